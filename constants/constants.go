@@ -22,6 +22,8 @@ const (
 	CharArrowUp                    = '\u2191'
 	CharArrowDown                  = '\u2193'
 	CharArrowLeft                  = '\u2190'
+	CharArrowRight                 = '\u2192'
+	CharCheckMark                  = '\u221A'
 	CharBlockSolid                 = '\u2588'
 	CharBlockDense                 = '\u2593'
 	CharBlockMedium                = '\u2592'
@@ -58,6 +60,19 @@ const (
 	CharDoubleLineTSingleRight     = '\u255F'
 	CharBlockLowerHalf 			   = '\u2584'
 	CharBlockUpperHalf  		   = '\u2580'
+	CharBlockLowerOneEighth        = '\u2581'
+	CharBlockLowerOneQuarter       = '\u2582'
+	CharBlockLowerThreeEighths     = '\u2583'
+	CharBlockLowerFiveEighths      = '\u2585'
+	CharBlockLowerThreeQuarters    = '\u2586'
+	CharBlockLowerSevenEighths     = '\u2587'
+	CharBlockLeftSevenEighths      = '\u2589'
+	CharBlockLeftThreeQuarters     = '\u258A'
+	CharBlockLeftFiveEighths       = '\u258B'
+	CharBlockLeftHalf              = '\u258C'
+	CharBlockLeftThreeEighths      = '\u258D'
+	CharBlockLeftOneQuarter        = '\u258E'
+	CharBlockLeftOneEighth         = '\u258F'
 )
 
 // Black
@@ -119,6 +134,72 @@ const FrameStyleRaised = 1
 const FrameStyleSunken = 2
 const CellTypeButton = 1
 const CellTypeTextInput = 2
+const CellTypeCheckBox = 3
+const CellTypeRadioButton = 4
+const CellTypeToggleSwitch = 5
+const AnchorNone = 0
+const AnchorLeft = 1
+const AnchorRight = 2
+const AnchorTop = 4
+const AnchorBottom = 8
+const EventTypeNone = 0
+const EventTypeKey = 1
+const EventTypeMouse = 2
+const EventTypeResize = 3
+const EventTypeFocus = 4
+const EventTypePaste = 5
+const EventTypeTimer = 6
+const EventTypeKeyBinding = 7
+const EventTypeWindow = 8
+const EventTypeControl = 9
+const MouseActionNone = 0
+const MouseActionMove = 1
+const MouseActionPress = 2
+const MouseActionRelease = 3
+const MouseActionDrag = 4
+const MouseActionWheel = 5
+const MouseActionEnter = 6
+const MouseActionLeave = 7
+const WindowActionClose = 1
+const WindowActionMove = 2
+const WindowActionResize = 3
+const WindowActionMaximize = 4
+const WindowActionRestore = 5
+const WindowMinimumWidth = 12
+const WindowMinimumHeight = 3
+const ControlTypeButton = 1
+const ControlTypeTextField = 2
+const ControlTypeMenu = 3
+const ControlTypeTextArea = 4
+const ControlTypeListBox = 5
+const ControlTypeMenuBar = 6
+const ControlTypeDataGrid = 7
+const ControlTypeTreeView = 8
+const ControlTypeCheckBox = 9
+const ControlTypeRadioButtonGroup = 10
+const ControlTypeToggleSwitch = 11
+const ControlTypeTabContainer = 12
+const ControlActionClick = 1
+const ControlActionChange = 2
+const ControlActionSubmit = 3
+const ControlActionCancel = 4
+const ControlActionSort = 5
+const ControlActionExpand = 6
+const ControlActionCollapse = 7
+const DataGridColumnWidthFixed = 0
+const DataGridColumnWidthProportional = 1
+const DataGridColumnWidthAuto = 2
+const ValidatorTypeNone = 0
+const ValidatorTypeNumeric = 1
+const ValidatorTypeIntegerRange = 2
+const ValidatorTypeRegex = 3
+const ValidatorTypeMask = 4
+const ValidatorTypeDate = 5
+const ValidatorTypeCustom = 6
+const DialogButtonOk = "ok"
+const DialogButtonYes = "yes"
+const DialogButtonNo = "no"
+const DialogButtonCancel = "cancel"
 
 const VirtualFileSystemZip = 1
 const VirtualFileSystemRar = 2
\ No newline at end of file
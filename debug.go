@@ -15,6 +15,8 @@ on disk. In addition, the following information should be noted:
 required for debugging.
 */
 func dumpScreenToFile() {
+	commonResource.displayMutex.Lock()
+	defer commonResource.displayMutex.Unlock()
 	dumpLayerToFile(commonResource.screenLayer)
 }
 
@@ -26,6 +28,8 @@ terminal. In addition, the following information should be noted:
 required for debugging.
 */
 func dumpScreenToTerminal() {
+	commonResource.displayMutex.Lock()
+	defer commonResource.displayMutex.Unlock()
 	log.Println(commonResource.screenLayer.GetBasicAnsiStringAsBase64())
 }
 
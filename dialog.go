@@ -1,9 +1,11 @@
 package dosktop
 
 import (
+	"github.com/supercom32/dosktop/constants"
 	"github.com/supercom32/dosktop/internal/memory"
 	"github.com/supercom32/dosktop/internal/stringformat"
 	"fmt"
+	"strings"
 )
 
 /*
@@ -174,3 +176,311 @@ func getLengthOfNextWord(stringToParse string, startingCharacterIndex int) int {
 	}
 	return lengthOfNextWord
 }
+
+/*
+modalDialogButtonType is a structure used to describe a button shown on a modal
+dialog.
+*/
+type modalDialogButtonType struct {
+	buttonAlias string
+	label       string
+}
+
+/*
+modalDialogStateType is a structure used to remember the state of the
+terminal before a modal dialog was opened, so that it can be restored once
+the dialog is dismissed.
+*/
+type modalDialogStateType struct {
+	layerAlias      string
+	controlAlias    string
+	modalLayerAlias string
+}
+
+/*
+modalLayerAlias is the alias of the text layer used by the modal dialog
+which is currently open. While a modal dialog is open, input is only
+delivered to its text layer.
+*/
+var modalLayerAlias string
+
+const modalDialogLayerAlias = "ModalDialog"
+const modalDialogShadeLayerAlias = "ModalDialogShade"
+const modalDialogTextFieldAlias = "ModalDialogValue"
+const modalDialogMaximumValueLength = 256
+
+/*
+MessageBox allows you to display a message to the user in a modal dialog
+with a single 'OK' button. In addition, the following information should
+be noted:
+
+- The dialog is drawn centered on the terminal, in front of all other text
+layers. Everything underneath the dialog is dimmed until it is dismissed.
+
+- While the dialog is open, it receives all keystrokes and mouse events.
+Controls, menu bars, and key bindings outside of the dialog cannot be used,
+and any events read while the dialog is open are not reported by
+'PollEvent'.
+
+- Messages are word wrapped to fit on the terminal. Line breaks in your
+message are preserved.
+
+- The returned value is always 'constants.DialogButtonOk', since pressing
+'esc' also dismisses the dialog.
+
+- This method blocks until the dialog is dismissed. Once it is, the dialog
+is removed and focus is returned to the control which had it before.
+*/
+func MessageBox(styleEntry memory.TuiStyleEntryType, title string, message string) string {
+	buttons := []modalDialogButtonType{{constants.DialogButtonOk, "OK"}}
+	buttonAlias, _ := showModalDialog(styleEntry, title, message, buttons, constants.DialogButtonOk, false, "")
+	return buttonAlias
+}
+
+/*
+Confirm allows you to ask the user a question in a modal dialog with 'Yes',
+'No', and 'Cancel' buttons. The returned value is the alias of the button
+pressed, which is one of 'constants.DialogButtonYes',
+'constants.DialogButtonNo', or 'constants.DialogButtonCancel'. Pressing
+'esc' is the same as pressing 'Cancel'. All the same rules as 'MessageBox'
+apply.
+*/
+func Confirm(styleEntry memory.TuiStyleEntryType, title string, message string) string {
+	buttons := []modalDialogButtonType{{constants.DialogButtonYes, "Yes"}, {constants.DialogButtonNo, "No"}, {constants.DialogButtonCancel, "Cancel"}}
+	buttonAlias, _ := showModalDialog(styleEntry, title, message, buttons, constants.DialogButtonCancel, false, "")
+	return buttonAlias
+}
+
+/*
+Prompt allows you to ask the user to enter a value in a modal dialog with a
+text field, along with 'OK' and 'Cancel' buttons. All the same rules as
+'MessageBox' apply. In addition, the following information should be
+noted:
+
+- The text field initially contains the default value provided, and has
+focus when the dialog opens. Up to 256 characters may be entered. Pressing 'enter' in the text field is the
+same as pressing 'OK'.
+
+- The returned values are the alias of the button pressed, which is either
+'constants.DialogButtonOk' or 'constants.DialogButtonCancel', and the value
+of the text field. Pressing 'esc' is the same as pressing 'Cancel'.
+*/
+func Prompt(styleEntry memory.TuiStyleEntryType, title string, message string, defaultValue string) (string, string) {
+	buttons := []modalDialogButtonType{{constants.DialogButtonOk, "OK"}, {constants.DialogButtonCancel, "Cancel"}}
+	return showModalDialog(styleEntry, title, message, buttons, constants.DialogButtonCancel, true, defaultValue)
+}
+
+/*
+showModalDialog allows you to display a modal dialog and wait until the
+user dismisses it. The first button provided is the default button, which
+has focus when the dialog opens unless a text field is shown. The alias of
+the button pressed and the value of the text field are returned.
+*/
+func showModalDialog(styleEntry memory.TuiStyleEntryType, title string, message string, buttons []modalDialogButtonType, cancelButtonAlias string, isTextFieldShown bool, defaultValue string) (string, string) {
+	maximumLineWidth := commonResource.terminalWidth - 8
+	if maximumLineWidth < 10 {
+		maximumLineWidth = 10
+	}
+	messageLines := getWrappedLines(message, maximumLineWidth)
+	contentWidth := len([]rune(title)) + 4
+	for _, currentLine := range messageLines {
+		if len([]rune(currentLine)) > contentWidth {
+			contentWidth = len([]rune(currentLine))
+		}
+	}
+	buttonsWidth := 0
+	for _, currentButton := range buttons {
+		buttonsWidth += len(currentButton.label) + 6
+	}
+	buttonsWidth -= 2
+	if buttonsWidth > contentWidth {
+		contentWidth = buttonsWidth
+	}
+	if isTextFieldShown && contentWidth < 30 {
+		contentWidth = 30
+	}
+	dialogWidth := contentWidth + 4
+	dialogHeight := len(messageLines) + 7
+	if isTextFieldShown {
+		dialogHeight += 2
+	}
+	layerEntry, modalDialogState := openModalDialog(styleEntry, title, dialogWidth, dialogHeight)
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.TextForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.TextBackgroundColor
+	for currentIndex, currentLine := range messageLines {
+		printLayer(layerEntry, attributeEntry, 2, currentIndex+2, []rune(currentLine))
+	}
+	currentYLocation := len(messageLines) + 3
+	if isTextFieldShown {
+		AddTextField(modalDialogLayerAlias, modalDialogTextFieldAlias, styleEntry, 2, currentYLocation, contentWidth, modalDialogMaximumValueLength, false, defaultValue)
+		currentYLocation += 2
+	}
+	addModalDialogButtons(styleEntry, buttons, (dialogWidth-buttonsWidth)/2, currentYLocation, 1)
+	if isTextFieldShown {
+		SetFocus(modalDialogLayerAlias, modalDialogTextFieldAlias)
+	} else {
+		SetFocus(modalDialogLayerAlias, buttons[0].buttonAlias)
+	}
+	buttonAlias := ""
+	for buttonAlias == "" {
+		UpdateDisplay()
+		eventEntry := WaitForEvent()
+		switch eventEntry.EventType {
+		case constants.EventTypeControl:
+			controlEvent := eventEntry.ControlEvent
+			if controlEvent.LayerAlias != modalDialogLayerAlias {
+				continue
+			}
+			if controlEvent.Action == constants.ControlActionClick {
+				buttonAlias = controlEvent.ControlAlias
+			} else if controlEvent.Action == constants.ControlActionSubmit {
+				buttonAlias = buttons[0].buttonAlias
+			} else if controlEvent.Action == constants.ControlActionCancel {
+				buttonAlias = cancelButtonAlias
+			}
+		case constants.EventTypeKey:
+			if eventEntry.KeyEvent.Chord == "esc" {
+				buttonAlias = cancelButtonAlias
+			}
+		}
+	}
+	value := ""
+	if isTextFieldShown {
+		value = GetTextFieldValue(modalDialogLayerAlias, modalDialogTextFieldAlias)
+	}
+	closeModalDialog(modalDialogState)
+	return buttonAlias, value
+}
+
+/*
+openModalDialog allows you to create the text layers used by a modal dialog
+of the size provided, and start trapping input. A text layer covering the
+entire terminal is used to dim everything underneath the dialog, and the
+dialog itself is drawn centered on a text layer in front of it. The text
+layer of the dialog is returned, along with the state which must be passed
+to 'closeModalDialog' once the dialog is dismissed.
+*/
+func openModalDialog(styleEntry memory.TuiStyleEntryType, title string, dialogWidth int, dialogHeight int) (*memory.LayerEntryType, modalDialogStateType) {
+	openLayerAlias, openMenuBarEntry := getOpenMenuBar()
+	if openMenuBarEntry != nil {
+		closeMenuBar(openLayerAlias, openMenuBarEntry)
+	}
+	var modalDialogState modalDialogStateType
+	modalDialogState.layerAlias, modalDialogState.controlAlias = GetFocus()
+	modalDialogState.modalLayerAlias = modalLayerAlias
+	xLocation := (commonResource.terminalWidth - dialogWidth) / 2
+	yLocation := (commonResource.terminalHeight - dialogHeight) / 2
+	if xLocation < 0 {
+		xLocation = 0
+	}
+	if yLocation < 0 {
+		yLocation = 0
+	}
+	highestZOrder := 0
+	for _, currentLayerEntry := range memory.ScreenMemory {
+		if currentLayerEntry.ParentAlias == "" && currentLayerEntry.ZOrder > highestZOrder {
+			highestZOrder = currentLayerEntry.ZOrder
+		}
+	}
+	memory.AddLayer(modalDialogShadeLayerAlias, 0, 0, commonResource.terminalWidth, commonResource.terminalHeight, highestZOrder+1, "")
+	shadeLayerEntry := memory.GetLayer(modalDialogShadeLayerAlias)
+	drawShadow(shadeLayerEntry, memory.NewAttributeEntry(), 0, 0, shadeLayerEntry.Width, shadeLayerEntry.Height, 0.5)
+	markLayerAsDirty(shadeLayerEntry)
+	memory.AddLayer(modalDialogLayerAlias, xLocation, yLocation, dialogWidth+2, dialogHeight+1, highestZOrder+2, "")
+	layerEntry := memory.GetLayer(modalDialogLayerAlias)
+	dialogStyleEntry := styleEntry
+	dialogStyleEntry.IsWindowHeaderDrawn = false
+	dialogStyleEntry.IsWindowFooterDrawn = false
+	drawWindow(layerEntry, dialogStyleEntry, memory.NewAttributeEntry(), 0, 0, dialogWidth, dialogHeight)
+	if title != "" {
+		drawFrameLabel(layerEntry, dialogStyleEntry, title, 1, 0)
+	}
+	markLayerAsDirty(layerEntry)
+	modalLayerAlias = modalDialogLayerAlias
+	return layerEntry, modalDialogState
+}
+
+/*
+addModalDialogButtons allows you to add a row of buttons to a modal dialog,
+starting at the location provided. Buttons are given consecutive tab
+indexes starting from the one provided.
+*/
+func addModalDialogButtons(styleEntry memory.TuiStyleEntryType, buttons []modalDialogButtonType, xLocation int, yLocation int, tabIndex int) {
+	for currentIndex, currentButton := range buttons {
+		AddButton(modalDialogLayerAlias, currentButton.buttonAlias, currentButton.label, styleEntry, xLocation, yLocation, len(currentButton.label)+4, 3)
+		SetTabIndex(modalDialogLayerAlias, currentButton.buttonAlias, tabIndex+currentIndex)
+		xLocation += len(currentButton.label) + 6
+	}
+}
+
+/*
+closeModalDialog allows you to remove a modal dialog along with all of its
+controls, and stop trapping input. Focus is returned to the control which
+had it before the dialog was opened.
+*/
+func closeModalDialog(modalDialogState modalDialogStateType) {
+	var controlEntries []*memory.ControlEntryType
+	for _, currentControlEntry := range memory.ControlMemory[modalDialogLayerAlias] {
+		controlEntries = append(controlEntries, currentControlEntry)
+	}
+	for _, currentControlEntry := range controlEntries {
+		switch currentControlEntry.ControlType {
+		case constants.ControlTypeButton:
+			DeleteButton(modalDialogLayerAlias, currentControlEntry.ControlAlias)
+		case constants.ControlTypeTextField:
+			DeleteTextField(modalDialogLayerAlias, currentControlEntry.ControlAlias)
+		case constants.ControlTypeListBox:
+			DeleteListBox(modalDialogLayerAlias, currentControlEntry.ControlAlias)
+		}
+	}
+	DeleteLayer(modalDialogLayerAlias)
+	DeleteLayer(modalDialogShadeLayerAlias)
+	modalLayerAlias = modalDialogState.modalLayerAlias
+	if modalDialogState.controlAlias != "" && memory.IsControlExists(modalDialogState.layerAlias, modalDialogState.controlAlias) {
+		SetFocus(modalDialogState.layerAlias, modalDialogState.controlAlias)
+	} else {
+		ClearFocus()
+	}
+	UpdateDisplay()
+}
+
+/*
+isModalInputAllowed allows you to detect if input directed at a given text
+layer can be used. If no modal dialog is open, all input is allowed.
+Otherwise, only input directed at the text layer of the modal dialog is
+allowed.
+*/
+func isModalInputAllowed(layerAlias string) bool {
+	return modalLayerAlias == "" || layerAlias == modalLayerAlias
+}
+
+/*
+getWrappedLines allows you to break text into lines which are no longer
+than the width provided. Lines are broken between words whenever possible,
+and line breaks already in the text are preserved. Words which are longer
+than the width provided are split across lines.
+*/
+func getWrappedLines(text string, width int) []string {
+	var wrappedLines []string
+	for _, currentLine := range strings.Split(text, "\n") {
+		lineOfRunes := []rune{}
+		for _, currentWord := range strings.Fields(currentLine) {
+			wordOfRunes := []rune(currentWord)
+			if len(lineOfRunes) > 0 && len(lineOfRunes)+1+len(wordOfRunes) > width {
+				wrappedLines = append(wrappedLines, string(lineOfRunes))
+				lineOfRunes = []rune{}
+			}
+			if len(lineOfRunes) > 0 {
+				lineOfRunes = append(lineOfRunes, ' ')
+			}
+			lineOfRunes = append(lineOfRunes, wordOfRunes...)
+			for len(lineOfRunes) > width {
+				wrappedLines = append(wrappedLines, string(lineOfRunes[:width]))
+				lineOfRunes = lineOfRunes[width:]
+			}
+		}
+		wrappedLines = append(wrappedLines, string(lineOfRunes))
+	}
+	return wrappedLines
+}
@@ -1,8 +1,12 @@
 package dosktop
 
 import (
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
 	"testing"
+	"time"
 )
 
 func TestPrintDialogWithTextStyles(test *testing.T) {
@@ -27,4 +31,57 @@ func TestPrintDialogWithTextStyles(test *testing.T) {
 	obtainedValue := layerEntry.GetBasicAnsiStringAsBase64()
 	expectedValue := "G1szODsyOzA7MDsxMjhtG1s0ODsyOzA7MTI4OzEyOG1hMWEyYTNhNGE1YTFhMmEzYTRhNWExYTJhM2E0YTVhMWEyYTNhNGE1G1szODsyOzA7MDswbRtbNDg7MjswOzA7MG0KG1szODsyOzA7MDsxMjhtG1s0ODsyOzA7MTI4OzEyOG1hMWEyYTNhNGE1YTFhMmEzYTRhNWExYTJhM2E0YTVhMWEyYTNhNGE1G1szODsyOzA7MDswbRtbNDg7MjswOzA7MG0KG1szODsyOzA7MDsxMjhtG1s0ODsyOzA7MTI4OzEyOG1hMRtbMzg7MjsxMjg7MTI4OzEyOG0bWzQ4OzI7MjU1OzA7MG1UaGlzIGlzIGEgG1szODsyOzA7MDsxMjhtG1s0ODsyOzA7MTI4OzEyOG1hMmEzYTRhNWExYTJhM2E0YTVhMWEyYTNhNGE1G1szODsyOzA7MDswbRtbNDg7MjswOzA7MG0KG1szODsyOzA7MDsxMjhtG1s0ODsyOzA7MTI4OzEyOG1hMRtbMzg7MjsyNTU7MDswbRtbNDg7MjswOzA7MG1zYW1wbGUbWzM4OzI7MTI4OzEyODsxMjhtG1s0ODsyOzI1NTswOzBtIGxpbmUgb2YgG1szODsyOzA7MDsxMjhtG1s0ODsyOzA7MTI4OzEyOG00YTVhMWEyYTNhNGE1YTFhMmEzYTRhNRtbMzg7MjswOzA7MG0bWzQ4OzI7MDswOzBtChtbMzg7MjswOzA7MTI4bRtbNDg7MjswOzEyODsxMjhtYTEbWzM4OzI7MTI4OzEyODsxMjhtG1s0ODsyOzI1NTswOzBtdGV4dC4gVGhpcyBsaW5lIBtbMzg7MjswOzA7MTI4bRtbNDg7MjswOzEyODsxMjhtYTVhMWEyYTNhNGE1YTFhMmEzYTRhNRtbMzg7MjswOzA7MG0bWzQ4OzI7MDswOzBtChtbMzg7MjswOzA7MTI4bRtbNDg7MjswOzEyODsxMjhtYTEbWzM4OzI7MTI4OzEyODsxMjhtG1s0ODsyOzI1NTswOzBtd2lsbCAbWzM4OzI7MDswOzEyOG0bWzQ4OzI7MDsxMjg7MTI4bTRhNWExYTJhM2E0YTVhMWEyYTNhNGE1YTFhMmEzYTRhNRtbMzg7MjswOzA7MG0bWzQ4OzI7MDswOzBtChtbMzg7MjswOzA7MTI4bRtbNDg7MjswOzEyODsxMjhtYTEbWzM4OzI7MjU1OzA7MG0bWzQ4OzI7MDswOzBtYXV0b21hdGljYWxseRtbMzg7MjsxMjg7MTI4OzEyOG0bWzQ4OzI7MjU1OzA7MG0gd3JhcCAbWzM4OzI7MDswOzEyOG0bWzQ4OzI7MDsxMjg7MTI4bTFhMmEzYTRhNWExYTJhM2E0YTUbWzM4OzI7MDswOzBtG1s0ODsyOzA7MDswbQobWzM4OzI7MDswOzEyOG0bWzQ4OzI7MDsxMjg7MTI4bWExG1szODsyOzEyODsxMjg7MTI4bRtbNDg7MjsyNTU7MDswbXdpdGhvdXQgY3V0dGluZyAbWzM4OzI7MDswOzEyOG0bWzQ4OzI7MDsxMjg7MTI4bWE1YTFhMmEzYTRhNWExYTJhM2E0YTUbWzM4OzI7MDswOzBtG1s0ODsyOzA7MDswbQobWzM4OzI7MDswOzEyOG0bWzQ4OzI7MDsxMjg7MTI4bWExG1szODsyOzEyODsxMjg7MTI4bRtbNDg7MjsyNTU7MDswbXdvcmRzLhtbMzg7MjswOzA7MTI4bRtbNDg7MjswOzEyODsxMjhtYTVhMWEyYTNhNGE1YTFhMmEzYTRhNWExYTJhM2E0YTUbWzM4OzI7MDswOzBtG1s0ODsyOzA7MDswbQobWzM4OzI7MDswOzEyOG0bWzQ4OzI7MDsxMjg7MTI4bWExYTJhM2E0YTVhMWEyYTNhNGE1YTFhMmEzYTRhNWExYTJhM2E0YTUbWzM4OzI7MDswOzBtG1s0ODsyOzA7MDswbQo="
 	assert.Equalf(test, expectedValue, obtainedValue, "The updated screen does not match the master original!")
-}
\ No newline at end of file
+}
+
+func TestModalDialogs(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(40, 15)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Desktop", 0, 0, 40, 15, 1, "")
+	AddButton("Desktop", "Outside", "Outside", NewTuiStyleEntry(), 30, 12, 8, 3)
+	SetFocus("Desktop", "Outside")
+	injectKey := func(key tcell.Key, character rune, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventKey(key, character, modifierMask))
+	}
+	injectMouse := func(xLocation int, yLocation int, buttonMask tcell.ButtonMask, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventMouse(xLocation, yLocation, buttonMask, modifierMask))
+	}
+	waitForMouseEvents := func(numberOfEvents int) {
+		numberOfEvents += memory.EventMemory.GetNumberOfEvents() + 1
+		injectKey(tcell.KeyF12, 0, tcell.ModNone)
+		timeout := time.Now().Add(time.Second)
+		for memory.EventMemory.GetNumberOfEvents() < numberOfEvents && time.Now().Before(timeout) {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, constants.DialogButtonOk, MessageBox(NewTuiStyleEntry(), "Notice", "The file was saved."), "Dismissing a message box did not return its button alias!")
+	layerAlias, controlAlias := GetFocus()
+	assert.Equalf(test, []interface{}{"Desktop", "Outside", false, false}, []interface{}{layerAlias, controlAlias, memory.IsLayerExists(modalDialogLayerAlias), memory.IsLayerExists(modalDialogShadeLayerAlias)}, "Focus was not restored, or the dialog layers were not removed!")
+	injectKey(tcell.KeyTab, 0, tcell.ModNone)
+	injectKey(tcell.KeyTab, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, constants.DialogButtonCancel, Confirm(NewTuiStyleEntry(), "Editor", "Save changes?"), "Moving focus between dialog buttons did not work!")
+	injectKey(tcell.KeyTab, 0, tcell.ModNone)
+	injectKey(tcell.KeyTab, 0, tcell.ModNone)
+	injectKey(tcell.KeyTab, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, constants.DialogButtonYes, Confirm(NewTuiStyleEntry(), "Editor", "Save changes?"), "Focus was able to leave the modal dialog!")
+	injectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	assert.Equalf(test, constants.DialogButtonCancel, Confirm(NewTuiStyleEntry(), "Editor", "Save changes?"), "Pressing escape did not cancel the dialog!")
+	injectMouse(32, 13, tcell.Button1, tcell.ModNone)
+	injectMouse(32, 13, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(17, 8, tcell.Button1, tcell.ModNone)
+	injectMouse(17, 8, tcell.ButtonNone, tcell.ModNone)
+	waitForMouseEvents(4)
+	assert.Equalf(test, constants.DialogButtonNo, Confirm(NewTuiStyleEntry(), "Editor", "Save changes?"), "Clicking a dialog button did not return its alias, or a control outside the dialog was clicked!")
+	injectKey(tcell.KeyRune, 'd', tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	buttonAlias, value := Prompt(NewTuiStyleEntry(), "Rename", "Enter a new name:", "abc")
+	assert.Equalf(test, []string{constants.DialogButtonOk, "abcd"}, []string{buttonAlias, value}, "Submitting a prompt did not return the value entered!")
+	injectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	buttonAlias, value = Prompt(NewTuiStyleEntry(), "Rename", "Enter a new name:", "abc")
+	assert.Equalf(test, []string{constants.DialogButtonCancel, "abc"}, []string{buttonAlias, value}, "Cancelling a prompt did not return the cancel button alias!")
+	assert.Falsef(test, memory.IsControlExists(modalDialogLayerAlias, modalDialogTextFieldAlias), "The prompt text field was not removed!")
+	assert.Equalf(test, []string{"The quick", "brown fox", "jumps", "", "abcdefghi", "jkl"}, getWrappedLines("The quick brown fox\njumps\n\nabcdefghijkl", 9), "Text was not wrapped correctly!")
+}
@@ -1,20 +1,71 @@
 package dosktop
 
 import (
+	"github.com/supercom32/dosktop/constants"
 	"github.com/supercom32/dosktop/internal/memory"
 	"fmt"
 	"github.com/gdamore/tcell"
 	"strings"
+	"time"
 )
 
+/*
+mouseHistoryType is a structure used to remember the last mouse state
+reported by the terminal, so that the kind of mouse action which occurred
+can be determined.
+*/
+type mouseHistoryType struct {
+	xLocation      int
+	yLocation      int
+	buttonPressed  uint
+	pressXLocation int
+	pressYLocation int
+	pressTime      int64
+	clickCount     int
+	pressButton    uint
+}
+
+/*
+mouseHistory is a variable used to hold the last mouse state reported by
+the terminal. It is only accessed by the background event monitor.
+*/
+var mouseHistory mouseHistoryType
+
+/*
+hoverHistoryType is a structure used to remember which text layer the mouse
+was last seen over, so that hover enter and leave events can be generated.
+*/
+type hoverHistoryType struct {
+	layerAlias string
+}
+
+/*
+hoverHistory is a variable used to hold the text layer the mouse was last
+seen over. It is only accessed by methods which read from the event queue.
+*/
+var hoverHistory hoverHistoryType
+
+/*
+pendingEvents is a variable used to hold events which were generated while
+reading from the event queue, and which must be returned before any other
+event.
+*/
+var pendingEvents []memory.EventEntryType
+
 /*
 updateEventQueues allows you to update all event queues so that information
 such as mouse clicks, keystrokes, and other events are properly registered.
 */
-func updateEventQueues() {
-	event := commonResource.screen.PollEvent()
+func updateEventQueues(event tcell.Event) {
 	switch event := event.(type) {
 	case *tcell.EventResize:
+		width, height := event.Size()
+		if setPendingResize(width, height) {
+			eventEntry := newEventEntry(constants.EventTypeResize)
+			eventEntry.ResizeEvent.Width = width
+			eventEntry.ResizeEvent.Height = height
+			memory.EventMemory.AddEvent(eventEntry)
+		}
 		commonResource.screen.Sync()
 	case *tcell.EventKey:
 		keystroke := ""
@@ -23,7 +74,15 @@ func updateEventQueues() {
 		} else {
 			keystroke = strings.ToLower(event.Name())
 		}
-		memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer(keystroke)
+		eventEntry := newEventEntry(constants.EventTypeKey)
+		eventEntry.KeyEvent.Keystroke = keystroke
+		eventEntry.KeyEvent.Chord = getKeyChord(event.Key(), event.Rune(), event.Modifiers())
+		eventEntry.KeyEvent.Key = event.Key()
+		eventEntry.KeyEvent.ModifierMask = event.Modifiers()
+		if event.Key() == tcell.KeyRune {
+			eventEntry.KeyEvent.Character = event.Rune()
+		}
+		memory.EventMemory.AddEvent(eventEntry)
 	case *tcell.EventMouse:
 		mouseXLocation, mouseYLocation := event.Position()
 		var mouseButtonNumber uint
@@ -44,6 +103,650 @@ func updateEventQueues() {
 			wheelState = "Right"
 		}
 		memory.MouseMemory.SetMouseStatus(mouseXLocation, mouseYLocation, mouseButtonNumber, wheelState)
+		mouseAction := getMouseAction(mouseXLocation, mouseYLocation, mouseButtonNumber, wheelState)
+		if mouseAction != constants.MouseActionNone {
+			eventEntry := newEventEntry(constants.EventTypeMouse)
+			eventEntry.MouseEvent.XLocation = mouseXLocation
+			eventEntry.MouseEvent.YLocation = mouseYLocation
+			eventEntry.MouseEvent.ButtonPressed = mouseButtonNumber
+			eventEntry.MouseEvent.WheelState = wheelState
+			eventEntry.MouseEvent.Action = mouseAction
+			eventEntry.MouseEvent.ModifierMask = event.Modifiers()
+			eventEntry.MouseEvent.StartXLocation = mouseXLocation
+			eventEntry.MouseEvent.StartYLocation = mouseYLocation
+			if mouseAction == constants.MouseActionPress || mouseAction == constants.MouseActionRelease || mouseAction == constants.MouseActionDrag {
+				eventEntry.MouseEvent.StartXLocation = mouseHistory.pressXLocation
+				eventEntry.MouseEvent.StartYLocation = mouseHistory.pressYLocation
+				eventEntry.MouseEvent.ClickCount = mouseHistory.clickCount
+			}
+			memory.EventMemory.AddEvent(eventEntry)
+		}
 		updateButtonStates()
 	}
 }
+
+/*
+getMouseAction allows you to determine what kind of mouse action occurred,
+by comparing the mouse state provided with the last one reported. In
+addition, the following information should be noted:
+
+- If the mouse state is identical to the last one reported, then
+'constants.MouseActionNone' is returned since nothing actually happened.
+
+- Wheel movements are always reported, since they do not change the state
+of the mouse.
+
+- When a button is pressed, the press location and click count are
+remembered until the next press.
+*/
+func getMouseAction(mouseXLocation int, mouseYLocation int, buttonPressed uint, wheelState string) int {
+	previousMouseHistory := mouseHistory
+	mouseHistory.xLocation = mouseXLocation
+	mouseHistory.yLocation = mouseYLocation
+	mouseHistory.buttonPressed = buttonPressed
+	isMouseMoved := mouseXLocation != previousMouseHistory.xLocation || mouseYLocation != previousMouseHistory.yLocation
+	if wheelState != "" {
+		return constants.MouseActionWheel
+	}
+	if buttonPressed != previousMouseHistory.buttonPressed {
+		if buttonPressed == 0 {
+			return constants.MouseActionRelease
+		}
+		updateClickCount(mouseXLocation, mouseYLocation, buttonPressed, previousMouseHistory)
+		return constants.MouseActionPress
+	}
+	if isMouseMoved {
+		if buttonPressed != 0 {
+			return constants.MouseActionDrag
+		}
+		return constants.MouseActionMove
+	}
+	return constants.MouseActionNone
+}
+
+/*
+updateClickCount allows you to record a mouse button press, and determine
+whether it forms part of a double or triple click. In addition, the
+following information should be noted:
+
+- A press counts as a repeated click if it happens at the same location,
+with the same button, and within the configured double click interval of
+the previous press.
+
+- After a triple click, the next press starts counting from one again.
+*/
+func updateClickCount(mouseXLocation int, mouseYLocation int, buttonPressed uint, previousMouseHistory mouseHistoryType) {
+	currentTime := GetCurrentTimeInMilliseconds()
+	isRepeatedClick := previousMouseHistory.clickCount > 0 && previousMouseHistory.clickCount < 3 &&
+		mouseXLocation == previousMouseHistory.pressXLocation && mouseYLocation == previousMouseHistory.pressYLocation &&
+		buttonPressed == previousMouseHistory.pressButton && currentTime-previousMouseHistory.pressTime <= memory.MouseMemory.GetClickInterval()
+	if isRepeatedClick {
+		mouseHistory.clickCount = previousMouseHistory.clickCount + 1
+	} else {
+		mouseHistory.clickCount = 1
+	}
+	mouseHistory.pressXLocation = mouseXLocation
+	mouseHistory.pressYLocation = mouseYLocation
+	mouseHistory.pressTime = currentTime
+	mouseHistory.pressButton = buttonPressed
+}
+
+/*
+newEventEntry allows you to create a new event of the specified type, time
+stamped with the current time.
+*/
+func newEventEntry(eventType int) memory.EventEntryType {
+	eventEntry := memory.NewEventEntry()
+	eventEntry.EventType = eventType
+	eventEntry.TimeStamp = GetCurrentTimeInMilliseconds()
+	return eventEntry
+}
+
+/*
+PollEvent allows you to obtain the next event from the event queue without
+waiting. If no event is available, then 'false' is returned instead. For
+example:
+
+	// Process all events currently waiting in the event queue.
+	for eventEntry, isEventAvailable := dosktop.PollEvent(); isEventAvailable; eventEntry, isEventAvailable = dosktop.PollEvent() {
+		if eventEntry.EventType == constants.EventTypeKey {
+			dosktop.Print(eventEntry.KeyEvent.Keystroke)
+		}
+	}
+
+In addition, the following information should be noted:
+
+- Events are returned in the order they occurred. Keystrokes, mouse
+actions, and terminal resizes are all delivered through the same queue, so
+their relative ordering is preserved.
+
+- Timer events are generated once for each enabled timer that expires. Once
+reported, the timer is disabled just as if 'IsTimerExpired' had been called.
+
+- If a resize event is returned, the new terminal size has already been
+applied.
+
+- If a sequence of keystrokes matches a key binding, then a single key
+binding event is returned in place of those keystrokes. For more
+information, see 'AddKeyBinding'.
+
+- Mouse events report the top-most text layer under the mouse. When the
+mouse moves onto a different text layer, hover leave and enter events are
+returned before the mouse event itself.
+
+- Mouse events used to move, resize, maximize, or close managed windows are
+replaced by window events. For more information, see 'AddWindow'.
+
+- Mouse events used to drag the scroll bars of a viewport are not reported.
+For more information, see 'AddViewport'.
+
+- While a modal dialog is open, key bindings and menu bars are disabled, and
+mouse events outside of the dialog are discarded. For more information, see
+'MessageBox'.
+
+- Keystrokes and mouse events used to open or navigate a menu bar are not
+reported. Selecting a menu item returns a control event instead. For more
+information, see 'AddMenuBar'.
+
+- Keystrokes are delivered to the control which has focus before being
+reported, and 'tab' or 'shift+tab' move focus between controls. Focus and
+control events are reported as a result. For more information, see
+'SetFocus'.
+
+- Mouse press, release, and drag events report where the button was first
+pressed, as well as the number of consecutive clicks made. This makes it
+easy to detect double and triple clicks, or to draw selection rectangles.
+
+- Focus and paste events are not reported by the terminal itself. They are
+only generated by Dosktop or posted by your application.
+
+- If too many events are left unprocessed, the oldest events are discarded.
+
+- 'Inkey' reads keystrokes from the same event queue, skipping over any
+other events and leaving them to be returned by this method. Keystrokes
+read by 'Inkey' are not delivered to key bindings, menu bars, or controls.
+*/
+func PollEvent() (memory.EventEntryType, bool) {
+	pauseEventChannel()
+	defer resumeEventChannel()
+	applyPendingResize()
+	for {
+		if len(pendingEvents) > 0 {
+			eventEntry := pendingEvents[0]
+			pendingEvents = pendingEvents[1:]
+			return eventEntry, true
+		}
+		eventEntry, isEventAvailable := getExpiredTimerEvent()
+		if isEventAvailable {
+			return eventEntry, true
+		}
+		eventEntry, isEventAvailable = memory.EventMemory.GetEvent()
+		if !isEventAvailable {
+			return eventEntry, false
+		}
+		processRawEvent(eventEntry)
+	}
+}
+
+/*
+ProcessEvent allows you to process an event received through the channel
+returned by 'GetEventChannel'. The events which should be reported as a
+result are returned in the order they occurred. For example:
+
+	case rawEventEntry := <-eventChannel:
+		for _, eventEntry := range dosktop.ProcessEvent(rawEventEntry) {
+			handleEvent(eventEntry)
+		}
+
+In addition, the following information should be noted:
+
+- All the same rules as 'PollEvent' apply to the events returned.
+
+- A single event received from the channel may produce no events at all,
+such as a keystroke used to navigate a menu bar, or several, such as hover
+events followed by a mouse event. Any timers which have expired are also
+reported.
+
+- This method must be called from the same goroutine as the rest of your
+application, since it updates controls, windows, and timers.
+*/
+func ProcessEvent(eventEntry memory.EventEntryType) []memory.EventEntryType {
+	applyPendingResize()
+	if !isTimerWakeUpEvent(eventEntry) {
+		processRawEvent(eventEntry)
+	}
+	eventEntries := pendingEvents
+	pendingEvents = nil
+	for {
+		timerEventEntry, isEventAvailable := getExpiredTimerEvent()
+		if !isEventAvailable {
+			break
+		}
+		eventEntries = append(eventEntries, timerEventEntry)
+	}
+	updateEventChannelTimer()
+	return eventEntries
+}
+
+/*
+processRawEvent allows you to pass an event read from the event queue
+through key bindings, menu bars, managed windows, viewports, and focused
+controls. Any events which should be reported as a result are added to the
+end of the pending events.
+*/
+func processRawEvent(eventEntry memory.EventEntryType) {
+	switch eventEntry.EventType {
+	case constants.EventTypeKey:
+		isEventAvailable := true
+		if modalLayerAlias == "" {
+			eventEntry, isEventAvailable = processKeyBinding(eventEntry)
+		}
+		if isEventAvailable && eventEntry.EventType == constants.EventTypeKey {
+			menuBarEvents, isEventUsed := getMenuBarKeyEvents(eventEntry)
+			if isEventUsed {
+				pendingEvents = append(pendingEvents, menuBarEvents...)
+			} else {
+				pendingEvents = append(pendingEvents, getFocusKeyEvents(eventEntry)...)
+			}
+		} else if isEventAvailable {
+			pendingEvents = append(pendingEvents, eventEntry)
+		}
+	case constants.EventTypeMouse:
+		pendingEvents = append(pendingEvents, getMouseHoverEvents(&eventEntry)...)
+		if !isModalInputAllowed(eventEntry.MouseEvent.LayerAlias) {
+			return
+		}
+		for _, currentEventEntry := range getWindowEvents(eventEntry) {
+			if currentEventEntry.EventType == constants.EventTypeMouse {
+				if viewportEvents, isEventUsed := getViewportMouseEvents(currentEventEntry); isEventUsed {
+					pendingEvents = append(pendingEvents, viewportEvents...)
+					continue
+				}
+				menuBarEvents, isEventUsed := getMenuBarMouseEvents(currentEventEntry)
+				if isEventUsed {
+					pendingEvents = append(pendingEvents, menuBarEvents...)
+				} else {
+					pendingEvents = append(pendingEvents, getFocusMouseEvents(currentEventEntry)...)
+				}
+			} else {
+				pendingEvents = append(pendingEvents, currentEventEntry)
+			}
+		}
+	default:
+		pendingEvents = append(pendingEvents, eventEntry)
+	}
+}
+
+/*
+getMouseHoverEvents allows you to determine which text layer a mouse event
+occurred over, and record it in the mouse event. If it is different from
+the text layer the mouse was last seen over, then the hover leave and enter
+events which should be reported before the mouse event are returned. In
+addition, the following information should be noted:
+
+- Only the top-most visible text layer under the mouse is considered to be
+hovered. If the mouse moves from a parent layer onto one of its children,
+the parent will receive a leave event.
+
+- Mouse events which already have a layer alias, such as ones posted by your
+application, are left unchanged.
+*/
+func getMouseHoverEvents(eventEntry *memory.EventEntryType) []memory.EventEntryType {
+	if eventEntry.MouseEvent.LayerAlias != "" || eventEntry.MouseEvent.Action == constants.MouseActionEnter || eventEntry.MouseEvent.Action == constants.MouseActionLeave {
+		return nil
+	}
+	layerAlias := getLayerAliasAtLocation(eventEntry.MouseEvent.XLocation, eventEntry.MouseEvent.YLocation)
+	eventEntry.MouseEvent.LayerAlias = layerAlias
+	if layerAlias == hoverHistory.layerAlias {
+		return nil
+	}
+	var hoverEvents []memory.EventEntryType
+	if hoverHistory.layerAlias != "" {
+		hoverEvents = append(hoverEvents, newHoverEventEntry(*eventEntry, constants.MouseActionLeave, hoverHistory.layerAlias))
+	}
+	if layerAlias != "" {
+		hoverEvents = append(hoverEvents, newHoverEventEntry(*eventEntry, constants.MouseActionEnter, layerAlias))
+	}
+	hoverHistory.layerAlias = layerAlias
+	return hoverEvents
+}
+
+/*
+newHoverEventEntry allows you to create a hover enter or leave event for
+the specified text layer, based on the mouse event which caused it.
+*/
+func newHoverEventEntry(mouseEventEntry memory.EventEntryType, mouseAction int, layerAlias string) memory.EventEntryType {
+	eventEntry := memory.NewEventEntry(&mouseEventEntry)
+	eventEntry.MouseEvent.Action = mouseAction
+	eventEntry.MouseEvent.LayerAlias = layerAlias
+	eventEntry.MouseEvent.ClickCount = 0
+	return eventEntry
+}
+
+/*
+WaitForEvent allows you to wait until an event is available and then obtain
+it from the event queue. This is useful for building event loops that sleep
+while nothing is happening. For example:
+
+	for {
+		eventEntry := dosktop.WaitForEvent()
+		if eventEntry.EventType == constants.EventTypeKey && eventEntry.KeyEvent.Keystroke == "esc" {
+			break
+		}
+	}
+
+In addition, the following information should be noted:
+
+- All the same rules as 'PollEvent' apply.
+
+- If a timer is enabled, this method will wake up automatically when it
+expires so that a timer event can be returned.
+*/
+func WaitForEvent() memory.EventEntryType {
+	pauseEventChannel()
+	defer resumeEventChannel()
+	notificationChannel := memory.EventMemory.GetNotificationChannel()
+	for {
+		eventEntry, isEventAvailable := PollEvent()
+		if isEventAvailable {
+			return eventEntry
+		}
+		var timer *time.Timer
+		var timerChannel <-chan time.Time
+		timeRemaining, isTimerEnabled := getTimeUntilNextTimerExpires()
+		if isTimerEnabled {
+			timer = time.NewTimer(time.Duration(timeRemaining+1) * time.Millisecond)
+			timerChannel = timer.C
+		}
+		select {
+		case <-notificationChannel:
+		case <-timerChannel:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+/*
+GetEventChannel allows you to receive events through a Go channel. This is
+useful when you want to wait on Dosktop events and other channels at the
+same time using a 'select' statement. For example:
+
+	eventChannel := dosktop.GetEventChannel()
+	for {
+		select {
+		case rawEventEntry := <-eventChannel:
+			for _, eventEntry := range dosktop.ProcessEvent(rawEventEntry) {
+				handleEvent(eventEntry)
+			}
+		case result := <-myWorkerChannel:
+			handleResult(result)
+		}
+	}
+
+In addition, the following information should be noted:
+
+- Events are read from the event queue by a background goroutine, which is
+started the first time this method is called. The same channel is returned
+on every call until the terminal is initialized again.
+
+- The events received are raw events taken directly from the event queue,
+so they must be passed to 'ProcessEvent' before being used. This ensures
+that key bindings, controls, windows, and timers are only ever updated by
+your own goroutine.
+
+- When a timer is due to expire, a timer event with no timer alias is
+received so that your 'select' statement wakes up. Passing it to
+'ProcessEvent' returns the timer events which are ready.
+
+- Methods which read the event queue directly, such as 'PollEvent',
+'WaitForEvent', 'Inkey', and blocking helpers like 'GetInput' or
+'MessageBox', pause the event channel while they run. Any event it has
+already read but not yet delivered is returned to the front of the event
+queue first, so no events are lost or reordered.
+*/
+func GetEventChannel() <-chan memory.EventEntryType {
+	commonResource.eventChannelMutex.Lock()
+	if commonResource.eventChannel == nil {
+		eventChannel := make(chan memory.EventEntryType)
+		timerChannel := make(chan int64, 1)
+		pauseChannel := make(chan bool)
+		resumeChannel := make(chan bool)
+		stopChannel := make(chan bool)
+		doneChannel := make(chan bool)
+		commonResource.eventChannel = eventChannel
+		commonResource.eventChannelTimer = timerChannel
+		commonResource.eventChannelPause = pauseChannel
+		commonResource.eventChannelResume = resumeChannel
+		commonResource.eventChannelStop = stopChannel
+		commonResource.eventChannelDone = doneChannel
+		isPaused := commonResource.eventChannelPauseCount > 0
+		go func() {
+			defer close(doneChannel)
+			forwardRawEvents(eventChannel, timerChannel, pauseChannel, resumeChannel, stopChannel, isPaused)
+		}()
+	}
+	eventChannel := commonResource.eventChannel
+	commonResource.eventChannelMutex.Unlock()
+	updateEventChannelTimer()
+	return eventChannel
+}
+
+/*
+forwardRawEvents allows you to send raw events from the event queue to the
+event channel provided, until the stop channel is closed. In addition, the
+following information should be noted:
+
+- Since this method runs in the background, it only accesses the event
+queue, which is safe to use from any goroutine. All other processing is
+left to 'ProcessEvent'.
+
+- The time at which the next timer expires is received through the timer
+channel, since timers cannot be read safely from the background. A value of
+-1 means that no timer is enabled. Once the time is reached, a timer event
+with no timer alias is sent to wake up the receiver.
+
+- When a request is received through the pause channel, any event read but
+not yet sent is returned to the front of the event queue, and the request
+is acknowledged through the same channel. Nothing else is read until a
+request is received through the resume channel.
+*/
+func forwardRawEvents(eventChannel chan memory.EventEntryType, timerChannel chan int64, pauseChannel chan bool, resumeChannel chan bool, stopChannel chan bool, isPaused bool) {
+	notificationChannel := memory.EventMemory.GetNotificationChannel()
+	var eventEntry memory.EventEntryType
+	isEventPending := false
+	timerExpiryTime := int64(-1)
+	for {
+		if isPaused {
+			select {
+			case <-resumeChannel:
+				isPaused = false
+			case <-stopChannel:
+				return
+			}
+		}
+		if !isEventPending {
+			eventEntry, isEventPending = memory.EventMemory.GetEvent()
+		}
+		if !isEventPending && timerExpiryTime >= 0 && GetCurrentTimeInMilliseconds() > timerExpiryTime {
+			eventEntry = newEventEntry(constants.EventTypeTimer)
+			isEventPending = true
+			timerExpiryTime = -1
+		}
+		var outputChannel chan memory.EventEntryType
+		var timer *time.Timer
+		var timerExpiredChannel <-chan time.Time
+		if isEventPending {
+			outputChannel = eventChannel
+		} else if timerExpiryTime >= 0 {
+			timer = time.NewTimer(time.Duration(timerExpiryTime-GetCurrentTimeInMilliseconds()+1) * time.Millisecond)
+			timerExpiredChannel = timer.C
+		}
+		isStopped := false
+		select {
+		case outputChannel <- eventEntry:
+			isEventPending = false
+		case <-notificationChannel:
+		case <-timerExpiredChannel:
+		case timerExpiryTime = <-timerChannel:
+		case <-pauseChannel:
+			if isEventPending && !isTimerWakeUpEvent(eventEntry) {
+				memory.EventMemory.AddEventToFront(eventEntry)
+			}
+			isEventPending = false
+			isPaused = true
+			pauseChannel <- true
+		case <-stopChannel:
+			isStopped = true
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if isStopped {
+			return
+		}
+	}
+}
+
+/*
+isTimerWakeUpEvent allows you to detect if a raw event was sent by the
+event channel only to indicate that a timer is due to expire.
+*/
+func isTimerWakeUpEvent(eventEntry memory.EventEntryType) bool {
+	return eventEntry.EventType == constants.EventTypeTimer && eventEntry.TimerEvent.TimerAlias == ""
+}
+
+/*
+updateEventChannelTimer allows you to tell the event channel when the next
+timer expires, so that it can wake up the receiver in time. If the event
+channel has not been started, then the request is ignored. This method must
+be called by the goroutine which owns the timers.
+*/
+func updateEventChannelTimer() {
+	commonResource.eventChannelMutex.Lock()
+	defer commonResource.eventChannelMutex.Unlock()
+	if commonResource.eventChannelTimer == nil {
+		return
+	}
+	timerExpiryTime := int64(-1)
+	if timeRemaining, isTimerEnabled := getTimeUntilNextTimerExpires(); isTimerEnabled {
+		timerExpiryTime = GetCurrentTimeInMilliseconds() + timeRemaining
+	}
+	select {
+	case <-commonResource.eventChannelTimer:
+	default:
+	}
+	commonResource.eventChannelTimer <- timerExpiryTime
+}
+
+/*
+pauseEventChannel allows you to stop the event channel from reading the
+event queue, so that events can be read from it directly. In addition, the
+following information should be noted:
+
+- Any event which the event channel has read but not yet delivered is
+returned to the front of the event queue before this method returns.
+
+- Calls may be nested, and each one must be matched by a call to
+'resumeEventChannel'. If the event channel has not been started, it will
+start in a paused state until every call has been matched.
+*/
+func pauseEventChannel() {
+	commonResource.eventChannelMutex.Lock()
+	defer commonResource.eventChannelMutex.Unlock()
+	commonResource.eventChannelPauseCount++
+	if commonResource.eventChannelPauseCount == 1 && commonResource.eventChannelPause != nil {
+		commonResource.eventChannelPause <- true
+		<-commonResource.eventChannelPause
+	}
+}
+
+/*
+resumeEventChannel allows you to let the event channel read the event queue
+again, once every call to 'pauseEventChannel' has been matched. Since
+timers may have changed while it was paused, the event channel is also told
+when the next timer expires.
+*/
+func resumeEventChannel() {
+	commonResource.eventChannelMutex.Lock()
+	commonResource.eventChannelPauseCount--
+	isResumed := commonResource.eventChannelPauseCount == 0 && commonResource.eventChannelResume != nil
+	if isResumed {
+		commonResource.eventChannelResume <- true
+	}
+	commonResource.eventChannelMutex.Unlock()
+	if isResumed {
+		updateEventChannelTimer()
+	}
+}
+
+/*
+stopEventChannel allows you to stop the background goroutine used to
+deliver events through a Go channel, if one was started. This method will
+not return until the goroutine has stopped.
+*/
+func stopEventChannel() {
+	commonResource.eventChannelMutex.Lock()
+	defer commonResource.eventChannelMutex.Unlock()
+	if commonResource.eventChannelStop != nil {
+		close(commonResource.eventChannelStop)
+		<-commonResource.eventChannelDone
+	}
+	commonResource.eventChannel = nil
+	commonResource.eventChannelTimer = nil
+	commonResource.eventChannelPause = nil
+	commonResource.eventChannelResume = nil
+	commonResource.eventChannelStop = nil
+	commonResource.eventChannelDone = nil
+}
+
+/*
+PostEvent allows you to add your own event to the end of the event queue.
+This is useful for simulating user input, or for passing custom
+notifications such as paste or focus events through your event loop. For
+example:
+
+	// Simulate the user pasting some text.
+	eventEntry := dosktop.NewEventEntry()
+	eventEntry.EventType = constants.EventTypePaste
+	eventEntry.PasteEvent.Text = "Hello World"
+	dosktop.PostEvent(eventEntry)
+
+In addition, the following information should be noted:
+
+- If the event has no time stamp, the current time will be used.
+*/
+func PostEvent(eventEntry memory.EventEntryType) {
+	if eventEntry.TimeStamp == 0 {
+		eventEntry.TimeStamp = GetCurrentTimeInMilliseconds()
+	}
+	memory.EventMemory.AddEvent(eventEntry)
+}
+
+/*
+NewEventEntry allows you to obtain a new event entry which can be posted to
+the event queue with 'PostEvent'.
+*/
+func NewEventEntry() memory.EventEntryType {
+	return memory.NewEventEntry()
+}
+
+/*
+SetDoubleClickInterval allows you to specify the maximum number of
+milliseconds allowed between mouse button presses for them to count as a
+double or triple click. In addition, the following information should be
+noted:
+
+- The default interval can also be specified when the terminal is
+initialized, by using 'InitializeTerminalWithSettings'.
+
+- If the interval provided is negative, a panic will be generated to fail as
+fast as possible.
+*/
+func SetDoubleClickInterval(intervalInMilliseconds int64) {
+	if intervalInMilliseconds < 0 {
+		panic(fmt.Sprintf("The double click interval could not be set since '%d' is not a valid interval.", intervalInMilliseconds))
+	}
+	memory.MouseMemory.SetClickInterval(intervalInMilliseconds)
+}
@@ -0,0 +1,198 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+	"time"
+)
+
+func TestEventQueueOrdering(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	simulationScreen.InjectKey(tcell.KeyRune, 'a', tcell.ModNone)
+	simulationScreen.InjectMouse(2, 3, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(4, 3, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(4, 3, tcell.ButtonNone, tcell.ModNone)
+	simulationScreen.InjectMouse(5, 3, tcell.ButtonNone, tcell.ModNone)
+	simulationScreen.InjectMouse(5, 3, tcell.WheelDown, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	eventEntry := WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.EventTypeKey, "a", 'a'}, []interface{}{eventEntry.EventType, eventEntry.KeyEvent.Keystroke, eventEntry.KeyEvent.Character}, "The first key event was not received correctly!")
+	expectedMouseActions := []int{constants.MouseActionPress, constants.MouseActionDrag, constants.MouseActionRelease, constants.MouseActionMove, constants.MouseActionWheel}
+	for _, currentMouseAction := range expectedMouseActions {
+		eventEntry = WaitForEvent()
+		assert.Equalf(test, constants.EventTypeMouse, eventEntry.EventType, "A mouse event was expected!")
+		assert.Equalf(test, currentMouseAction, eventEntry.MouseEvent.Action, "The mouse action was not determined correctly!")
+	}
+	assert.Equalf(test, "Down", eventEntry.MouseEvent.WheelState, "The mouse wheel direction was not recorded!")
+	eventEntry = WaitForEvent()
+	assert.Equalf(test, "enter", eventEntry.KeyEvent.Keystroke, "The last key event was not received in order!")
+	_, isEventAvailable := PollEvent()
+	assert.Falsef(test, isEventAvailable, "An event was returned even though the queue should be empty!")
+}
+
+func TestEventResizeAndTimer(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	simulationScreen.SetSize(30, 12)
+	simulationScreen.PostEvent(tcell.NewEventResize(30, 12))
+	eventEntry := WaitForEvent()
+	assert.Equalf(test, []int{constants.EventTypeResize, 30, 12}, []int{eventEntry.EventType, eventEntry.ResizeEvent.Width, eventEntry.ResizeEvent.Height}, "The resize event was not received correctly!")
+	width, height := GetTerminalSize()
+	assert.Equalf(test, []int{30, 12}, []int{width, height}, "The resize was not applied before the event was returned!")
+	AddTimer("MyTimer", 50, true)
+	startTime := time.Now()
+	eventEntry = WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.EventTypeTimer, "MyTimer"}, []interface{}{eventEntry.EventType, eventEntry.TimerEvent.TimerAlias}, "The timer event was not received correctly!")
+	assert.Truef(test, time.Since(startTime) >= 50*time.Millisecond, "The timer event was received before the timer expired!")
+	assert.Falsef(test, memory.GetTimer("MyTimer").IsTimerEnabled, "The timer was not disabled after its event was returned!")
+	DeleteTimer("MyTimer")
+}
+
+func TestEventPostAndChannel(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	eventEntry := NewEventEntry()
+	eventEntry.EventType = constants.EventTypePaste
+	eventEntry.PasteEvent.Text = "Hello World"
+	PostEvent(eventEntry)
+	eventChannel := GetEventChannel()
+	select {
+	case obtainedEventEntry := <-eventChannel:
+		assert.Equalf(test, "Hello World", obtainedEventEntry.PasteEvent.Text, "The posted event was not received through the event channel!")
+		assert.NotZerof(test, obtainedEventEntry.TimeStamp, "The posted event was not time stamped!")
+	case <-time.After(time.Second):
+		test.Errorf("No event was received through the event channel!")
+	}
+	assert.Equalf(test, eventChannel, GetEventChannel(), "A different event channel was returned on the second call!")
+	AddTimer("MyTimer", 10, true)
+	select {
+	case obtainedEventEntry := <-eventChannel:
+		processedEvents := ProcessEvent(obtainedEventEntry)
+		assert.Equalf(test, []interface{}{1, "MyTimer"}, []interface{}{len(processedEvents), processedEvents[0].TimerEvent.TimerAlias}, "The event channel did not wake up when a timer expired!")
+	case <-time.After(time.Second):
+		test.Errorf("No timer event was received through the event channel!")
+	}
+	eventEntry = NewEventEntry()
+	eventEntry.EventType = constants.EventTypeKey
+	eventEntry.KeyEvent.Keystroke = "a"
+	PostEvent(eventEntry)
+	for memory.EventMemory.GetNumberOfEvents() > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equalf(test, "a", Inkey(), "A keystroke already read by the event channel was not returned to the event queue!")
+	stopEventChannel()
+	simulationScreen.InjectMouse(1, 1, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyRune, 'z', tcell.ModNone)
+	assert.Equalf(test, "z", waitForKeystroke(), "Inkey did not skip non-keyboard events!")
+	eventEntry, _ = PollEvent()
+	assert.Equalf(test, []int{constants.EventTypeMouse, 1}, []int{eventEntry.EventType, eventEntry.MouseEvent.XLocation}, "Inkey discarded a mouse event instead of leaving it in the event queue!")
+}
+
+func TestEventMouseClicksAndDrag(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	SetDoubleClickInterval(1000)
+	for currentClick := 0; currentClick < 4; currentClick++ {
+		simulationScreen.InjectMouse(3, 3, tcell.Button1, tcell.ModNone)
+		simulationScreen.InjectMouse(3, 3, tcell.ButtonNone, tcell.ModNone)
+	}
+	for _, expectedClickCount := range []int{1, 2, 3, 1} {
+		eventEntry := WaitForEvent()
+		assert.Equalf(test, []int{constants.MouseActionPress, expectedClickCount}, []int{eventEntry.MouseEvent.Action, eventEntry.MouseEvent.ClickCount}, "The mouse press was not counted correctly!")
+		eventEntry = WaitForEvent()
+		assert.Equalf(test, []int{constants.MouseActionRelease, expectedClickCount}, []int{eventEntry.MouseEvent.Action, eventEntry.MouseEvent.ClickCount}, "The mouse release was not counted correctly!")
+	}
+	simulationScreen.InjectMouse(5, 2, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(7, 4, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(8, 6, tcell.ButtonNone, tcell.ModNone)
+	eventEntry := WaitForEvent()
+	assert.Equalf(test, []int{constants.MouseActionPress, 1}, []int{eventEntry.MouseEvent.Action, eventEntry.MouseEvent.ClickCount}, "A press at a new location was counted as a repeated click!")
+	for _, expectedMouseAction := range []int{constants.MouseActionDrag, constants.MouseActionRelease} {
+		eventEntry = WaitForEvent()
+		assert.Equalf(test, []int{expectedMouseAction, 5, 2}, []int{eventEntry.MouseEvent.Action, eventEntry.MouseEvent.StartXLocation, eventEntry.MouseEvent.StartYLocation}, "The drag start location was not reported correctly!")
+	}
+	assert.Equalf(test, []int{8, 6}, []int{eventEntry.MouseEvent.XLocation, eventEntry.MouseEvent.YLocation}, "The drag end location was not reported correctly!")
+	SetDoubleClickInterval(0)
+	simulationScreen.InjectMouse(8, 6, tcell.Button1, tcell.ModNone)
+	time.Sleep(10 * time.Millisecond)
+	simulationScreen.InjectMouse(8, 6, tcell.ButtonNone, tcell.ModNone)
+	simulationScreen.InjectMouse(8, 6, tcell.Button1, tcell.ModNone)
+	WaitForEvent()
+	WaitForEvent()
+	eventEntry = WaitForEvent()
+	assert.Equalf(test, 1, eventEntry.MouseEvent.ClickCount, "A click outside the double click interval was counted as a repeated click!")
+	assert.Panicsf(test, func() { SetDoubleClickInterval(-1) }, "A negative double click interval did not panic!")
+}
+
+func TestEventMouseHover(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Background", 0, 0, 20, 10, 1, "")
+	AddLayer("Window", 5, 2, 10, 5, 2, "")
+	AddLayer("Panel", 1, 1, 3, 3, 1, "Window")
+	simulationScreen.InjectMouse(1, 1, tcell.ButtonNone, tcell.ModNone)
+	simulationScreen.InjectMouse(6, 3, tcell.ButtonNone, tcell.ModNone)
+	simulationScreen.InjectMouse(10, 3, tcell.ButtonNone, tcell.ModNone)
+	expectedEvents := [][]interface{}{
+		{constants.MouseActionEnter, "Background"},
+		{constants.MouseActionMove, "Background"},
+		{constants.MouseActionLeave, "Background"},
+		{constants.MouseActionEnter, "Panel"},
+		{constants.MouseActionMove, "Panel"},
+		{constants.MouseActionLeave, "Panel"},
+		{constants.MouseActionEnter, "Window"},
+		{constants.MouseActionMove, "Window"},
+	}
+	for _, currentExpectedEvent := range expectedEvents {
+		eventEntry := WaitForEvent()
+		assert.Equalf(test, currentExpectedEvent, []interface{}{eventEntry.MouseEvent.Action, eventEntry.MouseEvent.LayerAlias}, "The hover events were not received correctly!")
+	}
+	assert.Equalf(test, "Window", GetLayerAliasUnderMouseLocation(), "The layer under the mouse was not returned correctly!")
+}
+
+func TestEventChannelWhileChangingTimersAndLayers(test *testing.T) {
+	_, err := InitializeHeadlessTerminal(20, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	eventChannel := GetEventChannel()
+	var timerAliases []string
+	for currentIndex := 0; currentIndex < 50; currentIndex++ {
+		AddTimer(fmt.Sprintf("Timer%d", currentIndex), 1, true)
+		AddLayer(fmt.Sprintf("Layer%d", currentIndex), 0, 0, 5, 5, currentIndex, "")
+		eventEntry := NewEventEntry()
+		eventEntry.EventType = constants.EventTypeKey
+		eventEntry.KeyEvent.Keystroke = "a"
+		PostEvent(eventEntry)
+		select {
+		case rawEventEntry := <-eventChannel:
+			for _, currentEventEntry := range ProcessEvent(rawEventEntry) {
+				if currentEventEntry.EventType == constants.EventTypeTimer {
+					timerAliases = append(timerAliases, currentEventEntry.TimerEvent.TimerAlias)
+				}
+			}
+		case <-time.After(time.Second):
+			test.Fatalf("No event was received through the event channel!")
+		}
+	}
+	select {
+	case rawEventEntry := <-eventChannel:
+		for _, currentEventEntry := range ProcessEvent(rawEventEntry) {
+			if currentEventEntry.EventType == constants.EventTypeTimer {
+				timerAliases = append(timerAliases, currentEventEntry.TimerEvent.TimerAlias)
+			}
+		}
+	case <-time.After(time.Second):
+		test.Fatalf("No timer wake-up was received through the event channel!")
+	}
+	assert.NotEmptyf(test, timerAliases, "No timer events were produced while processing events from the event channel!")
+}
@@ -0,0 +1,562 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+/*
+fileDialogType is a structure used to hold the state of a file dialog while
+it is open.
+*/
+type fileDialogType struct {
+	styleEntry         memory.TuiStyleEntryType
+	layerEntry         *memory.LayerEntryType
+	isSaveDialog       bool
+	isVirtual          bool
+	localDirectoryPath string
+	directoryPath      string
+	filterPatterns     []string
+	directoryEntries   []fileSystemEntryType
+	dialogWidth        int
+	dialogHeight       int
+}
+
+const fileDialogDirectoryListAlias = "FileDialogDirectories"
+const fileDialogFileListAlias = "FileDialogFiles"
+const fileDialogFileNameAlias = "FileDialogFileName"
+const fileDialogLocalPrefix = "local:"
+const fileDialogVirtualPrefix = "virtual:"
+const fileDialogMaximumWidth = 76
+const fileDialogMaximumHeight = 22
+const fileDialogDateFormat = "2006-01-02 15:04"
+
+/*
+OpenFileDialog allows you to ask the user to pick an existing file in a
+modal dialog. The dialog shows a directory tree, a list of the files in the
+current directory with their sizes and modification dates, and a text
+field for the file name. All the same rules as 'MessageBox' apply. In
+addition, the following information should be noted:
+
+- If a virtual file system is mounted, the dialog starts inside it and
+'directoryPath' is relative to the root of the archive. Otherwise, the
+dialog starts on the local disk. If 'directoryPath' is "", the root of the
+archive or the current working directory is used instead. When a virtual
+file system is mounted, both it and the local disk appear at the top level
+of the directory tree, so the user may browse either one.
+
+- Only files which match one of the filter patterns provided are listed,
+such as "*.png". Patterns are not case sensitive. If no patterns are
+provided, all files are listed. Typing a pattern into the file name field
+and pressing 'enter' replaces the patterns being used. Separate multiple
+patterns with ';'.
+
+- Pressing 'enter' or double clicking on a directory opens it, and
+pressing 'backspace' while the directory tree or file list has focus opens
+the parent directory. Typing the name of a directory, or "..", into the file
+name field and pressing 'enter' also opens it.
+
+- Highlighting a file copies its name into the file name field. Pressing
+'enter' or double clicking on a file, or pressing 'Open', picks it. Only
+files which exist may be picked.
+
+- The file name field also accepts a path, either absolute or relative to
+the current directory, such as "images/logo.png". If the path describes a
+directory, it is opened. Otherwise, the file it describes is picked. Inside
+a virtual file system, paths always use '/' and absolute paths start at the
+root of the archive.
+
+- The returned values are the alias of the button pressed, which is either
+'constants.DialogButtonOk' or 'constants.DialogButtonCancel', and the path
+of the file picked. Files on the local disk are returned as absolute paths,
+while files in the virtual file system are returned relative to the root of
+the archive, exactly as they would be given to methods which load files. If
+the dialog is cancelled, the path returned is "".
+*/
+func OpenFileDialog(styleEntry memory.TuiStyleEntryType, title string, directoryPath string, filterPatterns []string) (string, string) {
+	return showFileDialog(styleEntry, title, directoryPath, "", filterPatterns, false)
+}
+
+/*
+SaveFileDialog allows you to ask the user to choose where a file should be
+saved in a modal dialog. The file name field initially contains the default
+file name provided, and has focus when the dialog opens. Unlike
+'OpenFileDialog', any file name may be entered, even if no such file exists
+yet. Otherwise, all the same rules as 'OpenFileDialog' apply.
+*/
+func SaveFileDialog(styleEntry memory.TuiStyleEntryType, title string, directoryPath string, defaultFileName string, filterPatterns []string) (string, string) {
+	return showFileDialog(styleEntry, title, directoryPath, defaultFileName, filterPatterns, true)
+}
+
+/*
+showFileDialog allows you to display a file dialog and wait until the user
+dismisses it. The alias of the button pressed and the path of the file
+chosen are returned.
+*/
+func showFileDialog(styleEntry memory.TuiStyleEntryType, title string, directoryPath string, defaultFileName string, filterPatterns []string, isSaveDialog bool) (string, string) {
+	var fileDialog fileDialogType
+	fileDialog.styleEntry = styleEntry
+	fileDialog.isSaveDialog = isSaveDialog
+	fileDialog.filterPatterns = filterPatterns
+	fileDialog.localDirectoryPath, _ = os.Getwd()
+	fileDialog.isVirtual = isVirtualFileSystemMounted()
+	if fileDialog.isVirtual {
+		directoryPath = strings.Trim(path.Clean("/"+filepath.ToSlash(directoryPath)), "/")
+	} else if directoryPath != "" {
+		directoryPath, _ = filepath.Abs(directoryPath)
+	}
+	fileDialog.dialogWidth = commonResource.terminalWidth - 4
+	if fileDialog.dialogWidth > fileDialogMaximumWidth {
+		fileDialog.dialogWidth = fileDialogMaximumWidth
+	}
+	fileDialog.dialogHeight = commonResource.terminalHeight - 2
+	if fileDialog.dialogHeight > fileDialogMaximumHeight {
+		fileDialog.dialogHeight = fileDialogMaximumHeight
+	}
+	if fileDialog.dialogWidth < 40 || fileDialog.dialogHeight < 12 {
+		panic(fmt.Sprintf("The terminal size of '%dx%d' is too small to show a file dialog!", commonResource.terminalWidth, commonResource.terminalHeight))
+	}
+	var modalDialogState modalDialogStateType
+	fileDialog.layerEntry, modalDialogState = openModalDialog(styleEntry, title, fileDialog.dialogWidth, fileDialog.dialogHeight)
+	addFileDialogControls(&fileDialog, defaultFileName)
+	if !changeFileDialogDirectory(&fileDialog, fileDialog.isVirtual, directoryPath) {
+		changeFileDialogDirectory(&fileDialog, fileDialog.isVirtual, "")
+	}
+	if isSaveDialog {
+		SetFocus(modalDialogLayerAlias, fileDialogFileNameAlias)
+	} else {
+		SetFocus(modalDialogLayerAlias, fileDialogFileListAlias)
+	}
+	buttonAlias := ""
+	filePath := ""
+	for buttonAlias == "" {
+		UpdateDisplay()
+		eventEntry := WaitForEvent()
+		switch eventEntry.EventType {
+		case constants.EventTypeControl:
+			controlEvent := eventEntry.ControlEvent
+			if controlEvent.LayerAlias != modalDialogLayerAlias {
+				continue
+			}
+			if controlEvent.Action == constants.ControlActionCancel {
+				buttonAlias = constants.DialogButtonCancel
+				continue
+			}
+			switch controlEvent.ControlAlias {
+			case constants.DialogButtonOk:
+				filePath = getFileDialogFilePath(&fileDialog, GetTextFieldValue(modalDialogLayerAlias, fileDialogFileNameAlias))
+			case constants.DialogButtonCancel:
+				buttonAlias = constants.DialogButtonCancel
+			case fileDialogDirectoryListAlias:
+				if controlEvent.Action == constants.ControlActionSubmit {
+					openFileDialogDirectoryListItem(&fileDialog)
+				}
+			case fileDialogFileListAlias:
+				selections := GetListBoxSelections(modalDialogLayerAlias, fileDialogFileListAlias)
+				if len(selections) == 0 {
+					continue
+				}
+				if controlEvent.Action == constants.ControlActionChange {
+					SetTextFieldValue(modalDialogLayerAlias, fileDialogFileNameAlias, selections[0])
+				} else if controlEvent.Action == constants.ControlActionSubmit {
+					filePath = getFileDialogFilePath(&fileDialog, selections[0])
+				}
+			case fileDialogFileNameAlias:
+				if controlEvent.Action == constants.ControlActionSubmit {
+					filePath = getFileDialogFilePath(&fileDialog, GetTextFieldValue(modalDialogLayerAlias, fileDialogFileNameAlias))
+				}
+			}
+			if filePath != "" {
+				buttonAlias = constants.DialogButtonOk
+			}
+		case constants.EventTypeKey:
+			keyEvent := eventEntry.KeyEvent
+			if keyEvent.Chord == "esc" {
+				buttonAlias = constants.DialogButtonCancel
+			} else if keyEvent.Chord == "backspace" && keyEvent.LayerAlias == modalDialogLayerAlias && keyEvent.ControlAlias != fileDialogFileNameAlias {
+				openFileDialogParentDirectory(&fileDialog)
+			}
+		}
+	}
+	closeModalDialog(modalDialogState)
+	return buttonAlias, filePath
+}
+
+/*
+addFileDialogControls allows you to add the directory tree, file list, file
+name field, and buttons of a file dialog to its text layer.
+*/
+func addFileDialogControls(fileDialog *fileDialogType, defaultFileName string) {
+	attributeEntry := getFileDialogTextAttributeEntry(fileDialog)
+	listHeight := fileDialog.dialogHeight - 9
+	directoryListWidth := (fileDialog.dialogWidth - 5) / 3
+	fileListWidth := fileDialog.dialogWidth - 5 - directoryListWidth
+	AddListBox(modalDialogLayerAlias, fileDialogDirectoryListAlias, fileDialog.styleEntry, memory.NewSelectionEntry(), 2, 2, directoryListWidth, listHeight, false)
+	SetTabIndex(modalDialogLayerAlias, fileDialogDirectoryListAlias, 1)
+	AddListBox(modalDialogLayerAlias, fileDialogFileListAlias, fileDialog.styleEntry, memory.NewSelectionEntry(), 3+directoryListWidth, 2, fileListWidth, listHeight, false)
+	SetTabIndex(modalDialogLayerAlias, fileDialogFileListAlias, 2)
+	fileNameLabel := "File name: "
+	fileNameWidth := len(fileNameLabel)
+	printLayer(fileDialog.layerEntry, attributeEntry, 2, fileDialog.dialogHeight-6, []rune(fileNameLabel))
+	AddTextField(modalDialogLayerAlias, fileDialogFileNameAlias, fileDialog.styleEntry, 2+fileNameWidth, fileDialog.dialogHeight-6, fileDialog.dialogWidth-4-fileNameWidth, modalDialogMaximumValueLength, false, defaultFileName)
+	SetTabIndex(modalDialogLayerAlias, fileDialogFileNameAlias, 3)
+	okButtonLabel := "Open"
+	if fileDialog.isSaveDialog {
+		okButtonLabel = "Save"
+	}
+	buttons := []modalDialogButtonType{{constants.DialogButtonOk, okButtonLabel}, {constants.DialogButtonCancel, "Cancel"}}
+	buttonsWidth := len(okButtonLabel) + len("Cancel") + 10
+	addModalDialogButtons(fileDialog.styleEntry, buttons, fileDialog.dialogWidth-2-buttonsWidth, fileDialog.dialogHeight-4, 4)
+}
+
+/*
+changeFileDialogDirectory allows you to change the directory shown by a file
+dialog, and refresh its directory tree and file list. Returns 'false' if the
+directory could not be listed, in which case the file dialog is left
+unchanged.
+*/
+func changeFileDialogDirectory(fileDialog *fileDialogType, isVirtual bool, directoryPath string) bool {
+	if !isVirtual && directoryPath == "" {
+		directoryPath = fileDialog.localDirectoryPath
+	}
+	directoryEntries, err := getFileDialogDirectoryEntries(isVirtual, directoryPath)
+	if err != nil {
+		return false
+	}
+	fileDialog.isVirtual = isVirtual
+	fileDialog.directoryPath = directoryPath
+	if !isVirtual {
+		fileDialog.localDirectoryPath = directoryPath
+	}
+	fileDialog.directoryEntries = directoryEntries
+	updateFileDialogDirectoryList(fileDialog)
+	updateFileDialogFileList(fileDialog)
+	drawFileDialogLabels(fileDialog)
+	return true
+}
+
+/*
+getFileDialogDirectoryEntries allows you to list the files and directories
+inside a directory of either the virtual file system or the local disk. If
+the directory cannot be listed, then an error is returned instead.
+*/
+func getFileDialogDirectoryEntries(isVirtual bool, directoryPath string) ([]fileSystemEntryType, error) {
+	if isVirtual {
+		return getDirectoryEntriesFromFileSystem(directoryPath)
+	}
+	return getDirectoryEntriesFromLocalFileSystem(directoryPath)
+}
+
+/*
+updateFileDialogDirectoryList allows you to refresh the directory tree of a
+file dialog. The tree shows the local disk and, if one is mounted, the
+virtual file system at its top level. Only the file system being browsed is
+expanded, showing each directory leading to the current directory, followed
+by the sub-directories of the current directory. The current directory is
+highlighted.
+*/
+func updateFileDialogDirectoryList(fileDialog *fileDialogType) {
+	selectionEntry := memory.NewSelectionEntry()
+	currentItemAlias := ""
+	if fileDialog.isVirtual {
+		selectionEntry.Add(fileDialogLocalPrefix+fileDialog.localDirectoryPath, string(filepath.Separator))
+		var directoryPaths []string
+		for currentPath := fileDialog.directoryPath; currentPath != ""; currentPath = path.Dir(currentPath) {
+			directoryPaths = append([]string{currentPath}, directoryPaths...)
+			if path.Dir(currentPath) == "." {
+				break
+			}
+		}
+		selectionEntry.Add(fileDialogVirtualPrefix, filepath.Base(virtualFileSystemArchive))
+		for currentIndex, currentPath := range directoryPaths {
+			selectionEntry.Add(fileDialogVirtualPrefix+currentPath, strings.Repeat(" ", currentIndex+1)+path.Base(currentPath))
+		}
+		currentItemAlias = fileDialogVirtualPrefix + fileDialog.directoryPath
+		for _, currentDirectoryEntry := range fileDialog.directoryEntries {
+			if currentDirectoryEntry.isDirectory {
+				selectionEntry.Add(fileDialogVirtualPrefix+path.Join(fileDialog.directoryPath, currentDirectoryEntry.name), strings.Repeat(" ", len(directoryPaths)+1)+currentDirectoryEntry.name)
+			}
+		}
+	} else {
+		var directoryPaths []string
+		for currentPath := fileDialog.directoryPath; ; currentPath = filepath.Dir(currentPath) {
+			directoryPaths = append([]string{currentPath}, directoryPaths...)
+			if filepath.Dir(currentPath) == currentPath {
+				break
+			}
+		}
+		for currentIndex, currentPath := range directoryPaths {
+			label := filepath.Base(currentPath)
+			if currentIndex == 0 {
+				label = currentPath
+			}
+			selectionEntry.Add(fileDialogLocalPrefix+currentPath, strings.Repeat(" ", currentIndex)+label)
+		}
+		currentItemAlias = fileDialogLocalPrefix + fileDialog.directoryPath
+		for _, currentDirectoryEntry := range fileDialog.directoryEntries {
+			if currentDirectoryEntry.isDirectory {
+				selectionEntry.Add(fileDialogLocalPrefix+filepath.Join(fileDialog.directoryPath, currentDirectoryEntry.name), strings.Repeat(" ", len(directoryPaths))+currentDirectoryEntry.name)
+			}
+		}
+		if isVirtualFileSystemMounted() {
+			selectionEntry.Add(fileDialogVirtualPrefix, filepath.Base(virtualFileSystemArchive))
+		}
+	}
+	SetListBoxItems(modalDialogLayerAlias, fileDialogDirectoryListAlias, selectionEntry)
+	SetListBoxSelections(modalDialogLayerAlias, fileDialogDirectoryListAlias, []string{currentItemAlias})
+}
+
+/*
+updateFileDialogFileList allows you to refresh the file list of a file
+dialog. Only files which match the filter patterns of the file dialog are
+listed. Each file is shown with its size and modification date in columns
+to the right of its name.
+*/
+func updateFileDialogFileList(fileDialog *fileDialogType) {
+	listBoxEntry := memory.GetListBox(modalDialogLayerAlias, fileDialogFileListAlias)
+	sizeWidth := 7
+	dateWidth := len(fileDialogDateFormat)
+	nameWidth := listBoxEntry.Width - 1 - sizeWidth - dateWidth - 2
+	selectionEntry := memory.NewSelectionEntry()
+	for _, currentDirectoryEntry := range fileDialog.directoryEntries {
+		if currentDirectoryEntry.isDirectory || !isFileNameMatchingPatterns(currentDirectoryEntry.name, fileDialog.filterPatterns) {
+			continue
+		}
+		modificationDate := ""
+		if !currentDirectoryEntry.modificationTime.IsZero() {
+			modificationDate = currentDirectoryEntry.modificationTime.Format(fileDialogDateFormat)
+		}
+		itemValue := getTruncatedString(currentDirectoryEntry.name, nameWidth)
+		itemValue = stringformat.GetFormattedString(itemValue, nameWidth, constants.LeftAligned) + " "
+		itemValue += stringformat.GetFormattedString(getFileSizeAsString(currentDirectoryEntry.size), sizeWidth, constants.RightAligned) + " "
+		itemValue += modificationDate
+		selectionEntry.Add(currentDirectoryEntry.name, itemValue)
+	}
+	SetListBoxItems(modalDialogLayerAlias, fileDialogFileListAlias, selectionEntry)
+}
+
+/*
+drawFileDialogLabels allows you to draw the current location and the filter
+patterns being used on the text layer of a file dialog.
+*/
+func drawFileDialogLabels(fileDialog *fileDialogType) {
+	attributeEntry := getFileDialogTextAttributeEntry(fileDialog)
+	labelWidth := fileDialog.dialogWidth - 4
+	location := fileDialog.directoryPath
+	if fileDialog.isVirtual {
+		location = filepath.Base(virtualFileSystemArchive) + ":/" + fileDialog.directoryPath
+	}
+	filter := "*"
+	if len(fileDialog.filterPatterns) > 0 {
+		filter = strings.Join(fileDialog.filterPatterns, ";")
+	}
+	fillArea(fileDialog.layerEntry, attributeEntry, " ", 2, 1, labelWidth, 1)
+	printLayer(fileDialog.layerEntry, attributeEntry, 2, 1, []rune(getTruncatedString("Look in: "+location, labelWidth)))
+	fillArea(fileDialog.layerEntry, attributeEntry, " ", 2, fileDialog.dialogHeight-5, labelWidth, 1)
+	printLayer(fileDialog.layerEntry, attributeEntry, 2, fileDialog.dialogHeight-5, []rune(getTruncatedString("Filter:    "+filter, labelWidth)))
+	markLayerAsDirty(fileDialog.layerEntry)
+}
+
+/*
+getFileDialogTextAttributeEntry allows you to obtain the attribute entry used
+to print text on the text layer of a file dialog.
+*/
+func getFileDialogTextAttributeEntry(fileDialog *fileDialogType) memory.AttributeEntryType {
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = fileDialog.styleEntry.TextForegroundColor
+	attributeEntry.BackgroundColor = fileDialog.styleEntry.TextBackgroundColor
+	return attributeEntry
+}
+
+/*
+openFileDialogDirectoryListItem allows you to open the directory currently
+selected in the directory tree of a file dialog.
+*/
+func openFileDialogDirectoryListItem(fileDialog *fileDialogType) {
+	selections := GetListBoxSelections(modalDialogLayerAlias, fileDialogDirectoryListAlias)
+	if len(selections) == 0 {
+		return
+	}
+	if strings.HasPrefix(selections[0], fileDialogVirtualPrefix) {
+		changeFileDialogDirectory(fileDialog, true, strings.TrimPrefix(selections[0], fileDialogVirtualPrefix))
+	} else {
+		changeFileDialogDirectory(fileDialog, false, strings.TrimPrefix(selections[0], fileDialogLocalPrefix))
+	}
+}
+
+/*
+openFileDialogParentDirectory allows you to open the parent of the directory
+currently shown by a file dialog. If the current directory is the root of
+its file system, then the request is ignored.
+*/
+func openFileDialogParentDirectory(fileDialog *fileDialogType) {
+	if fileDialog.isVirtual {
+		parentDirectoryPath := path.Dir(fileDialog.directoryPath)
+		if parentDirectoryPath == "." {
+			parentDirectoryPath = ""
+		}
+		if fileDialog.directoryPath != "" {
+			changeFileDialogDirectory(fileDialog, true, parentDirectoryPath)
+		}
+		return
+	}
+	changeFileDialogDirectory(fileDialog, false, filepath.Dir(fileDialog.directoryPath))
+}
+
+/*
+getFileDialogFilePath allows you to act on a file name entered or picked in
+a file dialog. If the file name is a directory, the directory is opened
+instead, and if it contains the wildcards '*' or '?', it replaces the
+filter patterns being used. Otherwise, the path of the file is returned.
+In addition, the following information should be noted:
+
+- The file name may also be a path, either absolute or relative to the
+current directory.
+
+- If the file name does not describe a file which can be picked, then ""
+is returned instead. For open dialogs, the file must exist. For save
+dialogs, the directory it is saved in must exist.
+*/
+func getFileDialogFilePath(fileDialog *fileDialogType, fileName string) string {
+	fileName = strings.TrimSpace(fileName)
+	if fileName == "" {
+		return ""
+	}
+	if strings.ContainsAny(fileName, "*?") {
+		fileDialog.filterPatterns = strings.Split(fileName, ";")
+		updateFileDialogFileList(fileDialog)
+		drawFileDialogLabels(fileDialog)
+		return ""
+	}
+	if fileName == ".." {
+		openFileDialogParentDirectory(fileDialog)
+		SetTextFieldValue(modalDialogLayerAlias, fileDialogFileNameAlias, "")
+		return ""
+	}
+	directoryPath, fileName := getFileDialogPathParts(fileDialog, fileName)
+	if fileName == "" {
+		changeFileDialogDirectory(fileDialog, fileDialog.isVirtual, directoryPath)
+		SetTextFieldValue(modalDialogLayerAlias, fileDialogFileNameAlias, "")
+		return ""
+	}
+	directoryEntries := fileDialog.directoryEntries
+	if directoryPath != fileDialog.directoryPath {
+		var err error
+		directoryEntries, err = getFileDialogDirectoryEntries(fileDialog.isVirtual, directoryPath)
+		if err != nil {
+			return ""
+		}
+	}
+	filePath := filepath.Join(directoryPath, fileName)
+	if fileDialog.isVirtual {
+		filePath = path.Join(directoryPath, fileName)
+	}
+	isFileFound := false
+	for _, currentDirectoryEntry := range directoryEntries {
+		if currentDirectoryEntry.name != fileName {
+			continue
+		}
+		if currentDirectoryEntry.isDirectory {
+			changeFileDialogDirectory(fileDialog, fileDialog.isVirtual, filePath)
+			SetTextFieldValue(modalDialogLayerAlias, fileDialogFileNameAlias, "")
+			return ""
+		}
+		isFileFound = true
+	}
+	if !isFileFound && !fileDialog.isSaveDialog {
+		return ""
+	}
+	return filePath
+}
+
+/*
+getFileDialogPathParts allows you to split a file name entered in a file
+dialog into the directory it belongs to and its base name. In addition, the
+following information should be noted:
+
+- A plain file name belongs to the current directory. If the file name
+contains a path separator, it is resolved as a path which is either
+absolute or relative to the current directory.
+
+- If the path resolves to the root of its file system, then "" is returned
+as the base name.
+*/
+func getFileDialogPathParts(fileDialog *fileDialogType, fileName string) (string, string) {
+	if fileDialog.isVirtual {
+		if !strings.Contains(fileName, "/") {
+			return fileDialog.directoryPath, fileName
+		}
+		if !strings.HasPrefix(fileName, "/") {
+			fileName = fileDialog.directoryPath + "/" + fileName
+		}
+		directoryPath, baseName := path.Split(strings.Trim(path.Clean("/"+fileName), "/"))
+		return strings.TrimSuffix(directoryPath, "/"), baseName
+	}
+	if !strings.ContainsAny(fileName, "/"+string(filepath.Separator)) && !filepath.IsAbs(fileName) {
+		return fileDialog.directoryPath, fileName
+	}
+	if !filepath.IsAbs(fileName) {
+		fileName = filepath.Join(fileDialog.directoryPath, fileName)
+	}
+	filePath := filepath.Clean(fileName)
+	if filepath.Dir(filePath) == filePath {
+		return filePath, ""
+	}
+	return filepath.Dir(filePath), filepath.Base(filePath)
+}
+
+/*
+isFileNameMatchingPatterns allows you to detect if a file name matches any
+of the wildcard patterns provided. Patterns are not case sensitive. If no
+patterns are provided, then all file names match.
+*/
+func isFileNameMatchingPatterns(fileName string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, currentPattern := range patterns {
+		isMatched, err := path.Match(strings.ToLower(strings.TrimSpace(currentPattern)), strings.ToLower(fileName))
+		if err == nil && isMatched {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+getFileSizeAsString allows you to obtain a file size as short text, using
+the units 'K', 'M', and 'G' for sizes too large to show in bytes.
+*/
+func getFileSizeAsString(size int64) string {
+	units := []string{"", "K", "M", "G"}
+	unitIndex := 0
+	for size >= 100000 && unitIndex < len(units)-1 {
+		size /= 1024
+		unitIndex++
+	}
+	return fmt.Sprintf("%d%s", size, units[unitIndex])
+}
+
+/*
+getTruncatedString allows you to shorten text so that it fits in the number
+of columns provided. Wide characters are counted as two columns.
+*/
+func getTruncatedString(text string, width int) string {
+	var truncatedText []rune
+	column := 0
+	for _, currentGraphemeCluster := range stringformat.GetGraphemeClusters([]rune(text)) {
+		graphemeClusterWidth := stringformat.GetGraphemeClusterWidth(currentGraphemeCluster)
+		if column+graphemeClusterWidth > width {
+			break
+		}
+		truncatedText = append(truncatedText, currentGraphemeCluster...)
+		column += graphemeClusterWidth
+	}
+	return string(truncatedText)
+}
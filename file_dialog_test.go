@@ -0,0 +1,89 @@
+package dosktop
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileDialogs(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(80, 25)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	injectKey := func(key tcell.Key, character rune, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventKey(key, character, modifierMask))
+	}
+	injectText := func(text string) {
+		for _, currentCharacter := range text {
+			injectKey(tcell.KeyRune, currentCharacter, tcell.ModNone)
+		}
+	}
+	err = mountVirtualFileSystem(BASE_DIRECTORY+"valid.zip", "TAFDRw==", "SampleScrambleKey")
+	assert.NoErrorf(test, err, "Failed to open a valid ZIP filesystem!")
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	buttonAlias, filePath := OpenFileDialog(NewTuiStyleEntry(), "Open", "", nil)
+	assert.Equalf(test, []string{constants.DialogButtonOk, "sample.png"}, []string{buttonAlias, filePath}, "Submitting the file list did not pick the highlighted file!")
+	assert.Falsef(test, memory.IsLayerExists(modalDialogLayerAlias), "The file dialog layer was not removed!")
+	injectKey(tcell.KeyTab, 0, tcell.ModNone)
+	injectText("myFolder-1/sample2.png")
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	buttonAlias, filePath = OpenFileDialog(NewTuiStyleEntry(), "Open", "", nil)
+	assert.Equalf(test, []string{constants.DialogButtonOk, "myFolder-1/sample2.png"}, []string{buttonAlias, filePath}, "A file could not be opened using a path relative to the current directory!")
+	injectKey(tcell.KeyTab, 0, tcell.ModNone)
+	injectText("/myFolder-1/myFolder-2")
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	injectText("sample3.png")
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	buttonAlias, filePath = OpenFileDialog(NewTuiStyleEntry(), "Open", "myFolder-1", nil)
+	assert.Equalf(test, []string{constants.DialogButtonOk, "myFolder-1/myFolder-2/sample3.png"}, []string{buttonAlias, filePath}, "Typing an absolute path to a directory did not open it!")
+	injectKey(tcell.KeyTab, 0, tcell.ModNone)
+	injectText("myFolder-1")
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	injectText("missing.png")
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	injectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	buttonAlias, filePath = OpenFileDialog(NewTuiStyleEntry(), "Open", "", []string{"*.PNG"})
+	assert.Equalf(test, []string{constants.DialogButtonCancel, ""}, []string{buttonAlias, filePath}, "A file which does not exist could be opened, or the dialog was not cancelled!")
+	injectKey(tcell.KeyBackspace2, 0, tcell.ModNone)
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	buttonAlias, filePath = OpenFileDialog(NewTuiStyleEntry(), "Open", "myFolder-1/myFolder-2", []string{"*.png"})
+	assert.Equalf(test, []string{constants.DialogButtonOk, "myFolder-1/sample2.png"}, []string{buttonAlias, filePath}, "Opening the parent directory with backspace did not work!")
+	UnmountVirtualFileSystem()
+	injectText("*.zip")
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	injectKey(tcell.KeyBacktab, 0, tcell.ModNone)
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	injectKey(tcell.KeyTab, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	buttonAlias, filePath = SaveFileDialog(NewTuiStyleEntry(), "Save", BASE_DIRECTORY, "", []string{"*.txt"})
+	expectedPath, _ := filepath.Abs(BASE_DIRECTORY + "valid.zip")
+	assert.Equalf(test, []string{constants.DialogButtonOk, expectedPath}, []string{buttonAlias, filePath}, "Changing the filter and highlighting a file did not fill in the file name!")
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	buttonAlias, filePath = SaveFileDialog(NewTuiStyleEntry(), "Save", BASE_DIRECTORY, "new.txt", nil)
+	expectedPath, _ = filepath.Abs(BASE_DIRECTORY + "new.txt")
+	assert.Equalf(test, []string{constants.DialogButtonOk, expectedPath}, []string{buttonAlias, filePath}, "A save dialog did not accept a new file name!")
+	expectedPath, _ = filepath.Abs(BASE_DIRECTORY + "valid.zip")
+	injectKey(tcell.KeyTab, 0, tcell.ModNone)
+	injectText(BASE_DIRECTORY + "missing.zip")
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	injectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	buttonAlias, filePath = OpenFileDialog(NewTuiStyleEntry(), "Open", "", nil)
+	assert.Equalf(test, []string{constants.DialogButtonCancel, ""}, []string{buttonAlias, filePath}, "A file which does not exist could be opened using a relative path!")
+	injectKey(tcell.KeyTab, 0, tcell.ModNone)
+	injectText(expectedPath)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	buttonAlias, filePath = OpenFileDialog(NewTuiStyleEntry(), "Open", "", nil)
+	assert.Equalf(test, []string{constants.DialogButtonOk, expectedPath}, []string{buttonAlias, filePath}, "A file could not be opened using an absolute path!")
+}
+
+func TestFileDialogHelpers(test *testing.T) {
+	assert.Truef(test, isFileNameMatchingPatterns("Image.PNG", []string{"*.txt", "*.png"}), "A file name was not matched without regard to case!")
+	assert.Falsef(test, isFileNameMatchingPatterns("image.jpg", []string{"*.png"}), "A file name was matched by the wrong pattern!")
+	assert.Truef(test, isFileNameMatchingPatterns("image.jpg", nil), "A file name was not matched when no patterns were provided!")
+	assert.Equalf(test, []string{"99999", "97K", "190M"}, []string{getFileSizeAsString(99999), getFileSizeAsString(100000), getFileSizeAsString(200000000)}, "File sizes were not formatted correctly!")
+	assert.Equalf(test, "ab", getTruncatedString("ab字", 3), "Wide characters were not truncated correctly!")
+}
@@ -0,0 +1,573 @@
+package dosktop
+
+import (
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"sort"
+)
+
+/*
+focusHistoryType is a structure used to remember which control currently has
+focus, and which control the mouse was last pressed over.
+*/
+type focusHistoryType struct {
+	layerAlias          string
+	controlAlias        string
+	pressedLayerAlias   string
+	pressedControlAlias string
+}
+
+/*
+focusHistory is a variable used to hold the control which currently has
+focus. It is only accessed by methods which read from the event queue, or
+which are called directly by your application.
+*/
+var focusHistory focusHistoryType
+
+/*
+SetFocus allows you to give focus to a control. The control with focus
+receives all keystrokes before your application does. For example, a
+focused button is activated when the user presses 'enter' or 'space'. In
+addition, the following information should be noted:
+
+- Focus also changes when the user presses 'tab' or 'shift+tab', or clicks
+on a control with the mouse. In those cases, focus events are returned by
+'PollEvent' and 'WaitForEvent' to report which control lost and gained
+focus.
+
+- If the control specified does not exist, a panic will be generated to
+fail as fast as possible.
+*/
+func SetFocus(layerAlias string, controlAlias string) {
+	controlEntry := memory.GetControl(layerAlias, controlAlias)
+	changeFocus(controlEntry)
+}
+
+/*
+ClearFocus allows you to remove focus from whichever control currently has
+it. If no control has focus, then no operation takes place.
+*/
+func ClearFocus() {
+	changeFocus(nil)
+}
+
+/*
+GetFocus allows you to obtain the layer alias and control alias of the
+control which currently has focus. If no control has focus, then empty
+strings are returned instead.
+*/
+func GetFocus() (string, string) {
+	if !memory.IsControlExists(focusHistory.layerAlias, focusHistory.controlAlias) {
+		return "", ""
+	}
+	return focusHistory.layerAlias, focusHistory.controlAlias
+}
+
+/*
+SetTabIndex allows you to change the order in which a control receives
+focus when the user presses 'tab'. In addition, the following information
+should be noted:
+
+- By default, controls receive focus in the order they were created.
+Controls with a lower tab index receive focus first.
+
+- Only controls which are visible and belong to the same managed window as
+the control currently focused take part in the tab order. This allows
+each window to behave like its own form.
+
+- If the control specified does not exist, a panic will be generated to
+fail as fast as possible.
+*/
+func SetTabIndex(layerAlias string, controlAlias string, tabIndex int) {
+	controlEntry := memory.GetControl(layerAlias, controlAlias)
+	controlEntry.TabIndex = tabIndex
+}
+
+/*
+addControl allows you to register a control on a text layer so that it can
+receive focus. If a control with the same alias already had focus, it keeps
+focus and is drawn accordingly.
+*/
+func addControl(layerAlias string, controlAlias string, controlType int) {
+	memory.AddControl(layerAlias, controlAlias, controlType)
+	if layerAlias == focusHistory.layerAlias && controlAlias == focusHistory.controlAlias {
+		setControlFocused(memory.GetControl(layerAlias, controlAlias), true)
+	}
+}
+
+/*
+deleteControl allows you to remove a control from the focus manager. If the
+control currently has focus, then no control will have focus afterwards.
+*/
+func deleteControl(layerAlias string, controlAlias string) {
+	if layerAlias == focusHistory.layerAlias && controlAlias == focusHistory.controlAlias {
+		focusHistory = focusHistoryType{}
+	}
+	memory.DeleteControl(layerAlias, controlAlias)
+}
+
+/*
+changeFocus allows you to move focus to the control provided, updating how
+the previous and new controls are drawn. The focus events describing the
+change are returned. If 'nil' is provided, focus is removed entirely.
+*/
+func changeFocus(controlEntry *memory.ControlEntryType) []memory.EventEntryType {
+	var focusEvents []memory.EventEntryType
+	previousLayerAlias, previousControlAlias := GetFocus()
+	if controlEntry != nil && controlEntry.LayerAlias == previousLayerAlias && controlEntry.ControlAlias == previousControlAlias {
+		return focusEvents
+	}
+	if previousControlAlias != "" {
+		previousControlEntry := memory.GetControl(previousLayerAlias, previousControlAlias)
+		setControlFocused(previousControlEntry, false)
+		focusEvents = append(focusEvents, newFocusEventEntry(previousControlEntry, false))
+	}
+	focusHistory.layerAlias = ""
+	focusHistory.controlAlias = ""
+	if controlEntry != nil {
+		focusHistory.layerAlias = controlEntry.LayerAlias
+		focusHistory.controlAlias = controlEntry.ControlAlias
+		setControlFocused(controlEntry, true)
+		focusEvents = append(focusEvents, newFocusEventEntry(controlEntry, true))
+	}
+	return focusEvents
+}
+
+/*
+moveFocus allows you to move focus to the next or previous control in the
+tab order. If no control has focus, then the first or last control receives
+focus instead. The focus events describing the change are returned.
+*/
+func moveFocus(isMovementBackwards bool) []memory.EventEntryType {
+	focusableControls := getFocusableControls()
+	if len(focusableControls) == 0 {
+		return nil
+	}
+	layerAlias, controlAlias := GetFocus()
+	focusedIndex := -1
+	for currentIndex, currentControlEntry := range focusableControls {
+		if currentControlEntry.LayerAlias == layerAlias && currentControlEntry.ControlAlias == controlAlias {
+			focusedIndex = currentIndex
+		}
+	}
+	nextIndex := 0
+	if isMovementBackwards {
+		nextIndex = len(focusableControls) - 1
+		if focusedIndex > 0 {
+			nextIndex = focusedIndex - 1
+		}
+	} else if focusedIndex >= 0 && focusedIndex < len(focusableControls)-1 {
+		nextIndex = focusedIndex + 1
+	}
+	return changeFocus(focusableControls[nextIndex])
+}
+
+/*
+getFocusableControls allows you to obtain all controls which can currently
+receive focus, sorted by their tab order. Only controls on visible text
+layers which belong to the same managed window as the focused control are
+included. While a modal dialog is open, only its controls are included.
+*/
+func getFocusableControls() []*memory.ControlEntryType {
+	var focusableControls []*memory.ControlEntryType
+	layerAlias, _ := GetFocus()
+	windowAlias := getWindowAliasForLayer(layerAlias)
+	for currentLayerAlias, currentControls := range memory.ControlMemory {
+		if !isLayerVisible(currentLayerAlias) || !isModalInputAllowed(currentLayerAlias) || (layerAlias != "" && getWindowAliasForLayer(currentLayerAlias) != windowAlias) {
+			continue
+		}
+		for _, currentControlEntry := range currentControls {
+			focusableControls = append(focusableControls, currentControlEntry)
+		}
+	}
+	sort.Slice(focusableControls, func(firstIndex int, secondIndex int) bool {
+		firstControlEntry := focusableControls[firstIndex]
+		secondControlEntry := focusableControls[secondIndex]
+		if firstControlEntry.TabIndex != secondControlEntry.TabIndex {
+			return firstControlEntry.TabIndex < secondControlEntry.TabIndex
+		}
+		if firstControlEntry.LayerAlias != secondControlEntry.LayerAlias {
+			return firstControlEntry.LayerAlias < secondControlEntry.LayerAlias
+		}
+		return firstControlEntry.ControlAlias < secondControlEntry.ControlAlias
+	})
+	return focusableControls
+}
+
+/*
+getFocusKeyEvents allows you to deliver a key event to the control which
+currently has focus. The events which should be reported in its place are
+returned. In addition, the following information should be noted:
+
+- The keys 'tab' and 'shift+tab' move focus between controls. If no
+controls can receive focus, they are reported unchanged.
+
+- The keys 'ctrl+pgup' and 'ctrl+pgdn' change the selected tab of the tab
+container which owns the focused control, if there is one.
+
+- If the focused control does not use the key, the key event is returned
+with the layer alias and control alias of the focused control filled in.
+*/
+func getFocusKeyEvents(eventEntry memory.EventEntryType) []memory.EventEntryType {
+	chord := eventEntry.KeyEvent.Chord
+	if chord == "tab" || chord == "shift+tab" {
+		focusEvents := moveFocus(chord == "shift+tab")
+		if len(getFocusableControls()) > 0 {
+			return focusEvents
+		}
+	}
+	layerAlias, controlAlias := GetFocus()
+	if controlAlias == "" {
+		return []memory.EventEntryType{eventEntry}
+	}
+	controlEntry := memory.GetControl(layerAlias, controlAlias)
+	eventEntry.KeyEvent.LayerAlias = layerAlias
+	eventEntry.KeyEvent.ControlAlias = controlAlias
+	if tabContainerControlEntry := getTabContainerForLayer(layerAlias); (chord == "ctrl+pgup" || chord == "ctrl+pgdn") && tabContainerControlEntry != nil && controlEntry.ControlType != constants.ControlTypeTabContainer {
+		return getTabContainerKeyEvents(tabContainerControlEntry, eventEntry)
+	}
+	switch controlEntry.ControlType {
+	case constants.ControlTypeButton:
+		if chord == "enter" || chord == "space" {
+			return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionClick)}
+		}
+	case constants.ControlTypeTextField:
+		return getTextFieldKeyEvents(controlEntry, eventEntry)
+	case constants.ControlTypeMenu:
+		return getMenuKeyEvents(controlEntry, eventEntry)
+	case constants.ControlTypeTextArea:
+		return getTextAreaKeyEvents(controlEntry, eventEntry)
+	case constants.ControlTypeListBox:
+		return getListBoxKeyEvents(controlEntry, eventEntry)
+	case constants.ControlTypeDataGrid:
+		return getDataGridKeyEvents(controlEntry, eventEntry)
+	case constants.ControlTypeTreeView:
+		return getTreeViewKeyEvents(controlEntry, eventEntry)
+	case constants.ControlTypeCheckBox, constants.ControlTypeToggleSwitch:
+		return getCheckBoxKeyEvents(controlEntry, eventEntry)
+	case constants.ControlTypeRadioButtonGroup:
+		return getRadioButtonGroupKeyEvents(controlEntry, eventEntry)
+	case constants.ControlTypeTabContainer:
+		return getTabContainerKeyEvents(controlEntry, eventEntry)
+	}
+	return []memory.EventEntryType{eventEntry}
+}
+
+/*
+getFocusMouseEvents allows you to process a mouse event for any control it
+affects. The events which should be reported in its place are returned. In
+addition, the following information should be noted:
+
+- Pressing the left mouse button over a control gives it focus. For text
+fields and text areas, the cursor is also moved to the location pressed, and
+for menus, list boxes, data grids, and tree views the item pressed is
+selected. Pressing a tab header of a tab container selects that tab.
+
+- Dragging the mouse after pressing it over a text area selects text, and
+dragging it along the scroll bar of a list box, data grid, or tree view
+scrolls it. Moving the mouse wheel over a text area, list box, data grid,
+tree view, or the tab headers of a tab container scrolls it.
+
+- Releasing the left mouse button over the same button it was pressed on
+reports a click event for that button. Releasing it over the same check
+box, toggle switch, or radio button group changes its value. Likewise, releasing it over a menu
+item of the same menu reports a submit event for that menu, and releasing
+it over a list box item, data grid row, or tree view node after a double
+click reports a submit event for that control.
+*/
+func getFocusMouseEvents(eventEntry memory.EventEntryType) []memory.EventEntryType {
+	mouseEvent := eventEntry.MouseEvent
+	if mouseEvent.Action == constants.MouseActionPress {
+		focusHistory.pressedLayerAlias = ""
+		focusHistory.pressedControlAlias = ""
+		controlEntry := getControlAtLocation(mouseEvent.LayerAlias, mouseEvent.XLocation, mouseEvent.YLocation)
+		if controlEntry == nil || mouseEvent.ButtonPressed != 1 {
+			return []memory.EventEntryType{eventEntry}
+		}
+		focusHistory.pressedLayerAlias = controlEntry.LayerAlias
+		focusHistory.pressedControlAlias = controlEntry.ControlAlias
+		mouseEvents := append(changeFocus(controlEntry), eventEntry)
+		layerXLocation, layerYLocation := getLayerScreenLocation(memory.GetLayer(controlEntry.LayerAlias))
+		switch controlEntry.ControlType {
+		case constants.ControlTypeTextField:
+			if selectTextFieldCompletionByLocation(controlEntry, mouseEvent.YLocation-layerYLocation) {
+				mouseEvents = append(mouseEvents, newControlEventEntry(controlEntry, constants.ControlActionChange))
+			} else {
+				setTextFieldCursorByLocation(controlEntry, mouseEvent.XLocation-layerXLocation)
+			}
+		case constants.ControlTypeTextArea:
+			setTextAreaCursorByLocation(controlEntry, mouseEvent.XLocation-layerXLocation, mouseEvent.YLocation-layerYLocation, false)
+		case constants.ControlTypeMenu:
+			itemIndex := getMenuItemAtLocation(controlEntry, mouseEvent.XLocation-layerXLocation, mouseEvent.YLocation-layerYLocation)
+			if itemIndex != constants.NullSelectionIndex && selectMenuItem(controlEntry.LayerAlias, controlEntry.ControlAlias, itemIndex) {
+				mouseEvents = append(mouseEvents, newControlEventEntry(controlEntry, constants.ControlActionChange))
+			}
+		case constants.ControlTypeListBox:
+			mouseEvents = append(mouseEvents, getListBoxMouseEvents(controlEntry, mouseEvent, mouseEvent.XLocation-layerXLocation, mouseEvent.YLocation-layerYLocation)...)
+		case constants.ControlTypeDataGrid:
+			mouseEvents = append(mouseEvents, getDataGridMouseEvents(controlEntry, mouseEvent, mouseEvent.XLocation-layerXLocation, mouseEvent.YLocation-layerYLocation)...)
+		case constants.ControlTypeTreeView:
+			mouseEvents = append(mouseEvents, getTreeViewMouseEvents(controlEntry, mouseEvent.XLocation-layerXLocation, mouseEvent.YLocation-layerYLocation)...)
+		case constants.ControlTypeTabContainer:
+			mouseEvents = append(mouseEvents, getTabContainerMouseEvents(controlEntry, mouseEvent.XLocation-layerXLocation, mouseEvent.YLocation-layerYLocation)...)
+		}
+		return mouseEvents
+	}
+	if mouseEvent.Action == constants.MouseActionDrag && focusHistory.pressedControlAlias != "" {
+		controlEntry := memory.GetControl(focusHistory.pressedLayerAlias, focusHistory.pressedControlAlias)
+		layerXLocation, layerYLocation := getLayerScreenLocation(memory.GetLayer(controlEntry.LayerAlias))
+		switch controlEntry.ControlType {
+		case constants.ControlTypeTextArea:
+			setTextAreaCursorByLocation(controlEntry, mouseEvent.XLocation-layerXLocation, mouseEvent.YLocation-layerYLocation, true)
+		case constants.ControlTypeListBox:
+			if isListBoxScrollBarAtLocation(memory.GetListBox(controlEntry.LayerAlias, controlEntry.ControlAlias), mouseEvent.XLocation-layerXLocation) {
+				scrollListBoxByLocation(controlEntry, mouseEvent.YLocation-layerYLocation)
+			}
+		case constants.ControlTypeDataGrid:
+			if isDataGridScrollBarAtLocation(memory.GetDataGrid(controlEntry.LayerAlias, controlEntry.ControlAlias), mouseEvent.XLocation-layerXLocation, mouseEvent.YLocation-layerYLocation) {
+				scrollDataGridByLocation(controlEntry, mouseEvent.YLocation-layerYLocation)
+			}
+		case constants.ControlTypeTreeView:
+			if isTreeViewScrollBarAtLocation(memory.GetTreeView(controlEntry.LayerAlias, controlEntry.ControlAlias), mouseEvent.XLocation-layerXLocation) {
+				scrollTreeViewByLocation(controlEntry, mouseEvent.YLocation-layerYLocation)
+			}
+		}
+		return []memory.EventEntryType{eventEntry}
+	}
+	if mouseEvent.Action == constants.MouseActionWheel {
+		controlEntry := getControlAtLocation(mouseEvent.LayerAlias, mouseEvent.XLocation, mouseEvent.YLocation)
+		numberOfRows := 0
+		if mouseEvent.WheelState == "Up" {
+			numberOfRows = -1
+		} else if mouseEvent.WheelState == "Down" {
+			numberOfRows = 1
+		}
+		if controlEntry != nil && controlEntry.ControlType == constants.ControlTypeTextArea {
+			scrollTextArea(controlEntry, numberOfRows)
+		}
+		if controlEntry != nil && controlEntry.ControlType == constants.ControlTypeListBox {
+			scrollListBox(controlEntry, numberOfRows)
+		}
+		if controlEntry != nil && controlEntry.ControlType == constants.ControlTypeDataGrid {
+			scrollDataGrid(controlEntry, numberOfRows)
+		}
+		if controlEntry != nil && controlEntry.ControlType == constants.ControlTypeTreeView {
+			scrollTreeView(controlEntry, numberOfRows)
+		}
+		if controlEntry != nil && controlEntry.ControlType == constants.ControlTypeTabContainer {
+			scrollTabContainer(controlEntry, numberOfRows)
+		}
+		return []memory.EventEntryType{eventEntry}
+	}
+	if mouseEvent.Action == constants.MouseActionRelease && focusHistory.pressedControlAlias != "" {
+		mouseEvents := []memory.EventEntryType{eventEntry}
+		controlEntry := getControlAtLocation(mouseEvent.LayerAlias, mouseEvent.XLocation, mouseEvent.YLocation)
+		if controlEntry != nil && controlEntry.LayerAlias == focusHistory.pressedLayerAlias && controlEntry.ControlAlias == focusHistory.pressedControlAlias {
+			layerXLocation, layerYLocation := getLayerScreenLocation(memory.GetLayer(controlEntry.LayerAlias))
+			switch controlEntry.ControlType {
+			case constants.ControlTypeButton:
+				mouseEvents = append(mouseEvents, newControlEventEntry(controlEntry, constants.ControlActionClick))
+			case constants.ControlTypeCheckBox, constants.ControlTypeToggleSwitch:
+				mouseEvents = append(mouseEvents, getCheckBoxMouseEvents(controlEntry, mouseEvent.XLocation, mouseEvent.YLocation)...)
+			case constants.ControlTypeRadioButtonGroup:
+				mouseEvents = append(mouseEvents, getRadioButtonGroupMouseEvents(controlEntry, mouseEvent.XLocation, mouseEvent.YLocation)...)
+			case constants.ControlTypeMenu:
+				if getMenuItemAtLocation(controlEntry, mouseEvent.XLocation-layerXLocation, mouseEvent.YLocation-layerYLocation) != constants.NullSelectionIndex {
+					mouseEvents = append(mouseEvents, newControlEventEntry(controlEntry, constants.ControlActionSubmit))
+				}
+			case constants.ControlTypeListBox:
+				listBoxEntry := memory.GetListBox(controlEntry.LayerAlias, controlEntry.ControlAlias)
+				if mouseEvent.ClickCount == 2 && getListBoxItemAtLocation(listBoxEntry, mouseEvent.XLocation-layerXLocation, mouseEvent.YLocation-layerYLocation) != constants.NullSelectionIndex &&
+					!isListBoxScrollBarAtLocation(listBoxEntry, mouseEvent.XLocation-layerXLocation) {
+					mouseEvents = append(mouseEvents, newControlEventEntry(controlEntry, constants.ControlActionSubmit))
+				}
+			case constants.ControlTypeDataGrid:
+				if mouseEvent.ClickCount == 2 && getDataGridRowAtLocation(memory.GetDataGrid(controlEntry.LayerAlias, controlEntry.ControlAlias), mouseEvent.XLocation-layerXLocation, mouseEvent.YLocation-layerYLocation) != constants.NullSelectionIndex {
+					mouseEvents = append(mouseEvents, newControlEventEntry(controlEntry, constants.ControlActionSubmit))
+				}
+			case constants.ControlTypeTreeView:
+				treeViewEntry := memory.GetTreeView(controlEntry.LayerAlias, controlEntry.ControlAlias)
+				if mouseEvent.ClickCount == 2 && getTreeViewRowAtLocation(treeViewEntry, getTreeViewRows(treeViewEntry), mouseEvent.XLocation-layerXLocation, mouseEvent.YLocation-layerYLocation) != constants.NullSelectionIndex &&
+					!isTreeViewScrollBarAtLocation(treeViewEntry, mouseEvent.XLocation-layerXLocation) {
+					mouseEvents = append(mouseEvents, newControlEventEntry(controlEntry, constants.ControlActionSubmit))
+				}
+			}
+		}
+		focusHistory.pressedLayerAlias = ""
+		focusHistory.pressedControlAlias = ""
+		return mouseEvents
+	}
+	return []memory.EventEntryType{eventEntry}
+}
+
+/*
+getControlAtLocation allows you to find the control on a text layer at the
+specified terminal location. If no control is at that location, then 'nil'
+is returned instead.
+*/
+func getControlAtLocation(layerAlias string, xLocation int, yLocation int) *memory.ControlEntryType {
+	if !memory.IsLayerExists(layerAlias) {
+		return nil
+	}
+	layerXLocation, layerYLocation := getLayerScreenLocation(memory.GetLayer(layerAlias))
+	for _, currentControlEntry := range memory.ControlMemory[layerAlias] {
+		controlRegion := getControlRegion(currentControlEntry)
+		if !controlRegion.GetIntersection(memory.NewRegionEntry(xLocation-layerXLocation, yLocation-layerYLocation, 1, 1)).IsEmpty() {
+			return currentControlEntry
+		}
+	}
+	return nil
+}
+
+/*
+getControlRegion allows you to obtain the area of its text layer which a
+control occupies. If the control has no area, then an empty region is
+returned.
+*/
+func getControlRegion(controlEntry *memory.ControlEntryType) memory.RegionEntryType {
+	switch controlEntry.ControlType {
+	case constants.ControlTypeButton:
+		if buttonEntry, isButtonExists := memory.ButtonMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isButtonExists {
+			return getButtonRegion(buttonEntry)
+		}
+	case constants.ControlTypeTextField:
+		if textFieldEntry, isTextFieldExists := memory.TextFieldMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isTextFieldExists {
+			return memory.NewRegionEntry(textFieldEntry.XLocation, textFieldEntry.YLocation, textFieldEntry.Width, 1+getTextFieldCompletionListHeight(textFieldEntry))
+		}
+	case constants.ControlTypeMenu:
+		if menuEntry, isMenuExists := memory.MenuMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isMenuExists {
+			return memory.NewRegionEntry(menuEntry.XLocation, menuEntry.YLocation, menuEntry.Width, menuEntry.Height)
+		}
+	case constants.ControlTypeTextArea:
+		if textAreaEntry, isTextAreaExists := memory.TextAreaMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isTextAreaExists {
+			return memory.NewRegionEntry(textAreaEntry.XLocation, textAreaEntry.YLocation, textAreaEntry.Width, textAreaEntry.Height)
+		}
+	case constants.ControlTypeListBox:
+		if listBoxEntry, isListBoxExists := memory.ListBoxMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isListBoxExists {
+			return memory.NewRegionEntry(listBoxEntry.XLocation, listBoxEntry.YLocation, listBoxEntry.Width, listBoxEntry.Height)
+		}
+	case constants.ControlTypeDataGrid:
+		if dataGridEntry, isDataGridExists := memory.DataGridMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isDataGridExists {
+			return memory.NewRegionEntry(dataGridEntry.XLocation, dataGridEntry.YLocation, dataGridEntry.Width, dataGridEntry.Height)
+		}
+	case constants.ControlTypeTreeView:
+		if treeViewEntry, isTreeViewExists := memory.TreeViewMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isTreeViewExists {
+			return memory.NewRegionEntry(treeViewEntry.XLocation, treeViewEntry.YLocation, treeViewEntry.Width, treeViewEntry.Height)
+		}
+	case constants.ControlTypeCheckBox, constants.ControlTypeToggleSwitch:
+		if checkBoxEntry, isCheckBoxExists := memory.CheckBoxMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isCheckBoxExists {
+			return getCheckBoxRegion(checkBoxEntry)
+		}
+	case constants.ControlTypeRadioButtonGroup:
+		if radioButtonGroupEntry, isRadioButtonGroupExists := memory.RadioButtonGroupMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isRadioButtonGroupExists {
+			return getRadioButtonGroupRegion(radioButtonGroupEntry)
+		}
+	case constants.ControlTypeTabContainer:
+		if tabContainerEntry, isTabContainerExists := memory.TabContainerMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isTabContainerExists {
+			return memory.NewRegionEntry(tabContainerEntry.XLocation, tabContainerEntry.YLocation, tabContainerEntry.Width, tabContainerEntry.Height)
+		}
+	}
+	return memory.NewRegionEntry(0, 0, 0, 0)
+}
+
+/*
+setControlFocused allows you to update a control so that it is drawn with
+or without focus.
+*/
+func setControlFocused(controlEntry *memory.ControlEntryType, isFocused bool) {
+	switch controlEntry.ControlType {
+	case constants.ControlTypeButton:
+		if buttonEntry, isButtonExists := memory.ButtonMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isButtonExists {
+			buttonEntry.IsSelected = isFocused
+			markButtonAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+		}
+	case constants.ControlTypeTextField:
+		if textFieldEntry, isTextFieldExists := memory.TextFieldMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isTextFieldExists {
+			markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+			textFieldEntry.IsFocused = isFocused
+			if !isFocused {
+				closeTextFieldCompletion(textFieldEntry)
+			}
+			markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+		}
+	case constants.ControlTypeMenu:
+		if menuEntry, isMenuExists := memory.MenuMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isMenuExists {
+			menuEntry.IsFocused = isFocused
+			markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+		}
+	case constants.ControlTypeTextArea:
+		if textAreaEntry, isTextAreaExists := memory.TextAreaMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isTextAreaExists {
+			textAreaEntry.IsFocused = isFocused
+			markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+		}
+	case constants.ControlTypeListBox:
+		if listBoxEntry, isListBoxExists := memory.ListBoxMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isListBoxExists {
+			listBoxEntry.IsFocused = isFocused
+			markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+		}
+	case constants.ControlTypeDataGrid:
+		if dataGridEntry, isDataGridExists := memory.DataGridMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isDataGridExists {
+			dataGridEntry.IsFocused = isFocused
+			markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+		}
+	case constants.ControlTypeTreeView:
+		if treeViewEntry, isTreeViewExists := memory.TreeViewMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isTreeViewExists {
+			treeViewEntry.IsFocused = isFocused
+			markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+		}
+	case constants.ControlTypeCheckBox, constants.ControlTypeToggleSwitch:
+		if checkBoxEntry, isCheckBoxExists := memory.CheckBoxMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isCheckBoxExists {
+			checkBoxEntry.IsFocused = isFocused
+			markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+		}
+	case constants.ControlTypeRadioButtonGroup:
+		if radioButtonGroupEntry, isRadioButtonGroupExists := memory.RadioButtonGroupMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isRadioButtonGroupExists {
+			radioButtonGroupEntry.IsFocused = isFocused
+			markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+		}
+	case constants.ControlTypeTabContainer:
+		if tabContainerEntry, isTabContainerExists := memory.TabContainerMemory[controlEntry.LayerAlias][controlEntry.ControlAlias]; isTabContainerExists {
+			tabContainerEntry.IsFocused = isFocused
+			markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+		}
+	}
+}
+
+/*
+markControlAsDirty allows you to flag the area of a text layer covered by a
+control as modified, so that the control is redrawn the next time the
+display is updated. If the control or the text layer it belongs to does not
+exist, then the request will simply be ignored.
+*/
+func markControlAsDirty(layerAlias string, controlAlias string) {
+	if !memory.IsControlExists(layerAlias, controlAlias) || !memory.IsLayerExists(layerAlias) {
+		return
+	}
+	controlRegion := getControlRegion(memory.GetControl(layerAlias, controlAlias))
+	memory.GetLayer(layerAlias).MarkDirtyRegion(controlRegion.XLocation, controlRegion.YLocation, controlRegion.Width, controlRegion.Height)
+}
+
+/*
+newFocusEventEntry allows you to create a focus event for the control
+provided.
+*/
+func newFocusEventEntry(controlEntry *memory.ControlEntryType, isFocused bool) memory.EventEntryType {
+	eventEntry := newEventEntry(constants.EventTypeFocus)
+	eventEntry.FocusEvent.IsFocused = isFocused
+	eventEntry.FocusEvent.LayerAlias = controlEntry.LayerAlias
+	eventEntry.FocusEvent.ControlAlias = controlEntry.ControlAlias
+	return eventEntry
+}
+
+/*
+newControlEventEntry allows you to create a control event for the control
+provided.
+*/
+func newControlEventEntry(controlEntry *memory.ControlEntryType, controlAction int) memory.EventEntryType {
+	eventEntry := newEventEntry(constants.EventTypeControl)
+	eventEntry.ControlEvent.LayerAlias = controlEntry.LayerAlias
+	eventEntry.ControlEvent.ControlAlias = controlEntry.ControlAlias
+	eventEntry.ControlEvent.ControlType = controlEntry.ControlType
+	eventEntry.ControlEvent.Action = controlAction
+	return eventEntry
+}
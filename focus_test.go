@@ -0,0 +1,75 @@
+package dosktop
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestFocusTabOrder(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Form", 0, 0, 20, 10, 1, "")
+	AddButton("Form", "First", "One", NewTuiStyleEntry(), 0, 0, 6, 3)
+	AddButton("Form", "Second", "Two", NewTuiStyleEntry(), 0, 3, 6, 3)
+	AddButton("Form", "Third", "Three", NewTuiStyleEntry(), 0, 6, 6, 3)
+	SetTabIndex("Form", "Third", -1)
+	simulationScreen.InjectKey(tcell.KeyTab, 0, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyTab, 0, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyBacktab, 0, tcell.ModNone)
+	expectedEvents := [][]interface{}{
+		{true, "Third"},
+		{false, "Third"},
+		{true, "First"},
+		{false, "First"},
+		{true, "Third"},
+	}
+	for _, currentExpectedEvent := range expectedEvents {
+		eventEntry := WaitForEvent()
+		assert.Equalf(test, constants.EventTypeFocus, eventEntry.EventType, "A focus event was expected!")
+		assert.Equalf(test, currentExpectedEvent, []interface{}{eventEntry.FocusEvent.IsFocused, eventEntry.FocusEvent.ControlAlias}, "Focus did not follow the tab order!")
+	}
+	assert.Equalf(test, []bool{false, false, true}, []bool{memory.GetButton("Form", "First").IsSelected, memory.GetButton("Form", "Second").IsSelected, memory.GetButton("Form", "Third").IsSelected}, "Only the focused button should be selected!")
+	simulationScreen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyRune, 'x', tcell.ModNone)
+	eventEntry := WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.EventTypeControl, "Third", constants.ControlActionClick}, []interface{}{eventEntry.EventType, eventEntry.ControlEvent.ControlAlias, eventEntry.ControlEvent.Action}, "Pressing enter did not click the focused button!")
+	eventEntry = WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.EventTypeKey, "Form", "Third"}, []interface{}{eventEntry.EventType, eventEntry.KeyEvent.LayerAlias, eventEntry.KeyEvent.ControlAlias}, "An unused key was not reported with the focused control!")
+	DeleteButton("Form", "Third")
+	layerAlias, controlAlias := GetFocus()
+	assert.Equalf(test, []string{"", ""}, []string{layerAlias, controlAlias}, "A deleted button still has focus!")
+	assert.Panicsf(test, func() { SetFocus("Form", "Third") }, "Focusing a control which does not exist did not panic!")
+}
+
+func TestFocusMouseAndWindows(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(40, 20)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Desktop", 0, 0, 40, 20, 1, "")
+	AddButton("Desktop", "DesktopButton", "Desk", NewTuiStyleEntry(), 1, 1, 8, 3)
+	AddWindow("MyWindow", "Title", NewTuiStyleEntry(), 20, 5, 15, 10, 2, "")
+	AddButton("MyWindow", "WindowButton", "Ok", NewTuiStyleEntry(), 2, 2, 6, 3)
+	simulationScreen.InjectMouse(3, 2, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(3, 2, tcell.ButtonNone, tcell.ModNone)
+	var receivedEvents [][]interface{}
+	for len(receivedEvents) < 2 {
+		eventEntry := WaitForEvent()
+		if eventEntry.EventType == constants.EventTypeFocus {
+			receivedEvents = append(receivedEvents, []interface{}{constants.EventTypeFocus, eventEntry.FocusEvent.ControlAlias})
+		} else if eventEntry.EventType == constants.EventTypeControl {
+			receivedEvents = append(receivedEvents, []interface{}{constants.EventTypeControl, eventEntry.ControlEvent.ControlAlias})
+		}
+	}
+	assert.Equalf(test, [][]interface{}{{constants.EventTypeFocus, "DesktopButton"}, {constants.EventTypeControl, "DesktopButton"}}, receivedEvents, "Clicking a button did not focus and click it!")
+	SetFocus("MyWindow", "WindowButton")
+	simulationScreen.InjectKey(tcell.KeyTab, 0, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyRune, 'x', tcell.ModNone)
+	eventEntry := WaitForEvent()
+	assert.Equalf(test, constants.EventTypeKey, eventEntry.EventType, "Tab should not move focus outside of the focused window!")
+	layerAlias, controlAlias := GetFocus()
+	assert.Equalf(test, []string{"MyWindow", "WindowButton"}, []string{layerAlias, controlAlias}, "Focus left the window containing the focused control!")
+}
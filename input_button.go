@@ -12,21 +12,14 @@ var buttonHistory buttonHistoryType
 /*
 getButtonClickIdentifier allows you to obtain the layer alias and the button
 alias for the text cell currently under the mouse cursor. This is useful
-for determining which button button the user has clicked (if any).
+for determining which button the user has clicked (if any).
  */
 func getButtonClickIdentifier(mouseXLocation int, mouseYLocation int) (string, string) {
-	buttonAlias := ""
-	layerAlias := ""
-	layerEntry := commonResource.screenLayer
-	mouseYLocationOnLayer := mouseYLocation - layerEntry.ScreenYLocation
-	mouseXLocationOnLayer := mouseXLocation - layerEntry.ScreenXLocation
-	if mouseYLocationOnLayer >= 0 && mouseXLocationOnLayer >= 0 &&
-		mouseYLocationOnLayer < len(layerEntry.CharacterMemory) && mouseXLocationOnLayer < len(layerEntry.CharacterMemory[0]) {
-		characterEntry := layerEntry.CharacterMemory[mouseYLocation-layerEntry.ScreenYLocation][mouseXLocation-layerEntry.ScreenXLocation]
-		buttonAlias = characterEntry.AttributeEntry.CellAlias
-		layerAlias = characterEntry.LayerAlias
+	characterEntry, isCharacterAvailable := getScreenCharacterEntry(mouseXLocation, mouseYLocation)
+	if !isCharacterAvailable {
+		return "", ""
 	}
-	return layerAlias, buttonAlias
+	return characterEntry.LayerAlias, characterEntry.AttributeEntry.CellAlias
 }
 
 /*
@@ -38,16 +31,21 @@ func updateButtonStates() {
 	mouseXLocation, mouseYLocation, buttonPressed, _ := memory.MouseMemory.GetMouseStatus()
 	layerAlias, buttonAlias := getButtonClickIdentifier(mouseXLocation, mouseYLocation)
 	if buttonPressed != 0 {
-		if buttonAlias != "" {
-			memory.ButtonMemory[layerAlias][buttonAlias].IsPressed = true
+		if buttonEntry, isButtonExists := memory.ButtonMemory[layerAlias][buttonAlias]; isButtonExists {
+			buttonEntry.IsPressed = true
+			markButtonAsDirty(layerAlias, buttonAlias)
 			buttonHistory.layerAlias = layerAlias
 			buttonHistory.buttonAlias = buttonAlias
-			UpdateDisplay()
+			updateDisplay()
 		}
 	} else {
 		if buttonHistory.buttonAlias != "" {
-			memory.ButtonMemory[buttonHistory.layerAlias][buttonHistory.buttonAlias].IsPressed = false
-			UpdateDisplay()
+			if buttonEntry, isButtonExists := memory.ButtonMemory[buttonHistory.layerAlias][buttonHistory.buttonAlias]; isButtonExists {
+				buttonEntry.IsPressed = false
+				markButtonAsDirty(buttonHistory.layerAlias, buttonHistory.buttonAlias)
+			}
+			buttonHistory = buttonHistoryType{}
+			updateDisplay()
 		}
 	}
 }
@@ -0,0 +1,220 @@
+package dosktop
+
+import (
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+)
+
+/*
+AddCheckBox allows you to add a check box with a label to a text layer. In
+addition, the following information should be noted:
+
+- Check boxes are not drawn physically to the text layer provided. Instead
+they are rendered to the terminal at the same time when the text layer is
+rendered, in the same way as buttons.
+
+- Check boxes can receive focus. A focused check box has its label
+underlined, and is checked or unchecked when the user presses 'enter' or
+'space'. Clicking anywhere on the check box or its label also checks or
+unchecks it. Every time the user changes a check box, a control event with
+the action 'ControlActionChange' is returned. The current value can then be
+obtained with 'IsCheckBoxChecked'.
+*/
+func AddCheckBox(layerAlias string, checkBoxAlias string, label string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, isChecked bool) {
+	memory.AddCheckBox(layerAlias, checkBoxAlias, label, styleEntry, xLocation, yLocation, isChecked, false)
+	addControl(layerAlias, checkBoxAlias, constants.ControlTypeCheckBox)
+	markControlAsDirty(layerAlias, checkBoxAlias)
+}
+
+/*
+DeleteCheckBox allows you to remove a check box from a text layer. In
+addition, the following information should be noted:
+
+- If you attempt to delete a check box which does not exist, then the
+request will simply be ignored.
+*/
+func DeleteCheckBox(layerAlias string, checkBoxAlias string) {
+	if !memory.IsCheckBoxExists(layerAlias, checkBoxAlias) {
+		return
+	}
+	markControlAsDirty(layerAlias, checkBoxAlias)
+	deleteControl(layerAlias, checkBoxAlias)
+	memory.DeleteCheckBox(layerAlias, checkBoxAlias)
+}
+
+/*
+IsCheckBoxChecked allows you to detect if a check box is checked. If the
+check box does not exist, then a panic will be generated to fail as fast as
+possible.
+*/
+func IsCheckBoxChecked(layerAlias string, checkBoxAlias string) bool {
+	return memory.GetCheckBox(layerAlias, checkBoxAlias).IsChecked
+}
+
+/*
+SetCheckBoxChecked allows you to check or uncheck a check box. No control
+event is returned, since the change was not made by the user. If the check
+box does not exist, then a panic will be generated to fail as fast as
+possible.
+*/
+func SetCheckBoxChecked(layerAlias string, checkBoxAlias string, isChecked bool) {
+	memory.GetCheckBox(layerAlias, checkBoxAlias).IsChecked = isChecked
+	markControlAsDirty(layerAlias, checkBoxAlias)
+}
+
+/*
+AddToggleSwitch allows you to add an on/off switch with a label to a text
+layer. Toggle switches behave exactly like check boxes, but are drawn as a
+switch which reads 'ON' or 'OFF'. In addition, the following information
+should be noted:
+
+- Toggle switches are not drawn physically to the text layer provided.
+Instead they are rendered to the terminal at the same time when the text
+layer is rendered, in the same way as buttons.
+
+- Toggle switches can receive focus. A focused toggle switch has its label
+underlined, and is switched on or off when the user presses 'enter' or
+'space'. Clicking anywhere on the toggle switch or its label also switches
+it. Every time the user changes a toggle switch, a control event with the
+action 'ControlActionChange' is returned. The current value can then be
+obtained with 'IsToggleSwitchOn'.
+*/
+func AddToggleSwitch(layerAlias string, toggleSwitchAlias string, label string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, isOn bool) {
+	memory.AddCheckBox(layerAlias, toggleSwitchAlias, label, styleEntry, xLocation, yLocation, isOn, true)
+	addControl(layerAlias, toggleSwitchAlias, constants.ControlTypeToggleSwitch)
+	markControlAsDirty(layerAlias, toggleSwitchAlias)
+}
+
+/*
+DeleteToggleSwitch allows you to remove a toggle switch from a text layer.
+In addition, the following information should be noted:
+
+- If you attempt to delete a toggle switch which does not exist, then the
+request will simply be ignored.
+*/
+func DeleteToggleSwitch(layerAlias string, toggleSwitchAlias string) {
+	DeleteCheckBox(layerAlias, toggleSwitchAlias)
+}
+
+/*
+IsToggleSwitchOn allows you to detect if a toggle switch is on. If the
+toggle switch does not exist, then a panic will be generated to fail as
+fast as possible.
+*/
+func IsToggleSwitchOn(layerAlias string, toggleSwitchAlias string) bool {
+	return IsCheckBoxChecked(layerAlias, toggleSwitchAlias)
+}
+
+/*
+SetToggleSwitchOn allows you to switch a toggle switch on or off. No
+control event is returned, since the change was not made by the user. If
+the toggle switch does not exist, then a panic will be generated to fail as
+fast as possible.
+*/
+func SetToggleSwitchOn(layerAlias string, toggleSwitchAlias string, isOn bool) {
+	SetCheckBoxChecked(layerAlias, toggleSwitchAlias, isOn)
+}
+
+/*
+getCheckBoxKeyEvents allows you to update a check box or toggle switch with
+a key event. The events which should be reported in its place are returned.
+If the key is not used by the check box, then the key event itself is
+returned.
+*/
+func getCheckBoxKeyEvents(controlEntry *memory.ControlEntryType, eventEntry memory.EventEntryType) []memory.EventEntryType {
+	if eventEntry.KeyEvent.Chord != "enter" && eventEntry.KeyEvent.Chord != "space" {
+		return []memory.EventEntryType{eventEntry}
+	}
+	return getCheckBoxToggleEvents(controlEntry)
+}
+
+/*
+getCheckBoxMouseEvents allows you to update a check box or toggle switch
+with a mouse release at the specified terminal location. The check box is
+only changed if the text cell under the mouse was drawn by it. The events
+which should be reported are returned.
+*/
+func getCheckBoxMouseEvents(controlEntry *memory.ControlEntryType, xLocation int, yLocation int) []memory.EventEntryType {
+	layerAlias, cellAlias := getButtonClickIdentifier(xLocation, yLocation)
+	if layerAlias != controlEntry.LayerAlias || cellAlias != controlEntry.ControlAlias {
+		return nil
+	}
+	return getCheckBoxToggleEvents(controlEntry)
+}
+
+/*
+getCheckBoxToggleEvents allows you to check or uncheck a check box for the
+user. The change event which should be reported is returned.
+*/
+func getCheckBoxToggleEvents(controlEntry *memory.ControlEntryType) []memory.EventEntryType {
+	checkBoxEntry := memory.GetCheckBox(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	checkBoxEntry.IsChecked = !checkBoxEntry.IsChecked
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionChange)}
+}
+
+/*
+getCheckBoxRegion allows you to obtain the area of a text layer covered by a
+check box or toggle switch, including its label.
+*/
+func getCheckBoxRegion(checkBoxEntry *memory.CheckBoxEntryType) memory.RegionEntryType {
+	labelWidth := getGraphemeClustersWidth(stringformat.GetGraphemeClusters([]rune(checkBoxEntry.Label)))
+	return memory.NewRegionEntry(checkBoxEntry.XLocation, checkBoxEntry.YLocation, len(getCheckBoxIndicator(checkBoxEntry))+1+labelWidth, 1)
+}
+
+/*
+getCheckBoxIndicator allows you to obtain the characters drawn to the left
+of the label of a check box or toggle switch to show its current value.
+*/
+func getCheckBoxIndicator(checkBoxEntry *memory.CheckBoxEntryType) []rune {
+	if checkBoxEntry.IsToggleSwitch {
+		if checkBoxEntry.IsChecked {
+			return []rune("[ON ]")
+		}
+		return []rune("[OFF]")
+	}
+	if checkBoxEntry.IsChecked {
+		return []rune{'[', constants.CharCheckMark, ']'}
+	}
+	return []rune("[ ]")
+}
+
+/*
+drawCheckBoxesOnLayer allows you to draw all check boxes and toggle switches
+on a given text layer entry.
+*/
+func drawCheckBoxesOnLayer(layerEntry memory.LayerEntryType) {
+	for _, currentCheckBoxEntry := range memory.CheckBoxMemory[layerEntry.LayerAlias] {
+		drawCheckBox(&layerEntry, currentCheckBoxEntry)
+	}
+}
+
+/*
+drawCheckBox allows you to draw a check box or toggle switch on a given
+text layer. Every text cell drawn is tagged with the alias of the check box,
+so that mouse clicks can be matched to it. A toggle switch which is on is
+drawn using the highlight colors of its style.
+*/
+func drawCheckBox(layerEntry *memory.LayerEntryType, checkBoxEntry *memory.CheckBoxEntryType) {
+	styleEntry := checkBoxEntry.StyleEntry
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.TextForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.TextBackgroundColor
+	attributeEntry.CellType = constants.CellTypeCheckBox
+	if checkBoxEntry.IsToggleSwitch {
+		attributeEntry.CellType = constants.CellTypeToggleSwitch
+	}
+	attributeEntry.CellAlias = checkBoxEntry.CheckBoxAlias
+	indicator := getCheckBoxIndicator(checkBoxEntry)
+	printLayer(layerEntry, attributeEntry, checkBoxEntry.XLocation, checkBoxEntry.YLocation, append(indicator, ' '))
+	if checkBoxEntry.IsToggleSwitch && checkBoxEntry.IsChecked {
+		highlightAttributeEntry := memory.NewAttributeEntry(&attributeEntry)
+		highlightAttributeEntry.ForegroundColor = styleEntry.HighlightForegroundColor
+		highlightAttributeEntry.BackgroundColor = styleEntry.HighlightBackgroundColor
+		printLayer(layerEntry, highlightAttributeEntry, checkBoxEntry.XLocation+1, checkBoxEntry.YLocation, indicator[1:len(indicator)-1])
+	}
+	labelAttributeEntry := memory.NewAttributeEntry(&attributeEntry)
+	labelAttributeEntry.IsUnderlined = checkBoxEntry.IsFocused
+	printLayer(layerEntry, labelAttributeEntry, checkBoxEntry.XLocation+len(indicator)+1, checkBoxEntry.YLocation, []rune(checkBoxEntry.Label))
+}
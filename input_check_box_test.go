@@ -0,0 +1,63 @@
+package dosktop
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestCheckBoxesAndToggleSwitches(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(30, 6)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Form", 0, 0, 30, 6, 1, "")
+	AddCheckBox("Form", "Bold", "Bold", NewTuiStyleEntry(), 1, 1, false)
+	AddToggleSwitch("Form", "Wrap", "Word wrap", NewTuiStyleEntry(), 1, 3, true)
+	injectKey := func(key tcell.Key, character rune, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventKey(key, character, modifierMask))
+	}
+	injectMouse := func(xLocation int, yLocation int, buttonMask tcell.ButtonMask, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventMouse(xLocation, yLocation, buttonMask, modifierMask))
+	}
+	getControlEvents := func(numberOfEvents int) []string {
+		var controlEvents []string
+		for len(controlEvents) < numberOfEvents {
+			if eventEntry := WaitForEvent(); eventEntry.EventType == constants.EventTypeControl && eventEntry.ControlEvent.Action == constants.ControlActionChange {
+				controlEvents = append(controlEvents, eventEntry.ControlEvent.ControlAlias)
+			}
+		}
+		return controlEvents
+	}
+	getScreenText := func(xLocation int, yLocation int, length int) string {
+		UpdateDisplay()
+		cells, width, _ := simulationScreen.GetContents()
+		obtainedValue := ""
+		for currentXLocation := xLocation; currentXLocation < xLocation+length; currentXLocation++ {
+			obtainedValue += string(cells[yLocation*width+currentXLocation].Runes)
+		}
+		return obtainedValue
+	}
+	assert.Equalf(test, []string{"[ ] Bold", "[ON ] Word wrap"}, []string{getScreenText(1, 1, 8), getScreenText(1, 3, 15)}, "The check box and toggle switch were not drawn correctly!")
+	assert.Equalf(test, constants.CellTypeToggleSwitch, commonResource.screenLayer.CharacterMemory[3][12].AttributeEntry.CellType, "The toggle switch label was not tagged as part of the control!")
+	SetFocus("Form", "Bold")
+	injectKey(tcell.KeyRune, ' ', tcell.ModNone)
+	injectKey(tcell.KeyTab, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, []string{"Bold", "Wrap"}, getControlEvents(2), "Pressing space or enter did not change the focused controls!")
+	assert.Equalf(test, []bool{true, false}, []bool{IsCheckBoxChecked("Form", "Bold"), IsToggleSwitchOn("Form", "Wrap")}, "The values of the controls were not changed!")
+	assert.Equalf(test, []string{"[√] Bold", "[OFF] Word wrap"}, []string{getScreenText(1, 1, 8), getScreenText(1, 3, 15)}, "The changed check box and toggle switch were not drawn correctly!")
+	injectMouse(7, 1, tcell.Button1, tcell.ModNone)
+	injectMouse(7, 1, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(2, 3, tcell.Button1, tcell.ModNone)
+	injectMouse(2, 3, tcell.ButtonNone, tcell.ModNone)
+	assert.Equalf(test, []string{"Bold", "Wrap"}, getControlEvents(2), "Clicking the controls did not change them!")
+	assert.Equalf(test, []bool{false, true}, []bool{IsCheckBoxChecked("Form", "Bold"), IsToggleSwitchOn("Form", "Wrap")}, "Clicking the controls did not change their values!")
+	SetCheckBoxChecked("Form", "Bold", true)
+	SetToggleSwitchOn("Form", "Wrap", false)
+	assert.Equalf(test, []bool{true, false}, []bool{IsCheckBoxChecked("Form", "Bold"), IsToggleSwitchOn("Form", "Wrap")}, "The values of the controls could not be set!")
+	DeleteCheckBox("Form", "Bold")
+	DeleteToggleSwitch("Form", "Wrap")
+	assert.Falsef(test, memory.IsControlExists("Form", "Bold") || memory.IsControlExists("Form", "Wrap"), "The controls were not deleted!")
+}
@@ -0,0 +1,346 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+)
+
+/*
+These constants represent limits placed on text field history and
+completion lists.
+*/
+const (
+	textFieldHistoryLimit         = 100
+	textFieldCompletionListHeight = 5
+)
+
+/*
+SetTextFieldCompletionProvider allows you to offer completions to the user
+as they type into a text field. In addition, the following information
+should be noted:
+
+- Every time the user edits the text field, your completion provider is
+called with the current value. The candidates it returns are shown in a
+drop-down list beneath the text field. If no candidates are returned, the
+list is hidden.
+
+- While the list is shown, 'up' and 'down' select a candidate, 'enter'
+replaces the value of the text field with the candidate selected, and 'esc'
+hides the list. Clicking a candidate with the mouse also selects it.
+
+- If 'enter' is pressed while no candidate is selected, the list is hidden
+and the text field is submitted as usual.
+
+- Passing 'nil' as your completion provider removes it.
+
+- If the text field does not exist, then a panic will be generated to fail
+as fast as possible.
+*/
+func SetTextFieldCompletionProvider(layerAlias string, textFieldAlias string, completionProvider func(currentValue string) []string) {
+	textFieldEntry := memory.GetTextField(layerAlias, textFieldAlias)
+	markControlAsDirty(layerAlias, textFieldAlias)
+	textFieldEntry.CompletionProvider = completionProvider
+	closeTextFieldCompletion(textFieldEntry)
+}
+
+/*
+SetTextFieldHistory allows you to enable input history for a text field,
+restoring any history entries previously obtained with
+'GetTextFieldHistory'. In addition, the following information should be
+noted:
+
+- Once enabled, every non-empty value submitted with 'enter' is added to
+the end of the history, unless it is the same as the last entry. Only the
+most recent entries are kept.
+
+- While the text field has focus and no completion list is shown, 'up' and
+'down' move through the history. Moving past the newest entry clears the
+text field.
+
+- History is never recorded for password protected text fields.
+
+- If the text field does not exist, then a panic will be generated to fail
+as fast as possible.
+*/
+func SetTextFieldHistory(layerAlias string, textFieldAlias string, history []string) {
+	textFieldEntry := memory.GetTextField(layerAlias, textFieldAlias)
+	setTextFieldHistory(textFieldEntry, history)
+}
+
+/*
+GetTextFieldHistory allows you to obtain the history entries of a text
+field, oldest first. This is useful for saving history so that it can be
+restored the next time your application runs. If the text field does not
+exist, then a panic will be generated to fail as fast as possible.
+*/
+func GetTextFieldHistory(layerAlias string, textFieldAlias string) []string {
+	return append([]string(nil), memory.GetTextField(layerAlias, textFieldAlias).History...)
+}
+
+/*
+GetCommandInput allows you to obtain keyboard input from the user with
+history and completion, which is useful for building command consoles. It
+behaves exactly like 'GetInput', except that in addition, the following
+information should be noted:
+
+- The history provided is used to recall previous input with 'up' and
+'down'. The updated history, including the value entered, is returned so
+that it can be passed in again the next time input is required, or saved
+for later.
+
+- If a completion provider is given, candidates are shown in a drop-down
+list beneath the input field as described in
+'SetTextFieldCompletionProvider'. The area under the list is restored
+once input is complete. If no completions are needed, pass 'nil' instead.
+*/
+func GetCommandInput(layerAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, maxLengthAllowed int, history []string, completionProvider func(currentValue string) []string, defaultValue string) (string, []string) {
+	if maxLengthAllowed <= 0 {
+		panic(fmt.Sprintf("The specified maximum input length of '%d' is invalid!", maxLengthAllowed))
+	}
+	textFieldEntry := memory.NewTextFieldEntry()
+	textFieldEntry.StyleEntry = styleEntry
+	textFieldEntry.XLocation = xLocation
+	textFieldEntry.YLocation = yLocation
+	textFieldEntry.Width = width
+	textFieldEntry.MaxLengthAllowed = maxLengthAllowed
+	textFieldEntry.IsFocused = true
+	textFieldEntry.CompletionProvider = completionProvider
+	setTextFieldHistory(&textFieldEntry, history)
+	setTextFieldValue(&textFieldEntry, defaultValue)
+	obtainedValue := getInput(layerAlias, &textFieldEntry)
+	addTextFieldHistory(&textFieldEntry)
+	return obtainedValue, textFieldEntry.History
+}
+
+/*
+setTextFieldHistory allows you to enable input history for a text field
+entry, replacing any history it already has. Only the most recent entries
+are kept.
+*/
+func setTextFieldHistory(textFieldEntry *memory.TextFieldEntryType, history []string) {
+	if len(history) > textFieldHistoryLimit {
+		history = history[len(history)-textFieldHistoryLimit:]
+	}
+	textFieldEntry.IsHistoryEnabled = true
+	textFieldEntry.History = append([]string(nil), history...)
+	textFieldEntry.HistoryIndex = len(textFieldEntry.History)
+}
+
+/*
+addTextFieldHistory allows you to record the current value of a text field
+entry in its history. Empty values, values identical to the last entry,
+and password protected text fields are not recorded. If history is not
+enabled for the text field, no operation takes place.
+*/
+func addTextFieldHistory(textFieldEntry *memory.TextFieldEntryType) {
+	if !textFieldEntry.IsHistoryEnabled || textFieldEntry.IsPasswordProtected || textFieldEntry.CurrentValue == "" {
+		return
+	}
+	if len(textFieldEntry.History) == 0 || textFieldEntry.History[len(textFieldEntry.History)-1] != textFieldEntry.CurrentValue {
+		textFieldEntry.History = append(textFieldEntry.History, textFieldEntry.CurrentValue)
+		if len(textFieldEntry.History) > textFieldHistoryLimit {
+			textFieldEntry.History = textFieldEntry.History[1:]
+		}
+	}
+	textFieldEntry.HistoryIndex = len(textFieldEntry.History)
+}
+
+/*
+updateTextFieldCompletion allows you to update the completion list or
+history of a text field entry with a keystroke. Returns 'true' if the
+keystroke was used. In addition, the following information should be
+noted:
+
+- While the completion list is shown, 'up', 'down', and 'esc' are always
+used by the list. 'enter' is only used if a candidate is selected.
+
+- Otherwise, 'up' and 'down' move through the history of the text field, if
+it has any.
+*/
+func updateTextFieldCompletion(textFieldEntry *memory.TextFieldEntryType, keystroke string) bool {
+	if len(textFieldEntry.CompletionItems) > 0 {
+		switch keystroke {
+		case "up":
+			if textFieldEntry.CompletionItemSelected != constants.NullSelectionIndex {
+				selectTextFieldCompletion(textFieldEntry, textFieldEntry.CompletionItemSelected-1)
+			}
+			return true
+		case "down":
+			selectTextFieldCompletion(textFieldEntry, textFieldEntry.CompletionItemSelected+1)
+			return true
+		case "esc":
+			closeTextFieldCompletion(textFieldEntry)
+			return true
+		case "enter":
+			if textFieldEntry.CompletionItemSelected != constants.NullSelectionIndex {
+				acceptTextFieldCompletion(textFieldEntry, textFieldEntry.CompletionItemSelected)
+				return true
+			}
+			closeTextFieldCompletion(textFieldEntry)
+		}
+		return false
+	}
+	if len(textFieldEntry.History) == 0 {
+		return false
+	}
+	switch keystroke {
+	case "up":
+		if textFieldEntry.HistoryIndex > 0 {
+			textFieldEntry.HistoryIndex--
+			setTextFieldValue(textFieldEntry, textFieldEntry.History[textFieldEntry.HistoryIndex])
+		}
+		return true
+	case "down":
+		if textFieldEntry.HistoryIndex < len(textFieldEntry.History) {
+			textFieldEntry.HistoryIndex++
+			if textFieldEntry.HistoryIndex == len(textFieldEntry.History) {
+				setTextFieldValue(textFieldEntry, "")
+			} else {
+				setTextFieldValue(textFieldEntry, textFieldEntry.History[textFieldEntry.HistoryIndex])
+			}
+		}
+		return true
+	}
+	return false
+}
+
+/*
+updateTextFieldCompletionItems allows you to refresh the completion list of
+a text field entry by calling its completion provider with the current
+value. No candidate is selected afterwards. If the text field has no
+completion provider, no operation takes place.
+*/
+func updateTextFieldCompletionItems(textFieldEntry *memory.TextFieldEntryType) {
+	if textFieldEntry.CompletionProvider == nil {
+		return
+	}
+	textFieldEntry.CompletionItems = append([]string(nil), textFieldEntry.CompletionProvider(textFieldEntry.CurrentValue)...)
+	textFieldEntry.CompletionItemSelected = constants.NullSelectionIndex
+	textFieldEntry.CompletionViewportPosition = 0
+}
+
+/*
+selectTextFieldCompletion allows you to select a candidate in the
+completion list of a text field entry, scrolling the list so that it is
+visible. Selecting before the first candidate removes the selection, and
+selecting past the last candidate keeps the last one selected.
+*/
+func selectTextFieldCompletion(textFieldEntry *memory.TextFieldEntryType, itemSelected int) {
+	if itemSelected < 0 {
+		itemSelected = constants.NullSelectionIndex
+	}
+	if itemSelected >= len(textFieldEntry.CompletionItems) {
+		itemSelected = len(textFieldEntry.CompletionItems) - 1
+	}
+	textFieldEntry.CompletionItemSelected = itemSelected
+	if itemSelected == constants.NullSelectionIndex {
+		return
+	}
+	if itemSelected < textFieldEntry.CompletionViewportPosition {
+		textFieldEntry.CompletionViewportPosition = itemSelected
+	}
+	if itemSelected >= textFieldEntry.CompletionViewportPosition+textFieldCompletionListHeight {
+		textFieldEntry.CompletionViewportPosition = itemSelected - textFieldCompletionListHeight + 1
+	}
+}
+
+/*
+acceptTextFieldCompletion allows you to replace the value of a text field
+entry with a candidate from its completion list, and then hide the list.
+*/
+func acceptTextFieldCompletion(textFieldEntry *memory.TextFieldEntryType, itemSelected int) {
+	setTextFieldValue(textFieldEntry, textFieldEntry.CompletionItems[itemSelected])
+	closeTextFieldCompletion(textFieldEntry)
+}
+
+/*
+closeTextFieldCompletion allows you to hide the completion list of a text
+field entry.
+*/
+func closeTextFieldCompletion(textFieldEntry *memory.TextFieldEntryType) {
+	textFieldEntry.CompletionItems = nil
+	textFieldEntry.CompletionItemSelected = constants.NullSelectionIndex
+	textFieldEntry.CompletionViewportPosition = 0
+}
+
+/*
+selectTextFieldCompletionByLocation allows you to accept the candidate of a
+completion list under the specified location of its text layer. Returns
+'true' if a candidate was accepted.
+*/
+func selectTextFieldCompletionByLocation(controlEntry *memory.ControlEntryType, yLocation int) bool {
+	textFieldEntry := memory.GetTextField(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	itemSelected := getTextFieldCompletionAtLocation(textFieldEntry, yLocation)
+	if itemSelected == constants.NullSelectionIndex {
+		return false
+	}
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	acceptTextFieldCompletion(textFieldEntry, itemSelected)
+	return true
+}
+
+/*
+getTextFieldCompletionAtLocation allows you to obtain the index of the
+completion list candidate drawn on the specified row of a text layer. If
+no candidate is drawn there, then 'NullSelectionIndex' is returned instead.
+*/
+func getTextFieldCompletionAtLocation(textFieldEntry *memory.TextFieldEntryType, yLocation int) int {
+	row := yLocation - textFieldEntry.YLocation - 1
+	if row < 0 || row >= getTextFieldCompletionListHeight(textFieldEntry) {
+		return constants.NullSelectionIndex
+	}
+	return textFieldEntry.CompletionViewportPosition + row
+}
+
+/*
+getTextFieldCompletionListHeight allows you to obtain the number of rows
+used to draw the completion list of a text field entry. If the list is
+hidden, then 0 is returned.
+*/
+func getTextFieldCompletionListHeight(textFieldEntry *memory.TextFieldEntryType) int {
+	if !textFieldEntry.IsFocused {
+		return 0
+	}
+	if len(textFieldEntry.CompletionItems) > textFieldCompletionListHeight {
+		return textFieldCompletionListHeight
+	}
+	return len(textFieldEntry.CompletionItems)
+}
+
+/*
+drawTextFieldCompletionList allows you to draw the completion list of a
+text field on a given text layer, directly beneath the text field. The list
+is drawn using the menu colors of the text field style.
+*/
+func drawTextFieldCompletionList(layerEntry *memory.LayerEntryType, textFieldEntry *memory.TextFieldEntryType) {
+	listHeight := getTextFieldCompletionListHeight(textFieldEntry)
+	if listHeight == 0 {
+		return
+	}
+	selectionEntry := memory.NewSelectionEntry()
+	for _, currentItem := range textFieldEntry.CompletionItems {
+		selectionEntry.Add(currentItem, currentItem)
+	}
+	itemSelected := textFieldEntry.CompletionItemSelected - textFieldEntry.CompletionViewportPosition
+	if textFieldEntry.CompletionItemSelected == constants.NullSelectionIndex {
+		itemSelected = constants.NullSelectionIndex
+	}
+	drawVerticalMenu(layerEntry, textFieldEntry.StyleEntry, selectionEntry, textFieldEntry.XLocation, textFieldEntry.YLocation+1, textFieldEntry.Width, listHeight, textFieldEntry.CompletionViewportPosition, itemSelected)
+}
+
+/*
+restoreLayerArea allows you to copy an area of a saved text layer entry
+back onto a text layer, so that anything drawn over that area is removed.
+Any portion of the area which falls outside of the text layer is ignored.
+*/
+func restoreLayerArea(layerEntry *memory.LayerEntryType, savedLayerEntry *memory.LayerEntryType, xLocation int, yLocation int, width int, height int) {
+	for currentYLocation := yLocation; currentYLocation < yLocation+height; currentYLocation++ {
+		for currentXLocation := xLocation; currentXLocation < xLocation+width; currentXLocation++ {
+			if currentXLocation >= 0 && currentYLocation >= 0 && currentXLocation < layerEntry.Width && currentYLocation < layerEntry.Height {
+				layerEntry.CharacterMemory[currentYLocation][currentXLocation] = savedLayerEntry.CharacterMemory[currentYLocation][currentXLocation]
+			}
+		}
+	}
+	layerEntry.MarkDirtyRegion(xLocation, yLocation, width, height)
+}
@@ -0,0 +1,75 @@
+package dosktop
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"strings"
+	"testing"
+)
+
+func getCommandCompletions(currentValue string) []string {
+	var completions []string
+	for _, currentCommand := range []string{"help", "hello", "history", "quit"} {
+		if currentValue != "" && strings.HasPrefix(currentCommand, currentValue) {
+			completions = append(completions, currentCommand)
+		}
+	}
+	return completions
+}
+
+func TestCommandInput(test *testing.T) {
+	commonResource.isDebugEnabled = true
+	InitializeTerminal(20, 8)
+	AddLayer("Layer1", 0, 0, 20, 8, 1, "")
+	FillLayer("Layer1", "#")
+	memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer("h", "e", "down", "down", "enter", " ", "w", "enter")
+	obtainedValue, history := GetCommandInput("Layer1", memory.NewTuiStyleEntry(), 2, 1, 10, 20, []string{"ls"}, getCommandCompletions, "")
+	assert.Equalf(test, "hello w", obtainedValue, "The completion selected was not used!")
+	assert.Equalf(test, []string{"ls", "hello w"}, history, "The value entered was not added to the history!")
+	assert.Equalf(test, '#', memory.GetLayer("Layer1").CharacterMemory[2][2].Character, "The area beneath the completion list was not restored!")
+	memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer("up", "up", "down", "down", "up", "up", "enter")
+	obtainedValue, history = GetCommandInput("Layer1", memory.NewTuiStyleEntry(), 2, 1, 10, 20, history, nil, "")
+	assert.Equalf(test, "ls", obtainedValue, "The history was not recalled correctly!")
+	assert.Equalf(test, []string{"ls", "hello w", "ls"}, history, "A recalled value was not added to the end of the history!")
+}
+
+func TestTextFieldCompletion(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Form", 0, 0, 20, 10, 1, "")
+	AddTextField("Form", "Command", NewTuiStyleEntry(), 2, 1, 8, 20, false, "")
+	SetTextFieldCompletionProvider("Form", "Command", getCommandCompletions)
+	SetTextFieldHistory("Form", "Command", []string{"old"})
+	SetFocus("Form", "Command")
+	simulationScreen.InjectKey(tcell.KeyRune, 'h', tcell.ModNone)
+	eventEntry := WaitForEvent()
+	assert.Equalf(test, constants.ControlActionChange, eventEntry.ControlEvent.Action, "Typing did not change the text field!")
+	UpdateDisplay()
+	cells, width, _ := simulationScreen.GetContents()
+	getScreenText := func(xLocation int, yLocation int, length int) string {
+		obtainedValue := ""
+		for currentXLocation := xLocation; currentXLocation < xLocation+length; currentXLocation++ {
+			obtainedValue += string(cells[yLocation*width+currentXLocation].Runes)
+		}
+		return obtainedValue
+	}
+	assert.Equalf(test, []string{"help", "hello", "history"}, []string{getScreenText(2, 2, 4), getScreenText(2, 3, 5), getScreenText(2, 4, 7)}, "The completion list was not drawn beneath the text field!")
+	simulationScreen.InjectMouse(3, 3, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(3, 3, tcell.ButtonNone, tcell.ModNone)
+	for eventEntry = WaitForEvent(); eventEntry.EventType != constants.EventTypeControl; eventEntry = WaitForEvent() {
+	}
+	assert.Equalf(test, []interface{}{constants.ControlActionChange, "hello"}, []interface{}{eventEntry.ControlEvent.Action, GetTextFieldValue("Form", "Command")}, "Clicking a completion did not select it!")
+	simulationScreen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	for eventEntry = WaitForEvent(); eventEntry.EventType != constants.EventTypeControl; eventEntry = WaitForEvent() {
+	}
+	assert.Equalf(test, constants.ControlActionSubmit, eventEntry.ControlEvent.Action, "Pressing enter did not submit the text field!")
+	assert.Equalf(test, []string{"old", "hello"}, GetTextFieldHistory("Form", "Command"), "The value submitted was not added to the history!")
+	simulationScreen.InjectKey(tcell.KeyUp, 0, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyUp, 0, tcell.ModNone)
+	eventEntry = WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.ControlActionChange, "old"}, []interface{}{eventEntry.ControlEvent.Action, GetTextFieldValue("Form", "Command")}, "The history was not recalled with the up key!")
+	assert.Equalf(test, 1, getControlRegion(memory.GetControl("Form", "Command")).Height, "Recalling history should not show the completion list!")
+}
@@ -0,0 +1,637 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+These constants represent the rows of a data grid which are not used to
+display data. A data grid always has a top border, a header row, a header
+separator, and a bottom border.
+*/
+const (
+	dataGridHeaderRow      = 1
+	dataGridFirstDataRow   = 3
+	dataGridNumberOfFrames = 4
+)
+
+/*
+AddDataGrid allows you to add a table of rows and columns to a text layer.
+Like list boxes, data grids do not block your application and are updated
+as events are read with 'PollEvent' or 'WaitForEvent'. In addition, the
+following information should be noted:
+
+- Columns are added with 'AddDataGridColumn' and rows are provided with
+'SetDataGridRows'. The grid is drawn with a border, a header row, and
+separators between columns. Where separators meet borders, the proper
+connector characters of the style provided are used.
+
+- A data grid only receives keystrokes while it has focus. The keys 'up',
+'down', 'pgup', 'pgdn', 'home', and 'end' move the highlighted row. Every
+time the highlighted row changes, a control event with the action
+'ControlActionChange' is returned. When the user presses 'enter' or double
+clicks on a row, a 'ControlActionSubmit' event is returned. When the user
+presses 'esc', a 'ControlActionCancel' event is returned. The row can then
+be obtained with 'GetDataGridSelection'.
+
+- Clicking on a column header sorts the rows by that column, and a
+'ControlActionSort' event is returned. Clicking the same header again
+reverses the sort order.
+
+- If the data grid has more rows than its height allows, a scroll bar is
+drawn in its right border. Pressing or dragging the mouse on the scroll
+bar, or moving the mouse wheel over the data grid, scrolls it. Only the
+rows which are visible are drawn, so data grids with a very large number of
+rows remain responsive.
+
+- Data grids are not drawn physically to the text layer provided. Instead
+they are rendered to the terminal at the same time when the text layer is
+rendered.
+
+- If the width of your data grid is less than 3 or the height is less than
+5, a panic will be generated to fail as fast as possible.
+*/
+func AddDataGrid(layerAlias string, dataGridAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, height int) {
+	if width < 3 || height < dataGridNumberOfFrames+1 {
+		panic(fmt.Sprintf("The specified data grid size of '%dx%d' is invalid!", width, height))
+	}
+	memory.AddDataGrid(layerAlias, dataGridAlias, styleEntry, xLocation, yLocation, width, height)
+	addControl(layerAlias, dataGridAlias, constants.ControlTypeDataGrid)
+	markControlAsDirty(layerAlias, dataGridAlias)
+}
+
+/*
+DeleteDataGrid allows you to remove a data grid from a text layer. In
+addition, the following information should be noted:
+
+- If you attempt to delete a data grid which does not exist, then the
+request will simply be ignored.
+*/
+func DeleteDataGrid(layerAlias string, dataGridAlias string) {
+	if !memory.IsDataGridExists(layerAlias, dataGridAlias) {
+		return
+	}
+	markControlAsDirty(layerAlias, dataGridAlias)
+	deleteControl(layerAlias, dataGridAlias)
+	memory.DeleteDataGrid(layerAlias, dataGridAlias)
+}
+
+/*
+AddDataGridColumn allows you to add a column to the right side of a data
+grid. In addition, the following information should be noted:
+
+- If the width type is 'DataGridColumnWidthFixed', the column is always the
+width specified.
+
+- If the width type is 'DataGridColumnWidthAuto', the column is as wide as
+its header or its widest cell, whichever is larger. The width specified is
+ignored.
+
+- If the width type is 'DataGridColumnWidthProportional', the column shares
+any space left over by the other columns. The width specified is used as a
+weight, so a column with a weight of 2 is twice as wide as a column with a
+weight of 1.
+
+- The alignment can be 'LeftAligned', 'RightAligned', or 'CenterAligned',
+and applies to both the header and cells of the column.
+
+- Columns which do not fit inside the data grid are not drawn.
+
+- If the data grid does not exist, or the width type or alignment is not
+valid, a panic will be generated to fail as fast as possible.
+*/
+func AddDataGridColumn(layerAlias string, dataGridAlias string, header string, widthType int, width int, alignment int) {
+	dataGridEntry := memory.GetDataGrid(layerAlias, dataGridAlias)
+	if widthType != constants.DataGridColumnWidthFixed && widthType != constants.DataGridColumnWidthProportional && widthType != constants.DataGridColumnWidthAuto {
+		panic(fmt.Sprintf("The specified column width type '%d' is invalid!", widthType))
+	}
+	if alignment != constants.LeftAligned && alignment != constants.RightAligned && alignment != constants.CenterAligned {
+		panic(fmt.Sprintf("The specified column alignment '%d' is invalid!", alignment))
+	}
+	if width < 0 {
+		width = 0
+	}
+	columnEntry := memory.DataGridColumnEntryType{Header: header, WidthType: widthType, Width: width, Alignment: alignment}
+	dataGridEntry.Columns = append(dataGridEntry.Columns, columnEntry)
+	updateDataGridContentWidths(dataGridEntry)
+	markControlAsDirty(layerAlias, dataGridAlias)
+}
+
+/*
+SetDataGridRows allows you to replace the rows of a data grid. Each row is
+a list of cell values, one for each column. In addition, the following
+information should be noted:
+
+- Rows with fewer cells than there are columns have their remaining cells
+left blank. Extra cells are ignored.
+
+- If the data grid was sorted, the new rows are sorted in the same way.
+The first row is then highlighted.
+
+- No control event is returned, since the change was not made by the user.
+
+- If the data grid does not exist, a panic will be generated to fail as
+fast as possible.
+*/
+func SetDataGridRows(layerAlias string, dataGridAlias string, rows [][]string) {
+	dataGridEntry := memory.GetDataGrid(layerAlias, dataGridAlias)
+	dataGridEntry.Rows = nil
+	for _, currentRow := range rows {
+		dataGridEntry.Rows = append(dataGridEntry.Rows, append([]string(nil), currentRow...))
+	}
+	dataGridEntry.RowOrder = make([]int, len(rows))
+	for currentIndex := range dataGridEntry.RowOrder {
+		dataGridEntry.RowOrder[currentIndex] = currentIndex
+	}
+	sortDataGridRows(dataGridEntry)
+	dataGridEntry.RowHighlighted = 0
+	dataGridEntry.ViewportPosition = 0
+	updateDataGridContentWidths(dataGridEntry)
+	markControlAsDirty(layerAlias, dataGridAlias)
+}
+
+/*
+GetDataGridSelection allows you to obtain the index of the row currently
+highlighted in a data grid. The index refers to the position of the row in
+the list provided to 'SetDataGridRows', regardless of how the data grid is
+sorted. If the data grid has no rows, then 'constants.NullSelectionIndex'
+is returned instead. If the data grid does not exist, then a panic will be
+generated to fail as fast as possible.
+*/
+func GetDataGridSelection(layerAlias string, dataGridAlias string) int {
+	dataGridEntry := memory.GetDataGrid(layerAlias, dataGridAlias)
+	if len(dataGridEntry.RowOrder) == 0 {
+		return constants.NullSelectionIndex
+	}
+	return dataGridEntry.RowOrder[dataGridEntry.RowHighlighted]
+}
+
+/*
+SetDataGridSelection allows you to change which row is highlighted in a
+data grid. In addition, the following information should be noted:
+
+- The row index refers to the position of the row in the list provided to
+'SetDataGridRows', regardless of how the data grid is sorted. The data grid
+will scroll if required to ensure the row is visible.
+
+- No control event is returned, since the change was not made by the user.
+
+- If the data grid does not exist, or the row index is out of range, a
+panic will be generated to fail as fast as possible.
+*/
+func SetDataGridSelection(layerAlias string, dataGridAlias string, rowIndex int) {
+	dataGridEntry := memory.GetDataGrid(layerAlias, dataGridAlias)
+	if rowIndex < 0 || rowIndex >= len(dataGridEntry.Rows) {
+		panic(fmt.Sprintf("The row index '%d' does not exist in the data grid '%s'!", rowIndex, dataGridAlias))
+	}
+	for currentPosition, currentRowIndex := range dataGridEntry.RowOrder {
+		if currentRowIndex == rowIndex {
+			highlightDataGridRow(dataGridEntry, currentPosition)
+			break
+		}
+	}
+	markControlAsDirty(layerAlias, dataGridAlias)
+}
+
+/*
+SortDataGrid allows you to sort the rows of a data grid by the values of a
+column. In addition, the following information should be noted:
+
+- If every value in the column is a number, the rows are sorted
+numerically. Otherwise they are sorted alphabetically without regard to
+case. Rows with equal values keep their original order.
+
+- The row highlighted before sorting remains highlighted afterwards.
+
+- No control event is returned, since the change was not made by the user.
+
+- If the data grid or column does not exist, a panic will be generated to
+fail as fast as possible.
+*/
+func SortDataGrid(layerAlias string, dataGridAlias string, columnIndex int, isDescending bool) {
+	dataGridEntry := memory.GetDataGrid(layerAlias, dataGridAlias)
+	if columnIndex < 0 || columnIndex >= len(dataGridEntry.Columns) {
+		panic(fmt.Sprintf("The column index '%d' does not exist in the data grid '%s'!", columnIndex, dataGridAlias))
+	}
+	selectedRowIndex := GetDataGridSelection(layerAlias, dataGridAlias)
+	dataGridEntry.SortColumn = columnIndex
+	dataGridEntry.IsSortDescending = isDescending
+	sortDataGridRows(dataGridEntry)
+	if selectedRowIndex != constants.NullSelectionIndex {
+		SetDataGridSelection(layerAlias, dataGridAlias, selectedRowIndex)
+	}
+	markControlAsDirty(layerAlias, dataGridAlias)
+}
+
+/*
+GetDataGridSortOrder allows you to obtain the column a data grid is sorted
+by, and whether it is sorted in descending order. If the data grid has not
+been sorted, then 'constants.NullSelectionIndex' is returned as the column.
+If the data grid does not exist, then a panic will be generated to fail as
+fast as possible.
+*/
+func GetDataGridSortOrder(layerAlias string, dataGridAlias string) (int, bool) {
+	dataGridEntry := memory.GetDataGrid(layerAlias, dataGridAlias)
+	return dataGridEntry.SortColumn, dataGridEntry.IsSortDescending
+}
+
+/*
+sortDataGridRows allows you to reorder the rows of a data grid according to
+its current sort column and direction. If the data grid is not sorted, then
+the request will simply be ignored.
+*/
+func sortDataGridRows(dataGridEntry *memory.DataGridEntryType) {
+	if dataGridEntry.SortColumn < 0 || dataGridEntry.SortColumn >= len(dataGridEntry.Columns) {
+		return
+	}
+	isNumeric := true
+	numericValues := make([]float64, len(dataGridEntry.Rows))
+	for currentIndex := range dataGridEntry.Rows {
+		numericValue, err := strconv.ParseFloat(strings.TrimSpace(getDataGridCell(dataGridEntry, currentIndex, dataGridEntry.SortColumn)), 64)
+		if err != nil {
+			isNumeric = false
+			break
+		}
+		numericValues[currentIndex] = numericValue
+	}
+	sort.SliceStable(dataGridEntry.RowOrder, func(firstIndex int, secondIndex int) bool {
+		firstRowIndex := dataGridEntry.RowOrder[firstIndex]
+		secondRowIndex := dataGridEntry.RowOrder[secondIndex]
+		if dataGridEntry.IsSortDescending {
+			firstRowIndex, secondRowIndex = secondRowIndex, firstRowIndex
+		}
+		if isNumeric {
+			return numericValues[firstRowIndex] < numericValues[secondRowIndex]
+		}
+		return strings.ToLower(getDataGridCell(dataGridEntry, firstRowIndex, dataGridEntry.SortColumn)) < strings.ToLower(getDataGridCell(dataGridEntry, secondRowIndex, dataGridEntry.SortColumn))
+	})
+}
+
+/*
+getDataGridCell allows you to obtain the value of a data grid cell. If the
+row does not have a value for the column, then an empty string is returned
+instead.
+*/
+func getDataGridCell(dataGridEntry *memory.DataGridEntryType, rowIndex int, columnIndex int) string {
+	if columnIndex >= len(dataGridEntry.Rows[rowIndex]) {
+		return ""
+	}
+	return dataGridEntry.Rows[rowIndex][columnIndex]
+}
+
+/*
+updateDataGridContentWidths allows you to recalculate the width of the
+widest cell of each automatically sized column. This is done when the rows
+or columns change, so that drawing the data grid does not require every
+row to be examined.
+*/
+func updateDataGridContentWidths(dataGridEntry *memory.DataGridEntryType) {
+	for currentColumnIndex := range dataGridEntry.Columns {
+		columnEntry := &dataGridEntry.Columns[currentColumnIndex]
+		if columnEntry.WidthType != constants.DataGridColumnWidthAuto {
+			continue
+		}
+		// One extra column is reserved after the header for the sort indicator.
+		contentWidth := getGraphemeClustersWidth(stringformat.GetGraphemeClusters([]rune(columnEntry.Header))) + 1
+		for currentRowIndex := range dataGridEntry.Rows {
+			cellWidth := getGraphemeClustersWidth(stringformat.GetGraphemeClusters([]rune(getDataGridCell(dataGridEntry, currentRowIndex, currentColumnIndex))))
+			if cellWidth > contentWidth {
+				contentWidth = cellWidth
+			}
+		}
+		columnEntry.ContentWidth = contentWidth
+	}
+}
+
+/*
+getDataGridColumnWidths allows you to obtain the width of each column of a
+data grid. Fixed and automatic columns are given their widths first, and
+proportional columns share whatever space remains. Columns which extend
+past the right border of the data grid are not shortened.
+*/
+func getDataGridColumnWidths(dataGridEntry *memory.DataGridEntryType) []int {
+	columnWidths := make([]int, len(dataGridEntry.Columns))
+	remainingWidth := dataGridEntry.Width - 2 - (len(dataGridEntry.Columns) - 1)
+	totalWeight := 0
+	for currentIndex, currentColumnEntry := range dataGridEntry.Columns {
+		switch currentColumnEntry.WidthType {
+		case constants.DataGridColumnWidthFixed:
+			columnWidths[currentIndex] = currentColumnEntry.Width
+		case constants.DataGridColumnWidthAuto:
+			columnWidths[currentIndex] = currentColumnEntry.ContentWidth
+		case constants.DataGridColumnWidthProportional:
+			totalWeight += currentColumnEntry.Width
+			continue
+		}
+		remainingWidth -= columnWidths[currentIndex]
+	}
+	if remainingWidth <= 0 || totalWeight == 0 {
+		return columnWidths
+	}
+	weightUsed := 0
+	widthUsed := 0
+	for currentIndex, currentColumnEntry := range dataGridEntry.Columns {
+		if currentColumnEntry.WidthType != constants.DataGridColumnWidthProportional {
+			continue
+		}
+		// Widths are calculated from the running total so that rounding errors
+		// do not leave unused space at the end of the data grid.
+		weightUsed += currentColumnEntry.Width
+		columnWidths[currentIndex] = remainingWidth*weightUsed/totalWeight - widthUsed
+		widthUsed += columnWidths[currentIndex]
+	}
+	return columnWidths
+}
+
+/*
+getDataGridDataHeight allows you to obtain the number of rows of data a
+data grid can display at once.
+*/
+func getDataGridDataHeight(dataGridEntry *memory.DataGridEntryType) int {
+	return dataGridEntry.Height - dataGridNumberOfFrames
+}
+
+/*
+highlightDataGridRow allows you to highlight a row of a data grid by its
+display position, scrolling the data grid so that the row is visible. If
+the position specified is out of range, then the closest valid row is
+highlighted instead. Returns 'true' if the highlighted row has changed.
+*/
+func highlightDataGridRow(dataGridEntry *memory.DataGridEntryType, rowHighlighted int) bool {
+	if rowHighlighted >= len(dataGridEntry.RowOrder) {
+		rowHighlighted = len(dataGridEntry.RowOrder) - 1
+	}
+	if rowHighlighted < 0 {
+		rowHighlighted = 0
+	}
+	isHighlightChanged := rowHighlighted != dataGridEntry.RowHighlighted
+	dataGridEntry.RowHighlighted = rowHighlighted
+	dataHeight := getDataGridDataHeight(dataGridEntry)
+	if dataGridEntry.RowHighlighted < dataGridEntry.ViewportPosition {
+		dataGridEntry.ViewportPosition = dataGridEntry.RowHighlighted
+	}
+	if dataGridEntry.RowHighlighted >= dataGridEntry.ViewportPosition+dataHeight {
+		dataGridEntry.ViewportPosition = dataGridEntry.RowHighlighted - dataHeight + 1
+	}
+	return isHighlightChanged && len(dataGridEntry.RowOrder) > 0
+}
+
+/*
+getDataGridKeyEvents allows you to update a data grid with a key event. The
+events which should be reported in its place are returned. If the key is
+not used by the data grid, then the key event itself is returned.
+*/
+func getDataGridKeyEvents(controlEntry *memory.ControlEntryType, eventEntry memory.EventEntryType) []memory.EventEntryType {
+	dataGridEntry := memory.GetDataGrid(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	rowHighlighted := dataGridEntry.RowHighlighted
+	dataHeight := getDataGridDataHeight(dataGridEntry)
+	isHighlightChanged := false
+	switch eventEntry.KeyEvent.Chord {
+	case "enter":
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionSubmit)}
+	case "esc":
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionCancel)}
+	case "up":
+		isHighlightChanged = highlightDataGridRow(dataGridEntry, rowHighlighted-1)
+	case "down":
+		isHighlightChanged = highlightDataGridRow(dataGridEntry, rowHighlighted+1)
+	case "pgup":
+		isHighlightChanged = highlightDataGridRow(dataGridEntry, rowHighlighted-dataHeight)
+	case "pgdn":
+		isHighlightChanged = highlightDataGridRow(dataGridEntry, rowHighlighted+dataHeight)
+	case "home":
+		isHighlightChanged = highlightDataGridRow(dataGridEntry, 0)
+	case "end":
+		isHighlightChanged = highlightDataGridRow(dataGridEntry, len(dataGridEntry.RowOrder)-1)
+	default:
+		return []memory.EventEntryType{eventEntry}
+	}
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if isHighlightChanged {
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionChange)}
+	}
+	return nil
+}
+
+/*
+getDataGridMouseEvents allows you to update a data grid with a mouse press
+at the specified location of its text layer. The events which should be
+reported are returned. In addition, the following information should be
+noted:
+
+- Pressing the scroll bar scrolls the data grid to the position pressed.
+
+- Pressing a column header sorts the data grid by that column. If the data
+grid is already sorted by that column, the sort order is reversed.
+
+- Pressing a row highlights it.
+*/
+func getDataGridMouseEvents(controlEntry *memory.ControlEntryType, mouseEvent memory.MouseEventEntryType, xLocation int, yLocation int) []memory.EventEntryType {
+	dataGridEntry := memory.GetDataGrid(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if isDataGridScrollBarAtLocation(dataGridEntry, xLocation, yLocation) {
+		scrollDataGridByLocation(controlEntry, yLocation)
+		return nil
+	}
+	if yLocation == dataGridEntry.YLocation+dataGridHeaderRow {
+		columnIndex := getDataGridColumnAtLocation(dataGridEntry, xLocation)
+		if columnIndex == constants.NullSelectionIndex {
+			return nil
+		}
+		SortDataGrid(controlEntry.LayerAlias, controlEntry.ControlAlias, columnIndex, dataGridEntry.SortColumn == columnIndex && !dataGridEntry.IsSortDescending)
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionSort)}
+	}
+	rowPosition := getDataGridRowAtLocation(dataGridEntry, xLocation, yLocation)
+	if rowPosition == constants.NullSelectionIndex {
+		return nil
+	}
+	isHighlightChanged := highlightDataGridRow(dataGridEntry, rowPosition)
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if isHighlightChanged {
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionChange)}
+	}
+	return nil
+}
+
+/*
+scrollDataGrid allows you to scroll the rows of a data grid without
+changing the row highlighted. The data grid will not scroll past its first
+or last row.
+*/
+func scrollDataGrid(controlEntry *memory.ControlEntryType, numberOfRows int) {
+	dataGridEntry := memory.GetDataGrid(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	viewportPosition := dataGridEntry.ViewportPosition + numberOfRows
+	if viewportPosition > len(dataGridEntry.RowOrder)-getDataGridDataHeight(dataGridEntry) {
+		viewportPosition = len(dataGridEntry.RowOrder) - getDataGridDataHeight(dataGridEntry)
+	}
+	if viewportPosition < 0 {
+		viewportPosition = 0
+	}
+	dataGridEntry.ViewportPosition = viewportPosition
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+}
+
+/*
+scrollDataGridByLocation allows you to scroll a data grid to the position
+represented by the specified row of its text layer on its scroll bar.
+*/
+func scrollDataGridByLocation(controlEntry *memory.ControlEntryType, yLocation int) {
+	dataGridEntry := memory.GetDataGrid(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	dataHeight := getDataGridDataHeight(dataGridEntry)
+	dataGridEntry.ViewportPosition = getVerticalScrollBarViewportPosition(yLocation-dataGridEntry.YLocation-dataGridFirstDataRow, dataHeight, len(dataGridEntry.RowOrder), dataHeight)
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+}
+
+/*
+isDataGridScrollBarAtLocation allows you to detect if the specified location
+of a text layer falls on the scroll bar of a data grid. If the data grid
+has no scroll bar, then 'false' is always returned.
+*/
+func isDataGridScrollBarAtLocation(dataGridEntry *memory.DataGridEntryType, xLocation int, yLocation int) bool {
+	return len(dataGridEntry.RowOrder) > getDataGridDataHeight(dataGridEntry) && xLocation == dataGridEntry.XLocation+dataGridEntry.Width-1 &&
+		yLocation >= dataGridEntry.YLocation+dataGridFirstDataRow && yLocation < dataGridEntry.YLocation+dataGridEntry.Height-1
+}
+
+/*
+getDataGridColumnAtLocation allows you to obtain the index of the data grid
+column under the specified column of its text layer. If no column is at
+that location, or the location falls on a column separator, then
+'constants.NullSelectionIndex' is returned instead.
+*/
+func getDataGridColumnAtLocation(dataGridEntry *memory.DataGridEntryType, xLocation int) int {
+	columnXLocation := dataGridEntry.XLocation + 1
+	for currentIndex, currentWidth := range getDataGridColumnWidths(dataGridEntry) {
+		if xLocation >= columnXLocation && xLocation < columnXLocation+currentWidth && xLocation < dataGridEntry.XLocation+dataGridEntry.Width-1 {
+			return currentIndex
+		}
+		columnXLocation += currentWidth + 1
+	}
+	return constants.NullSelectionIndex
+}
+
+/*
+getDataGridRowAtLocation allows you to obtain the display position of the
+data grid row under the specified location of its text layer. If no row is
+at that location, then 'constants.NullSelectionIndex' is returned instead.
+*/
+func getDataGridRowAtLocation(dataGridEntry *memory.DataGridEntryType, xLocation int, yLocation int) int {
+	rowPosition := dataGridEntry.ViewportPosition + yLocation - dataGridEntry.YLocation - dataGridFirstDataRow
+	if xLocation <= dataGridEntry.XLocation || xLocation >= dataGridEntry.XLocation+dataGridEntry.Width-1 ||
+		yLocation < dataGridEntry.YLocation+dataGridFirstDataRow || yLocation >= dataGridEntry.YLocation+dataGridEntry.Height-1 ||
+		rowPosition >= len(dataGridEntry.RowOrder) {
+		return constants.NullSelectionIndex
+	}
+	return rowPosition
+}
+
+/*
+drawDataGridsOnLayer allows you to draw all data grids on a given text
+layer entry.
+*/
+func drawDataGridsOnLayer(layerEntry memory.LayerEntryType) {
+	for _, currentDataGridEntry := range memory.DataGridMemory[layerEntry.LayerAlias] {
+		drawDataGrid(&layerEntry, currentDataGridEntry)
+	}
+}
+
+/*
+drawDataGrid allows you to draw a data grid on a given text layer. In
+addition, the following information should be noted:
+
+- Only the rows which fall inside the viewport of the data grid are drawn.
+Cells too long to fit are truncated.
+
+- The highlighted row is drawn using the highlight colors of the data grid
+style. The header of the column the data grid is sorted by shows an arrow
+indicating the sort direction.
+*/
+func drawDataGrid(layerEntry *memory.LayerEntryType, dataGridEntry *memory.DataGridEntryType) {
+	styleEntry := dataGridEntry.StyleEntry
+	headerAttributeEntry := memory.NewAttributeEntry()
+	headerAttributeEntry.ForegroundColor = styleEntry.TextForegroundColor
+	headerAttributeEntry.BackgroundColor = styleEntry.TextBackgroundColor
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.MenuForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.MenuBackgroundColor
+	highlightAttributeEntry := memory.NewAttributeEntry()
+	highlightAttributeEntry.ForegroundColor = styleEntry.HighlightForegroundColor
+	highlightAttributeEntry.BackgroundColor = styleEntry.HighlightBackgroundColor
+	xLocation := dataGridEntry.XLocation
+	yLocation := dataGridEntry.YLocation
+	interiorWidth := dataGridEntry.Width - 2
+	dataHeight := getDataGridDataHeight(dataGridEntry)
+	numberOfRows := len(dataGridEntry.RowOrder)
+	fillArea(layerEntry, headerAttributeEntry, " ", xLocation+1, yLocation+dataGridHeaderRow, interiorWidth, 1)
+	fillArea(layerEntry, attributeEntry, " ", xLocation+1, yLocation+dataGridFirstDataRow, interiorWidth, dataHeight)
+	drawBorder(layerEntry, styleEntry, headerAttributeEntry, xLocation, yLocation, dataGridEntry.Width, dataGridEntry.Height)
+	drawHorizontalLine(layerEntry, styleEntry, headerAttributeEntry, xLocation, yLocation+dataGridFirstDataRow-1, dataGridEntry.Width, true)
+	columnWidths := getDataGridColumnWidths(dataGridEntry)
+	for currentRow := 0; currentRow < dataHeight && dataGridEntry.ViewportPosition+currentRow < numberOfRows; currentRow++ {
+		if dataGridEntry.ViewportPosition+currentRow == dataGridEntry.RowHighlighted {
+			fillArea(layerEntry, highlightAttributeEntry, " ", xLocation+1, yLocation+dataGridFirstDataRow+currentRow, interiorWidth, 1)
+		}
+	}
+	columnXLocation := xLocation + 1
+	for currentColumnIndex, currentColumnEntry := range dataGridEntry.Columns {
+		if columnXLocation > xLocation+interiorWidth {
+			break
+		}
+		columnWidth := columnWidths[currentColumnIndex]
+		if columnXLocation+columnWidth > xLocation+interiorWidth+1 {
+			columnWidth = xLocation + interiorWidth + 1 - columnXLocation
+		}
+		header := currentColumnEntry.Header
+		headerWidth := columnWidth
+		if currentColumnIndex == dataGridEntry.SortColumn && columnWidth > 0 {
+			headerWidth--
+			sortIndicator := constants.CharArrowUp
+			if dataGridEntry.IsSortDescending {
+				sortIndicator = constants.CharArrowDown
+			}
+			printLayer(layerEntry, headerAttributeEntry, columnXLocation+headerWidth, yLocation+dataGridHeaderRow, []rune{sortIndicator})
+		}
+		drawDataGridText(layerEntry, headerAttributeEntry, columnXLocation, yLocation+dataGridHeaderRow, headerWidth, header, currentColumnEntry.Alignment)
+		for currentRow := 0; currentRow < dataHeight && dataGridEntry.ViewportPosition+currentRow < numberOfRows; currentRow++ {
+			rowPosition := dataGridEntry.ViewportPosition + currentRow
+			rowAttributeEntry := attributeEntry
+			if rowPosition == dataGridEntry.RowHighlighted {
+				rowAttributeEntry = highlightAttributeEntry
+			}
+			cell := getDataGridCell(dataGridEntry, dataGridEntry.RowOrder[rowPosition], currentColumnIndex)
+			drawDataGridText(layerEntry, rowAttributeEntry, columnXLocation, yLocation+dataGridFirstDataRow+currentRow, columnWidth, cell, currentColumnEntry.Alignment)
+		}
+		columnXLocation += columnWidth
+		if currentColumnIndex < len(dataGridEntry.Columns)-1 && columnXLocation <= xLocation+interiorWidth {
+			drawVerticalLine(layerEntry, styleEntry, headerAttributeEntry, columnXLocation, yLocation, dataGridEntry.Height, true)
+		}
+		columnXLocation++
+	}
+	if numberOfRows > dataHeight {
+		drawVerticalScrollBar(layerEntry, styleEntry, xLocation+dataGridEntry.Width-1, yLocation+dataGridFirstDataRow, dataHeight, numberOfRows, dataHeight, dataGridEntry.ViewportPosition)
+	}
+}
+
+/*
+drawDataGridText allows you to draw text inside a data grid cell of the
+width provided, using the alignment specified. Text too long to fit is
+truncated.
+*/
+func drawDataGridText(layerEntry *memory.LayerEntryType, attributeEntry memory.AttributeEntryType, xLocation int, yLocation int, width int, text string, alignment int) {
+	graphemeClusters := stringformat.GetGraphemeClusters([]rune(getTruncatedString(text, width)))
+	textWidth := getGraphemeClustersWidth(graphemeClusters)
+	column := 0
+	if alignment == constants.RightAligned {
+		column = width - textWidth
+	} else if alignment == constants.CenterAligned {
+		column = (width - textWidth) / 2
+	}
+	for _, currentGraphemeCluster := range graphemeClusters {
+		printLayer(layerEntry, attributeEntry, xLocation+column, yLocation, currentGraphemeCluster)
+		column += stringformat.GetGraphemeClusterWidth(currentGraphemeCluster)
+	}
+}
@@ -0,0 +1,109 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestDataGrid(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(30, 12)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Form", 0, 0, 30, 12, 1, "")
+	AddDataGrid("Form", "Fruit", NewTuiStyleEntry(), 0, 0, 24, 7)
+	AddDataGridColumn("Form", "Fruit", "Name", constants.DataGridColumnWidthAuto, 0, constants.LeftAligned)
+	AddDataGridColumn("Form", "Fruit", "Qty", constants.DataGridColumnWidthFixed, 5, constants.RightAligned)
+	AddDataGridColumn("Form", "Fruit", "Note", constants.DataGridColumnWidthProportional, 1, constants.CenterAligned)
+	SetDataGridRows("Form", "Fruit", [][]string{{"Pear", "10", "a"}, {"Apple", "2", "b"}, {"Fig", "33", "c"}, {"Kiwi", "4", "d"}, {"Plum", "7"}})
+	injectKey := func(key tcell.Key, character rune, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventKey(key, character, modifierMask))
+	}
+	injectMouse := func(xLocation int, yLocation int, buttonMask tcell.ButtonMask, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventMouse(xLocation, yLocation, buttonMask, modifierMask))
+	}
+	getControlActions := func(numberOfActions int) []int {
+		var controlActions []int
+		for len(controlActions) < numberOfActions {
+			if eventEntry := WaitForEvent(); eventEntry.EventType == constants.EventTypeControl {
+				controlActions = append(controlActions, eventEntry.ControlEvent.Action)
+			}
+		}
+		return controlActions
+	}
+	getScreenText := func(xLocation int, yLocation int, length int) string {
+		cells, width, _ := simulationScreen.GetContents()
+		obtainedValue := ""
+		for currentXLocation := xLocation; currentXLocation < xLocation+length; currentXLocation++ {
+			obtainedValue += string(cells[yLocation*width+currentXLocation].Runes)
+		}
+		return obtainedValue
+	}
+	assert.Equalf(test, []int{5, 5, 10}, getDataGridColumnWidths(memory.GetDataGrid("Form", "Fruit")), "The column widths were not calculated correctly!")
+	UpdateDisplay()
+	expectedResult := []string{
+		"┌─────┬─────┬──────────┐",
+		"│Name │  Qty│   Note   │",
+		"├─────┼─────┼──────────┤",
+		"│Pear │   10│    a     █",
+		"│Apple│    2│    b     ░",
+		"│Fig  │   33│    c     ░",
+		"└─────┴─────┴──────────┘",
+	}
+	var obtainedResult []string
+	for currentRow := 0; currentRow < 7; currentRow++ {
+		obtainedResult = append(obtainedResult, getScreenText(0, currentRow, 24))
+	}
+	assert.Equalf(test, expectedResult, obtainedResult, "The data grid was not drawn correctly!")
+	SetFocus("Form", "Fruit")
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	injectKey(tcell.KeyUp, 0, tcell.ModNone)
+	injectKey(tcell.KeyUp, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnd, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange, constants.ControlActionChange, constants.ControlActionChange, constants.ControlActionSubmit}, getControlActions(4), "Moving past the first row should not change the data grid!")
+	assert.Equalf(test, []int{4, 2}, []int{GetDataGridSelection("Form", "Fruit"), memory.GetDataGrid("Form", "Fruit").ViewportPosition}, "The last row was not highlighted and scrolled into view!")
+	injectMouse(8, 1, tcell.Button1, tcell.ModNone)
+	injectMouse(8, 1, tcell.ButtonNone, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionSort}, getControlActions(1), "Clicking a header did not sort the data grid!")
+	sortColumn, isSortDescending := GetDataGridSortOrder("Form", "Fruit")
+	assert.Equalf(test, []interface{}{1, false, []int{1, 3, 4, 0, 2}, 4}, []interface{}{sortColumn, isSortDescending, memory.GetDataGrid("Form", "Fruit").RowOrder, GetDataGridSelection("Form", "Fruit")}, "The data grid was not sorted numerically, or the selection was lost!")
+	injectMouse(8, 1, tcell.Button1, tcell.ModNone)
+	injectMouse(8, 1, tcell.ButtonNone, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionSort}, getControlActions(1), "Clicking a header again did not sort the data grid!")
+	assert.Equalf(test, []int{2, 0, 4, 3, 1}, memory.GetDataGrid("Form", "Fruit").RowOrder, "Clicking the same header did not reverse the sort order!")
+	SortDataGrid("Form", "Fruit", 0, false)
+	assert.Equalf(test, []int{1, 2, 3, 0, 4}, memory.GetDataGrid("Form", "Fruit").RowOrder, "The data grid was not sorted alphabetically!")
+	injectMouse(5, 3, tcell.WheelUp, tcell.ModNone)
+	injectMouse(5, 3, tcell.WheelUp, tcell.ModNone)
+	injectMouse(3, 4, tcell.Button1, tcell.ModNone)
+	injectMouse(3, 4, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(3, 4, tcell.Button1, tcell.ModNone)
+	injectMouse(3, 4, tcell.ButtonNone, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange, constants.ControlActionSubmit}, getControlActions(2), "Double clicking a row did not submit the data grid!")
+	assert.Equalf(test, 2, GetDataGridSelection("Form", "Fruit"), "Clicking a row did not highlight it!")
+	UpdateDisplay()
+	assert.Equalf(test, "│Fig  │   33│    c     ░", getScreenText(0, 4, 24), "The sorted rows were not drawn correctly!")
+	injectMouse(23, 5, tcell.Button1, tcell.ModNone)
+	injectMouse(23, 5, tcell.ButtonNone, tcell.ModNone)
+	injectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionCancel}, getControlActions(1), "Pressing escape did not cancel the data grid!")
+	assert.Equalf(test, []int{2, 2}, []int{memory.GetDataGrid("Form", "Fruit").ViewportPosition, GetDataGridSelection("Form", "Fruit")}, "Pressing the scroll bar should scroll without changing the selection!")
+
+	var rows [][]string
+	for currentIndex := 0; currentIndex < 100000; currentIndex++ {
+		rows = append(rows, []string{fmt.Sprintf("Row %d", currentIndex)})
+	}
+	AddDataGrid("Form", "Large", NewTuiStyleEntry(), 0, 7, 30, 5)
+	AddDataGridColumn("Form", "Large", "Name", constants.DataGridColumnWidthProportional, 1, constants.LeftAligned)
+	SetDataGridRows("Form", "Large", rows)
+	SetDataGridSelection("Form", "Large", 99999)
+	UpdateDisplay()
+	assert.Equalf(test, "│Row 99999", getScreenText(0, 10, 10), "The last row of a large data grid was not drawn!")
+	DeleteDataGrid("Form", "Large")
+	assert.Falsef(test, memory.IsControlExists("Form", "Large"), "The data grid was not deleted!")
+	assert.Panicsf(test, func() { AddDataGrid("Form", "Small", NewTuiStyleEntry(), 0, 0, 10, 4) }, "Adding a data grid which is too small did not panic!")
+}
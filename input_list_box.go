@@ -0,0 +1,445 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+	"strings"
+	"time"
+)
+
+/*
+These constants represent limits placed on list boxes.
+*/
+const (
+	listBoxSearchTimeout = time.Second
+)
+
+/*
+AddListBox allows you to add a scrollable list of items to a text layer.
+Like menus, list boxes do not block your application and are updated as
+events are read with 'PollEvent' or 'WaitForEvent'. In addition, the
+following information should be noted:
+
+- A list box only receives keystrokes while it has focus. The keys 'up',
+'down', 'pgup', 'pgdn', 'home', and 'end' move the highlighted item. Typing
+printable characters highlights the next item which starts with the text
+typed. Text typed more than a second apart starts a new search.
+
+- If multi-select is disabled, the highlighted item is always the item
+selected. If multi-select is enabled, pressing 'space' or clicking the
+mouse while holding 'ctrl' adds or removes the highlighted item from the
+selection, while a normal click selects only the item clicked.
+
+- Every time the selection changes, a control event with the action
+'ControlActionChange' is returned. When the user presses 'enter' or double
+clicks on an item, a 'ControlActionSubmit' event is returned. When the user
+presses 'esc', a 'ControlActionCancel' event is returned. The selection can
+then be obtained with 'GetListBoxSelections'.
+
+- If the list box has more items than its height allows, a scroll bar is
+drawn in its right-most column. Pressing or dragging the mouse on the
+scroll bar, or moving the mouse wheel over the list box, scrolls it. Only
+the items which are visible are drawn, so list boxes with thousands of
+items remain responsive.
+
+- List boxes are not drawn physically to the text layer provided. Instead
+they are rendered to the terminal at the same time when the text layer is
+rendered.
+
+- If the width or height of your list box is less than or equal to 0, a
+panic will be generated to fail as fast as possible.
+*/
+func AddListBox(layerAlias string, listBoxAlias string, styleEntry memory.TuiStyleEntryType, selectionEntry memory.SelectionEntryType, xLocation int, yLocation int, width int, height int, isMultiSelectEnabled bool) {
+	if width <= 0 || height <= 0 {
+		panic(fmt.Sprintf("The specified list box size of '%dx%d' is invalid!", width, height))
+	}
+	memory.AddListBox(layerAlias, listBoxAlias, styleEntry, xLocation, yLocation, width, height, isMultiSelectEnabled)
+	addControl(layerAlias, listBoxAlias, constants.ControlTypeListBox)
+	SetListBoxItems(layerAlias, listBoxAlias, selectionEntry)
+}
+
+/*
+DeleteListBox allows you to remove a list box from a text layer. In
+addition, the following information should be noted:
+
+- If you attempt to delete a list box which does not exist, then the
+request will simply be ignored.
+*/
+func DeleteListBox(layerAlias string, listBoxAlias string) {
+	if !memory.IsListBoxExists(layerAlias, listBoxAlias) {
+		return
+	}
+	markControlAsDirty(layerAlias, listBoxAlias)
+	deleteControl(layerAlias, listBoxAlias)
+	memory.DeleteListBox(layerAlias, listBoxAlias)
+}
+
+/*
+SetListBoxItems allows you to replace the items of a list box. In addition,
+the following information should be noted:
+
+- The selection is cleared and the first item is highlighted. If
+multi-select is disabled, the first item is also selected.
+
+- No control event is returned, since the change was not made by the user.
+
+- If the list box does not exist, a panic will be generated to fail as fast
+as possible.
+*/
+func SetListBoxItems(layerAlias string, listBoxAlias string, selectionEntry memory.SelectionEntryType) {
+	listBoxEntry := memory.GetListBox(layerAlias, listBoxAlias)
+	listBoxEntry.SelectionEntry.SelectionAlias = append([]string(nil), selectionEntry.SelectionAlias...)
+	listBoxEntry.SelectionEntry.SelectionValue = append([]string(nil), selectionEntry.SelectionValue...)
+	listBoxEntry.IsItemSelected = make([]bool, len(selectionEntry.SelectionValue))
+	listBoxEntry.ItemHighlighted = 0
+	listBoxEntry.ViewportPosition = 0
+	listBoxEntry.SearchText = ""
+	highlightListBoxItem(listBoxEntry, 0)
+	markControlAsDirty(layerAlias, listBoxAlias)
+}
+
+/*
+GetListBoxSelections allows you to obtain the selection aliases of all items
+currently selected in a list box. The aliases are returned in the same order
+as the items of the list box. If no items are selected, then an empty list
+is returned instead. If the list box does not exist, then a panic will be
+generated to fail as fast as possible.
+*/
+func GetListBoxSelections(layerAlias string, listBoxAlias string) []string {
+	listBoxEntry := memory.GetListBox(layerAlias, listBoxAlias)
+	selections := []string{}
+	for currentIndex, isSelected := range listBoxEntry.IsItemSelected {
+		if isSelected {
+			selections = append(selections, listBoxEntry.SelectionEntry.SelectionAlias[currentIndex])
+		}
+	}
+	return selections
+}
+
+/*
+SetListBoxSelections allows you to change which items are selected in a
+list box. In addition, the following information should be noted:
+
+- Items are identified by their selection aliases. The first item selected
+is highlighted, and the list box will scroll if required to ensure it is
+visible.
+
+- If multi-select is disabled, only one selection alias may be provided.
+
+- No control event is returned, since the change was not made by the user.
+
+- If the list box does not exist, or a selection alias provided does not
+exist in the list box, a panic will be generated to fail as fast as
+possible.
+*/
+func SetListBoxSelections(layerAlias string, listBoxAlias string, selectionAliases []string) {
+	listBoxEntry := memory.GetListBox(layerAlias, listBoxAlias)
+	if !listBoxEntry.IsMultiSelectEnabled && len(selectionAliases) != 1 {
+		panic(fmt.Sprintf("The list box '%s' only allows one item to be selected, but %d were provided!", listBoxAlias, len(selectionAliases)))
+	}
+	isItemSelected := make([]bool, len(listBoxEntry.SelectionEntry.SelectionAlias))
+	firstItemSelected := len(isItemSelected)
+	for _, currentSelectionAlias := range selectionAliases {
+		itemIndex := getListBoxItemIndex(listBoxEntry, currentSelectionAlias)
+		if itemIndex == constants.NullSelectionIndex {
+			panic(fmt.Sprintf("The selection alias '%s' does not exist in the list box '%s'!", currentSelectionAlias, listBoxAlias))
+		}
+		isItemSelected[itemIndex] = true
+		if itemIndex < firstItemSelected {
+			firstItemSelected = itemIndex
+		}
+	}
+	listBoxEntry.IsItemSelected = isItemSelected
+	if firstItemSelected < len(isItemSelected) {
+		highlightListBoxItem(listBoxEntry, firstItemSelected)
+	}
+	markControlAsDirty(layerAlias, listBoxAlias)
+}
+
+/*
+getListBoxItemIndex allows you to obtain the index of a list box item by its
+selection alias. If the selection alias does not exist, then
+'constants.NullSelectionIndex' is returned instead.
+*/
+func getListBoxItemIndex(listBoxEntry *memory.ListBoxEntryType, selectionAlias string) int {
+	for currentIndex, currentSelectionAlias := range listBoxEntry.SelectionEntry.SelectionAlias {
+		if currentSelectionAlias == selectionAlias {
+			return currentIndex
+		}
+	}
+	return constants.NullSelectionIndex
+}
+
+/*
+highlightListBoxItem allows you to highlight an item in a list box,
+scrolling the list box so that the item is visible. If the item specified
+is out of range, then the closest valid item is highlighted instead. If
+multi-select is disabled, the item highlighted also becomes the only item
+selected. Returns 'true' if the selection has changed.
+*/
+func highlightListBoxItem(listBoxEntry *memory.ListBoxEntryType, itemHighlighted int) bool {
+	numberOfItems := len(listBoxEntry.IsItemSelected)
+	if itemHighlighted >= numberOfItems {
+		itemHighlighted = numberOfItems - 1
+	}
+	if itemHighlighted < 0 {
+		itemHighlighted = 0
+	}
+	listBoxEntry.ItemHighlighted = itemHighlighted
+	if listBoxEntry.ItemHighlighted < listBoxEntry.ViewportPosition {
+		listBoxEntry.ViewportPosition = listBoxEntry.ItemHighlighted
+	}
+	if listBoxEntry.ItemHighlighted >= listBoxEntry.ViewportPosition+listBoxEntry.Height {
+		listBoxEntry.ViewportPosition = listBoxEntry.ItemHighlighted - listBoxEntry.Height + 1
+	}
+	if listBoxEntry.IsMultiSelectEnabled || numberOfItems == 0 {
+		return false
+	}
+	return selectOnlyListBoxItem(listBoxEntry, itemHighlighted)
+}
+
+/*
+selectOnlyListBoxItem allows you to select a single item in a list box,
+removing all other items from the selection. Returns 'true' if the
+selection has changed.
+*/
+func selectOnlyListBoxItem(listBoxEntry *memory.ListBoxEntryType, itemSelected int) bool {
+	isSelectionChanged := false
+	for currentIndex := range listBoxEntry.IsItemSelected {
+		if listBoxEntry.IsItemSelected[currentIndex] != (currentIndex == itemSelected) {
+			listBoxEntry.IsItemSelected[currentIndex] = currentIndex == itemSelected
+			isSelectionChanged = true
+		}
+	}
+	return isSelectionChanged
+}
+
+/*
+searchListBox allows you to highlight the next list box item which starts
+with the text typed so far. The search is not case sensitive, and wraps
+around to the first item when the end of the list is reached. If the user
+has not typed for longer than the search timeout, a new search is started.
+Returns 'true' if the selection has changed.
+*/
+func searchListBox(listBoxEntry *memory.ListBoxEntryType, character rune) bool {
+	currentTime := time.Now()
+	if currentTime.Sub(listBoxEntry.SearchTime) > listBoxSearchTimeout {
+		listBoxEntry.SearchText = ""
+	}
+	listBoxEntry.SearchTime = currentTime
+	listBoxEntry.SearchText += strings.ToLower(string(character))
+	numberOfItems := len(listBoxEntry.SelectionEntry.SelectionValue)
+	startIndex := listBoxEntry.ItemHighlighted
+	if len([]rune(listBoxEntry.SearchText)) == 1 {
+		startIndex++
+	}
+	for currentOffset := 0; currentOffset < numberOfItems; currentOffset++ {
+		itemIndex := (startIndex + currentOffset) % numberOfItems
+		if strings.HasPrefix(strings.ToLower(listBoxEntry.SelectionEntry.SelectionValue[itemIndex]), listBoxEntry.SearchText) {
+			return highlightListBoxItem(listBoxEntry, itemIndex)
+		}
+	}
+	return false
+}
+
+/*
+getListBoxKeyEvents allows you to update a list box with a key event. The
+events which should be reported in its place are returned. If the key is
+not used by the list box, then the key event itself is returned.
+*/
+func getListBoxKeyEvents(controlEntry *memory.ControlEntryType, eventEntry memory.EventEntryType) []memory.EventEntryType {
+	listBoxEntry := memory.GetListBox(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	keyEvent := eventEntry.KeyEvent
+	itemHighlighted := listBoxEntry.ItemHighlighted
+	isSelectionChanged := false
+	switch keyEvent.Chord {
+	case "enter":
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionSubmit)}
+	case "esc":
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionCancel)}
+	case "up":
+		isSelectionChanged = highlightListBoxItem(listBoxEntry, itemHighlighted-1)
+	case "down":
+		isSelectionChanged = highlightListBoxItem(listBoxEntry, itemHighlighted+1)
+	case "pgup":
+		isSelectionChanged = highlightListBoxItem(listBoxEntry, itemHighlighted-listBoxEntry.Height)
+	case "pgdn":
+		isSelectionChanged = highlightListBoxItem(listBoxEntry, itemHighlighted+listBoxEntry.Height)
+	case "home":
+		isSelectionChanged = highlightListBoxItem(listBoxEntry, 0)
+	case "end":
+		isSelectionChanged = highlightListBoxItem(listBoxEntry, len(listBoxEntry.IsItemSelected)-1)
+	default:
+		if keyEvent.Chord == "space" && listBoxEntry.IsMultiSelectEnabled {
+			if len(listBoxEntry.IsItemSelected) == 0 {
+				return nil
+			}
+			listBoxEntry.IsItemSelected[itemHighlighted] = !listBoxEntry.IsItemSelected[itemHighlighted]
+			isSelectionChanged = true
+		} else if keyEvent.Key == tcell.KeyRune && keyEvent.ModifierMask&(tcell.ModCtrl|tcell.ModAlt) == 0 {
+			isSelectionChanged = searchListBox(listBoxEntry, keyEvent.Character)
+		} else {
+			return []memory.EventEntryType{eventEntry}
+		}
+	}
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if isSelectionChanged {
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionChange)}
+	}
+	return nil
+}
+
+/*
+getListBoxMouseEvents allows you to update a list box with a mouse press at
+the specified location of its text layer. The events which should be
+reported are returned. In addition, the following information should be
+noted:
+
+- Pressing the scroll bar scrolls the list box to the position pressed.
+
+- Pressing an item highlights it. If multi-select is enabled and 'ctrl' is
+held, the item is added to or removed from the selection instead of
+becoming the only item selected.
+*/
+func getListBoxMouseEvents(controlEntry *memory.ControlEntryType, mouseEvent memory.MouseEventEntryType, xLocation int, yLocation int) []memory.EventEntryType {
+	listBoxEntry := memory.GetListBox(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if isListBoxScrollBarAtLocation(listBoxEntry, xLocation) {
+		scrollListBoxByLocation(controlEntry, yLocation)
+		return nil
+	}
+	itemIndex := getListBoxItemAtLocation(listBoxEntry, xLocation, yLocation)
+	if itemIndex == constants.NullSelectionIndex {
+		return nil
+	}
+	isSelectionChanged := highlightListBoxItem(listBoxEntry, itemIndex)
+	if listBoxEntry.IsMultiSelectEnabled {
+		if mouseEvent.ModifierMask&tcell.ModCtrl != 0 {
+			listBoxEntry.IsItemSelected[itemIndex] = !listBoxEntry.IsItemSelected[itemIndex]
+			isSelectionChanged = true
+		} else {
+			isSelectionChanged = selectOnlyListBoxItem(listBoxEntry, itemIndex)
+		}
+	}
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if isSelectionChanged {
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionChange)}
+	}
+	return nil
+}
+
+/*
+scrollListBox allows you to scroll the items of a list box without changing
+the item highlighted. The list box will not scroll past its first or last
+item.
+*/
+func scrollListBox(controlEntry *memory.ControlEntryType, numberOfItems int) {
+	listBoxEntry := memory.GetListBox(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	viewportPosition := listBoxEntry.ViewportPosition + numberOfItems
+	if viewportPosition > len(listBoxEntry.IsItemSelected)-listBoxEntry.Height {
+		viewportPosition = len(listBoxEntry.IsItemSelected) - listBoxEntry.Height
+	}
+	if viewportPosition < 0 {
+		viewportPosition = 0
+	}
+	listBoxEntry.ViewportPosition = viewportPosition
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+}
+
+/*
+scrollListBoxByLocation allows you to scroll a list box to the position
+represented by the specified row of its text layer on its scroll bar.
+*/
+func scrollListBoxByLocation(controlEntry *memory.ControlEntryType, yLocation int) {
+	listBoxEntry := memory.GetListBox(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	listBoxEntry.ViewportPosition = getVerticalScrollBarViewportPosition(yLocation-listBoxEntry.YLocation, listBoxEntry.Height, len(listBoxEntry.IsItemSelected), listBoxEntry.Height)
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+}
+
+/*
+isListBoxScrollBarAtLocation allows you to detect if the specified column of
+a text layer falls on the scroll bar of a list box. If the list box has no
+scroll bar, then 'false' is always returned.
+*/
+func isListBoxScrollBarAtLocation(listBoxEntry *memory.ListBoxEntryType, xLocation int) bool {
+	return len(listBoxEntry.IsItemSelected) > listBoxEntry.Height && xLocation == listBoxEntry.XLocation+listBoxEntry.Width-1
+}
+
+/*
+getListBoxItemAtLocation allows you to obtain the index of the list box item
+under the specified location of its text layer. If no item is at that
+location, then 'constants.NullSelectionIndex' is returned instead.
+*/
+func getListBoxItemAtLocation(listBoxEntry *memory.ListBoxEntryType, xLocation int, yLocation int) int {
+	itemIndex := listBoxEntry.ViewportPosition + yLocation - listBoxEntry.YLocation
+	if xLocation < listBoxEntry.XLocation || xLocation >= listBoxEntry.XLocation+listBoxEntry.Width ||
+		yLocation < listBoxEntry.YLocation || yLocation >= listBoxEntry.YLocation+listBoxEntry.Height ||
+		itemIndex >= len(listBoxEntry.IsItemSelected) {
+		return constants.NullSelectionIndex
+	}
+	return itemIndex
+}
+
+/*
+drawListBoxesOnLayer allows you to draw all list boxes on a given text layer
+entry.
+*/
+func drawListBoxesOnLayer(layerEntry memory.LayerEntryType) {
+	for _, currentListBoxEntry := range memory.ListBoxMemory[layerEntry.LayerAlias] {
+		drawListBox(&layerEntry, currentListBoxEntry)
+	}
+}
+
+/*
+drawListBox allows you to draw a list box on a given text layer. In
+addition, the following information should be noted:
+
+- Only the items which fall inside the viewport of the list box are drawn.
+Items too long to fit are truncated.
+
+- The highlighted item is drawn using the highlight colors of the list box
+style. If multi-select is enabled, the left-most column marks which items
+are selected.
+*/
+func drawListBox(layerEntry *memory.LayerEntryType, listBoxEntry *memory.ListBoxEntryType) {
+	styleEntry := listBoxEntry.StyleEntry
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.MenuForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.MenuBackgroundColor
+	highlightAttributeEntry := memory.NewAttributeEntry()
+	highlightAttributeEntry.ForegroundColor = styleEntry.HighlightForegroundColor
+	highlightAttributeEntry.BackgroundColor = styleEntry.HighlightBackgroundColor
+	numberOfItems := len(listBoxEntry.IsItemSelected)
+	itemWidth := listBoxEntry.Width
+	if numberOfItems > listBoxEntry.Height {
+		itemWidth--
+		drawVerticalScrollBar(layerEntry, styleEntry, listBoxEntry.XLocation+itemWidth, listBoxEntry.YLocation, listBoxEntry.Height, numberOfItems, listBoxEntry.Height, listBoxEntry.ViewportPosition)
+	}
+	fillArea(layerEntry, attributeEntry, " ", listBoxEntry.XLocation, listBoxEntry.YLocation, itemWidth, listBoxEntry.Height)
+	for currentRow := 0; currentRow < listBoxEntry.Height && listBoxEntry.ViewportPosition+currentRow < numberOfItems; currentRow++ {
+		itemIndex := listBoxEntry.ViewportPosition + currentRow
+		yLocation := listBoxEntry.YLocation + currentRow
+		rowAttributeEntry := attributeEntry
+		if itemIndex == listBoxEntry.ItemHighlighted {
+			rowAttributeEntry = highlightAttributeEntry
+			fillArea(layerEntry, rowAttributeEntry, " ", listBoxEntry.XLocation, yLocation, itemWidth, 1)
+		}
+		column := 0
+		if listBoxEntry.IsMultiSelectEnabled {
+			if listBoxEntry.IsItemSelected[itemIndex] {
+				printLayer(layerEntry, rowAttributeEntry, listBoxEntry.XLocation, yLocation, []rune{constants.CharDot})
+			}
+			column = 2
+		}
+		for _, currentGraphemeCluster := range stringformat.GetGraphemeClusters([]rune(listBoxEntry.SelectionEntry.SelectionValue[itemIndex])) {
+			graphemeClusterWidth := stringformat.GetGraphemeClusterWidth(currentGraphemeCluster)
+			if column+graphemeClusterWidth > itemWidth {
+				break
+			}
+			printLayer(layerEntry, rowAttributeEntry, listBoxEntry.XLocation+column, yLocation, currentGraphemeCluster)
+			column += graphemeClusterWidth
+		}
+	}
+}
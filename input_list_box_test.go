@@ -0,0 +1,94 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestListBox(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 12)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Form", 0, 0, 20, 12, 1, "")
+	selectionEntry := memory.NewSelectionEntry()
+	selectionEntry.Add("apple", "Apple")
+	selectionEntry.Add("banana", "Banana")
+	selectionEntry.Add("blueberry", "Blueberry")
+	for currentIndex := 3; currentIndex < 1000; currentIndex++ {
+		selectionEntry.Add(fmt.Sprintf("item%d", currentIndex), fmt.Sprintf("Item %04d", currentIndex))
+	}
+	AddListBox("Form", "Fruit", NewTuiStyleEntry(), selectionEntry, 1, 1, 12, 4, true)
+	injectKey := func(key tcell.Key, character rune, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventKey(key, character, modifierMask))
+	}
+	injectMouse := func(xLocation int, yLocation int, buttonMask tcell.ButtonMask, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventMouse(xLocation, yLocation, buttonMask, modifierMask))
+	}
+	getControlActions := func(numberOfActions int) []int {
+		var controlActions []int
+		for len(controlActions) < numberOfActions {
+			if eventEntry := WaitForEvent(); eventEntry.EventType == constants.EventTypeControl {
+				controlActions = append(controlActions, eventEntry.ControlEvent.Action)
+			}
+		}
+		return controlActions
+	}
+	SetFocus("Form", "Fruit")
+	injectKey(tcell.KeyRune, 'b', tcell.ModNone)
+	injectKey(tcell.KeyRune, 'L', tcell.ModNone)
+	injectKey(tcell.KeyRune, ' ', tcell.ModNone)
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	injectKey(tcell.KeyRune, ' ', tcell.ModNone)
+	injectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange, constants.ControlActionChange, constants.ControlActionCancel}, getControlActions(3), "Only toggling an item should change a multi-select list box!")
+	assert.Equalf(test, []string{"blueberry", "item3"}, GetListBoxSelections("Form", "Fruit"), "Typing to search or pressing space did not select the correct items!")
+	injectKey(tcell.KeyEnd, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionSubmit}, getControlActions(1), "Pressing enter did not submit the list box!")
+	UpdateDisplay()
+	cells, width, _ := simulationScreen.GetContents()
+	getScreenText := func(xLocation int, yLocation int, length int) string {
+		obtainedValue := ""
+		for currentXLocation := xLocation; currentXLocation < xLocation+length; currentXLocation++ {
+			obtainedValue += string(cells[yLocation*width+currentXLocation].Runes)
+		}
+		return obtainedValue
+	}
+	assert.Equalf(test, []string{"  Item 0996", "  Item 0999", "░", "█"}, []string{getScreenText(1, 1, 11), getScreenText(1, 4, 11), getScreenText(12, 1, 1), getScreenText(12, 4, 1)}, "The last items and the scroll bar were not drawn correctly!")
+	injectMouse(5, 2, tcell.WheelUp, tcell.ModNone)
+	injectMouse(12, 2, tcell.Button1, tcell.ModNone)
+	injectMouse(12, 1, tcell.Button1, tcell.ModNone)
+	injectMouse(12, 1, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(3, 1, tcell.Button1, tcell.ModNone)
+	injectMouse(3, 1, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(3, 2, tcell.Button1, tcell.ModCtrl)
+	injectMouse(3, 2, tcell.ButtonNone, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange, constants.ControlActionChange}, getControlActions(2), "Clicking items of the list box did not change its selection!")
+	assert.Equalf(test, []interface{}{0, []string{"apple", "banana"}}, []interface{}{memory.GetListBox("Form", "Fruit").ViewportPosition, GetListBoxSelections("Form", "Fruit")}, "Dragging the scroll bar or clicking with ctrl did not work as expected!")
+
+	selectionEntry = memory.NewSelectionEntry()
+	selectionEntry.Add("red", "Red")
+	selectionEntry.Add("green", "Green")
+	selectionEntry.Add("blue", "Blue")
+	AddListBox("Form", "Color", NewTuiStyleEntry(), selectionEntry, 1, 7, 10, 3, false)
+	assert.Equalf(test, []string{"red"}, GetListBoxSelections("Form", "Color"), "A single-select list box did not select its first item!")
+	SetListBoxSelections("Form", "Color", []string{"green"})
+	SetFocus("Form", "Color")
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange}, getControlActions(1), "Moving past the last item should not change the selection!")
+	injectMouse(2, 7, tcell.Button1, tcell.ModNone)
+	injectMouse(2, 7, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(2, 7, tcell.Button1, tcell.ModNone)
+	injectMouse(2, 7, tcell.ButtonNone, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange, constants.ControlActionSubmit}, getControlActions(2), "Double clicking an item did not submit the list box!")
+	assert.Equalf(test, []string{"red"}, GetListBoxSelections("Form", "Color"), "The item double clicked was not selected!")
+	assert.Panicsf(test, func() { SetListBoxSelections("Form", "Color", []string{"red", "blue"}) }, "Selecting several items of a single-select list box did not panic!")
+	assert.Panicsf(test, func() { SetListBoxSelections("Form", "Fruit", []string{"cherry"}) }, "Selecting an item which does not exist did not panic!")
+	DeleteListBox("Form", "Color")
+	assert.Falsef(test, memory.IsControlExists("Form", "Color"), "The deleted list box could still receive focus!")
+}
@@ -340,6 +340,15 @@ the visible display area allocated for the menu.
 */
 func DrawVerticalMenu(layerAlias string, styleEntry memory.TuiStyleEntryType, selectionEntry memory.SelectionEntryType, xLocation int, yLocation int, menuWidth int, menuHeight int, viewportPosition int, itemSelected int) {
 	layerEntry := memory.GetLayer(layerAlias)
+	drawVerticalMenu(layerEntry, styleEntry, selectionEntry, xLocation, yLocation, menuWidth, menuHeight, viewportPosition, itemSelected)
+}
+
+/*
+drawVerticalMenu allows you to draw a vertical menu on a given text layer
+entry. The item selected is relative to the viewport position, so that 0
+highlights the first menu item visible.
+*/
+func drawVerticalMenu(layerEntry *memory.LayerEntryType, styleEntry memory.TuiStyleEntryType, selectionEntry memory.SelectionEntryType, xLocation int, yLocation int, menuWidth int, menuHeight int, viewportPosition int, itemSelected int) {
 	menuAttributeEntry := memory.NewAttributeEntry()
 	menuAttributeEntry.ForegroundColor = styleEntry.MenuForegroundColor
 	menuAttributeEntry.BackgroundColor = styleEntry.MenuBackgroundColor
@@ -373,6 +382,9 @@ layer, then only the visible portion of your menu item will be drawn.
 
 - The returned value is the selection alias for the item that was
 chosen.
+
+- This method blocks until a selection is made. If you need a menu which
+can run alongside other controls, animations, or timers, see 'AddMenu'.
 */
 func GetSelectionFromVerticalMenu (layerAlias string, styleEntry memory.TuiStyleEntryType, selectionEntry memory.SelectionEntryType, xLocation int, yLocation int, menuWidth int, menuHeight int, defaultItemSelected int) string {
 	selectionIndex := GetSelectionFromVerticalMenuByIndex(layerAlias, styleEntry , selectionEntry, xLocation, yLocation, menuWidth, menuHeight, defaultItemSelected)
@@ -485,146 +497,20 @@ the value specified.
 - If the cursor position moves outside of the visible display area of the
 field, then the entire input field will shift to ensure the cursor is always
 visible.
+
+- Input is edited one grapheme cluster at a time, so accented letters, CJK
+characters, and emoji sequences are each treated as a single character.
+Wide characters occupy two columns of the input field.
+
+- This method blocks until the user presses 'enter'. If you need an input
+field which can run alongside other controls, animations, or timers, see
+'AddTextField'.
+
+- If you need to restrict what the user can type, such as numbers, dates,
+or a fixed pattern, see 'GetValidatedInput'.
 */
 func GetInput(layerAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, maxLengthAllowed int, IsPasswordProtected bool, defaultValue string) string {
-	layerEntry := memory.GetLayer(layerAlias)
-	inputString := defaultValue
-	isScreenUpdateRequired := true
-	var currentKeyPressed string
-	var cursorPosition int
-	var viewportPosition int
-	if maxLengthAllowed <= 0 {
-		panic(fmt.Sprintf("The specified maximum input length of '%d' is invalid!", maxLengthAllowed))
-	}
-	widthOfViewport := width - 1
-	if len(inputString) > 0 {
-		memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer("end")
-	}
-	for currentKeyPressed != "enter" {
-		mouseXLocation, mouseYLocation, mouseButtonPressed, _ := memory.MouseMemory.GetMouseStatus()
-		mouseCellIdentifier := getCellIdByLayerAlias(layerAlias, mouseXLocation, mouseYLocation)
-		if mouseCellIdentifier != constants.NullCellId {
-			if mouseButtonPressed == 1 {
-				cursorPosition = mouseCellIdentifier
-				isScreenUpdateRequired = true
-			}
-		}
-		currentKeyPressed = Inkey()
-		if currentKeyPressed != "" {
-			// If character is pressed.
-			if len(currentKeyPressed) == 1 {
-				if len(inputString) < maxLengthAllowed {
-					inputString = inputString[:viewportPosition+cursorPosition] + currentKeyPressed + inputString[viewportPosition+cursorPosition:]
-					if cursorPosition < widthOfViewport {
-						cursorPosition++
-					} else {
-						viewportPosition++
-					}
-					isScreenUpdateRequired = true
-				}
-			}
-			if currentKeyPressed == "delete" {
-				if inputString != "" {
-					// Protect if nothing else to delete left of string
-					if viewportPosition+cursorPosition+1 <= len(inputString) {
-						inputString = inputString[:viewportPosition+cursorPosition] + inputString[viewportPosition+cursorPosition+1:]
-						isScreenUpdateRequired = true
-					}
-				}
-			}
-			if currentKeyPressed == "home" {
-				cursorPosition = 0
-				viewportPosition = 0
-				isScreenUpdateRequired = true
-			}
-			if currentKeyPressed == "end" {
-				// If your current viewport shows the end of the input string, just move the cursor to the end of the string.
-				if viewportPosition > len(inputString)-widthOfViewport {
-					cursorPosition = len(inputString) - viewportPosition
-				} else {
-					// Otherwise advance viewport to end of input string.
-					viewportPosition = len(inputString) - widthOfViewport
-					if viewportPosition < 0 {
-						// If input string is smaller than even one viewport block, just set cursor to end.
-						viewportPosition = 0
-						cursorPosition = len(inputString)
-					} else {
-						// Otherwise place cursor at end of viewport / string
-						cursorPosition = widthOfViewport
-					}
-				}
-				isScreenUpdateRequired = true
-			}
-			if currentKeyPressed == "backspace" || currentKeyPressed == "backspace2" {
-				if inputString != "" {
-					// Protect if nothing else to delete left of string
-					if viewportPosition+cursorPosition-1 >= 0 {
-						inputString = inputString[:viewportPosition+cursorPosition-1] + inputString[viewportPosition+cursorPosition:]
-						cursorPosition--
-						if cursorPosition < 1 {
-							if len(inputString) < widthOfViewport {
-								cursorPosition = viewportPosition + cursorPosition
-								viewportPosition = 0
-							} else {
-								if viewportPosition != 0 { // If your not at the start of the input string
-									if cursorPosition == 0 {
-										viewportPosition = viewportPosition - widthOfViewport + 1
-									} else {
-										viewportPosition = viewportPosition - widthOfViewport
-									}
-									if viewportPosition < 0 {
-										viewportPosition = 0
-									}
-									cursorPosition = widthOfViewport - 1
-								}
-							}
-						}
-						isScreenUpdateRequired = true
-					}
-				}
-			}
-			if currentKeyPressed == "left" {
-				cursorPosition--
-				if cursorPosition < 0 {
-					if viewportPosition == 0 {
-						cursorPosition = 0
-					} else {
-						viewportPosition = viewportPosition - widthOfViewport
-						if viewportPosition < 0 {
-							viewportPosition = 0
-						}
-						cursorPosition = widthOfViewport
-					}
-				}
-				isScreenUpdateRequired = true
-			}
-			if currentKeyPressed == "right" {
-				cursorPosition++
-				if viewportPosition+cursorPosition >= len(inputString)+1 {
-					cursorPosition--
-				} else {
-					if cursorPosition > widthOfViewport {
-						viewportPosition += widthOfViewport
-						cursorPosition = 0
-					}
-				}
-				isScreenUpdateRequired = true
-			}
-		}
-		if isScreenUpdateRequired {
-			drawInputString(layerEntry, styleEntry, xLocation, yLocation, width, 0, false, stringformat.GetFilledString(width, " "))
-			if IsPasswordProtected {
-				passwordProtectedString := stringformat.GetFilledString(len(inputString), "*")
-				drawInputString(layerEntry, styleEntry, xLocation, yLocation, widthOfViewport, viewportPosition, true, passwordProtectedString)
-			} else {
-				drawInputString(layerEntry, styleEntry, xLocation, yLocation, widthOfViewport, viewportPosition, true, inputString)
-			}
-			drawCursor(layerEntry, styleEntry, xLocation, yLocation, cursorPosition, false)
-			UpdateDisplay()
-			isScreenUpdateRequired = false
-		}
-	}
-	return inputString
+	return GetValidatedInput(layerAlias, styleEntry, xLocation, yLocation, width, maxLengthAllowed, IsPasswordProtected, memory.NewInputValidatorEntry(), defaultValue)
 }
 
 /*
@@ -653,40 +539,188 @@ func drawCursor(layerEntry *memory.LayerEntryType, styleEntry memory.TuiStyleEnt
 }
 
 /*
-drawInputString allows you to draw a string for an input field. This is
-different than regular printing, since input fields are usually
-constrained for space and have the possibility of not being able to
-show the entire string. In addition, the following information should be
-noted:
+AddMenu allows you to add a vertical menu to a text layer. Unlike
+'GetSelectionFromVerticalMenu', a menu does not block your application
+while the user makes a selection. Instead, it remembers which item is
+selected, and is updated as events are read with 'PollEvent' or
+'WaitForEvent'. In addition, the following information should be noted:
+
+- A menu only receives keystrokes while it has focus. The keys 'up', 'down',
+'pgup', 'pgdn', 'home', and 'end' change the item selected. Pressing the
+mouse over a menu item gives the menu focus and selects that item.
+
+- Every time the item selected changes, a control event with the action
+'ControlActionChange' is returned. When the user presses 'enter' or clicks
+on a menu item, a 'ControlActionSubmit' event is returned. When the user
+presses 'esc', a 'ControlActionCancel' event is returned. The selection
+can then be obtained with 'GetMenuSelection'.
+
+- Menus are not drawn physically to the text layer provided. Instead they
+are rendered to the terminal at the same time when the text layer is
+rendered.
+
+- If the menu has more items than its height allows, then the menu will
+scroll to ensure the item selected is always visible.
+
+- If the default item selected is not a valid selection index, a panic will
+be generated to fail as fast as possible.
+*/
+func AddMenu(layerAlias string, menuAlias string, styleEntry memory.TuiStyleEntryType, selectionEntry memory.SelectionEntryType, xLocation int, yLocation int, menuWidth int, menuHeight int, defaultItemSelected int) {
+	if menuHeight <= 0 {
+		panic(fmt.Sprintf("The specified menu height of '%d' is invalid!", menuHeight))
+	}
+	memory.AddMenu(layerAlias, menuAlias, styleEntry, selectionEntry, xLocation, yLocation, menuWidth, menuHeight)
+	addControl(layerAlias, menuAlias, constants.ControlTypeMenu)
+	SetMenuSelection(layerAlias, menuAlias, defaultItemSelected)
+}
 
-- If the location specified for the input string falls outside the range of
-the text layer, then only the visible portion will be displayed.
+/*
+DeleteMenu allows you to remove a menu from a text layer. In addition, the
+following information should be noted:
 
-- Width indicates how large the visible area of your input string should be.
+- If you attempt to delete a menu which does not exist, then the request
+will simply be ignored.
+*/
+func DeleteMenu(layerAlias string, menuAlias string) {
+	if !memory.IsMenuExists(layerAlias, menuAlias) {
+		return
+	}
+	markControlAsDirty(layerAlias, menuAlias)
+	deleteControl(layerAlias, menuAlias)
+	memory.DeleteMenu(layerAlias, menuAlias)
+}
 
-- String position indicates the location in your string where printing should
-start. If the remainder of your string is too long for the specified width,
-then only the visible portion will be displayed.
+/*
+GetMenuSelection allows you to obtain the selection alias of the item
+currently selected in a menu. If the menu has no items, then an empty
+string is returned instead. If the menu does not exist, then a panic will
+be generated to fail as fast as possible.
 */
-func drawInputString(layerEntry *memory.LayerEntryType, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, stringPosition int, isCellIdsRequired bool, inputString string) {
-	attributeEntry := memory.NewAttributeEntry()
-	attributeEntry.ForegroundColor = styleEntry.TextInputForegroundColor
-	attributeEntry.BackgroundColor = styleEntry.TextInputBackgroundColor
-	attributeEntry.CellType = constants.CellTypeTextInput
-	runeSlice := []rune(inputString)
-	var safeSubstring string
-	if stringPosition+width <= len(inputString) {
-		safeSubstring = string(runeSlice[stringPosition : stringPosition+width])
-	} else {
-		safeSubstring = string(runeSlice[stringPosition : stringPosition+len(inputString)-stringPosition])
+func GetMenuSelection(layerAlias string, menuAlias string) string {
+	selectionIndex := GetMenuSelectionIndex(layerAlias, menuAlias)
+	if selectionIndex == constants.NullSelectionIndex {
+		return ""
 	}
-	arrayOfRunes := stringformat.GetRunesFromString(safeSubstring)
-	// Here we loop over each character to draw since we need to accommodate for unique
-	// cell IDs (if required for mouse location detection).
-	for currentRuneIndex := 0; currentRuneIndex < len(arrayOfRunes); currentRuneIndex ++ {
-		if isCellIdsRequired {
-			attributeEntry.CellId = currentRuneIndex
-		}
-		printLayer(layerEntry, attributeEntry, xLocation + currentRuneIndex, yLocation, []rune{arrayOfRunes[currentRuneIndex]})
+	return memory.GetMenu(layerAlias, menuAlias).SelectionEntry.SelectionAlias[selectionIndex]
+}
+
+/*
+GetMenuSelectionIndex allows you to obtain the index number of the item
+currently selected in a menu, where 0 is the first item on your selection
+list. If the menu has no items, then 'constants.NullSelectionIndex' is
+returned instead. If the menu does not exist, then a panic will be
+generated to fail as fast as possible.
+*/
+func GetMenuSelectionIndex(layerAlias string, menuAlias string) int {
+	menuEntry := memory.GetMenu(layerAlias, menuAlias)
+	if len(menuEntry.SelectionEntry.SelectionValue) == 0 {
+		return constants.NullSelectionIndex
+	}
+	return menuEntry.ItemSelected
+}
+
+/*
+SetMenuSelection allows you to change which item is selected in a menu.
+In addition, the following information should be noted:
+
+- The menu will scroll if required to ensure the item selected is visible.
+
+- No control event is returned, since the change was not made by the user.
+
+- If the menu does not exist, or the item specified is not a valid
+selection index, a panic will be generated to fail as fast as possible.
+*/
+func SetMenuSelection(layerAlias string, menuAlias string, itemSelected int) {
+	menuEntry := memory.GetMenu(layerAlias, menuAlias)
+	numberOfItems := len(menuEntry.SelectionEntry.SelectionValue)
+	if (numberOfItems > 0 || itemSelected != 0) && (itemSelected < 0 || itemSelected >= numberOfItems) {
+		panic(fmt.Sprintf("The specified default item selected of '%d' is invalid for a selection range of 0 to %d!", itemSelected, numberOfItems))
+	}
+	selectMenuItem(layerAlias, menuAlias, itemSelected)
+}
+
+/*
+selectMenuItem allows you to select an item in a menu, scrolling the menu
+so that the item is visible. If the item specified is out of range, then
+the closest valid item is selected instead. Returns 'true' if the item
+selected has changed.
+*/
+func selectMenuItem(layerAlias string, menuAlias string, itemSelected int) bool {
+	menuEntry := memory.GetMenu(layerAlias, menuAlias)
+	numberOfItems := len(menuEntry.SelectionEntry.SelectionValue)
+	previousItemSelected := menuEntry.ItemSelected
+	if itemSelected >= numberOfItems {
+		itemSelected = numberOfItems - 1
+	}
+	if itemSelected < 0 {
+		itemSelected = 0
+	}
+	menuEntry.ItemSelected = itemSelected
+	if menuEntry.ItemSelected < menuEntry.ViewportPosition {
+		menuEntry.ViewportPosition = menuEntry.ItemSelected
+	}
+	if menuEntry.ItemSelected >= menuEntry.ViewportPosition+menuEntry.Height {
+		menuEntry.ViewportPosition = menuEntry.ItemSelected - menuEntry.Height + 1
+	}
+	markControlAsDirty(layerAlias, menuAlias)
+	return menuEntry.ItemSelected != previousItemSelected
+}
+
+/*
+getMenuKeyEvents allows you to update a menu with a key event. The events
+which should be reported in its place are returned. If the key is not used
+by the menu, then the key event itself is returned.
+*/
+func getMenuKeyEvents(controlEntry *memory.ControlEntryType, eventEntry memory.EventEntryType) []memory.EventEntryType {
+	menuEntry := memory.GetMenu(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	itemSelected := menuEntry.ItemSelected
+	switch eventEntry.KeyEvent.Chord {
+	case "enter":
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionSubmit)}
+	case "esc":
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionCancel)}
+	case "up":
+		itemSelected--
+	case "down":
+		itemSelected++
+	case "pgup":
+		itemSelected -= menuEntry.Height
+	case "pgdn":
+		itemSelected += menuEntry.Height
+	case "home":
+		itemSelected = 0
+	case "end":
+		itemSelected = len(menuEntry.SelectionEntry.SelectionValue) - 1
+	default:
+		return []memory.EventEntryType{eventEntry}
+	}
+	if selectMenuItem(controlEntry.LayerAlias, controlEntry.ControlAlias, itemSelected) {
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionChange)}
+	}
+	return nil
+}
+
+/*
+getMenuItemAtLocation allows you to obtain the index of the menu item under
+the specified location of its text layer. If no menu item is at that
+location, then 'constants.NullSelectionIndex' is returned instead.
+*/
+func getMenuItemAtLocation(controlEntry *memory.ControlEntryType, xLocation int, yLocation int) int {
+	menuEntry := memory.GetMenu(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	itemIndex := menuEntry.ViewportPosition + yLocation - menuEntry.YLocation
+	if xLocation < menuEntry.XLocation || xLocation >= menuEntry.XLocation+menuEntry.Width ||
+		yLocation < menuEntry.YLocation || yLocation >= menuEntry.YLocation+menuEntry.Height ||
+		itemIndex >= len(menuEntry.SelectionEntry.SelectionValue) {
+		return constants.NullSelectionIndex
+	}
+	return itemIndex
+}
+
+/*
+drawMenusOnLayer allows you to draw all menus on a given text layer entry.
+*/
+func drawMenusOnLayer(layerEntry memory.LayerEntryType) {
+	for _, currentMenuEntry := range memory.MenuMemory[layerEntry.LayerAlias] {
+		drawVerticalMenu(&layerEntry, currentMenuEntry.StyleEntry, currentMenuEntry.SelectionEntry, currentMenuEntry.XLocation, currentMenuEntry.YLocation, currentMenuEntry.Width, currentMenuEntry.Height, currentMenuEntry.ViewportPosition, currentMenuEntry.ItemSelected-currentMenuEntry.ViewportPosition)
 	}
 }
@@ -0,0 +1,826 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+	"sort"
+	"unicode"
+)
+
+/*
+menuBarReferenceType is a structure used to identify a menu bar along with
+the text layer it belongs to.
+*/
+type menuBarReferenceType struct {
+	layerAlias   string
+	menuBarEntry *memory.MenuBarEntryType
+}
+
+/*
+AddMenuBar allows you to add a DOS style menu bar to a text layer. Top-level
+menu items are drawn across a single row, and open drop-down panels when
+selected. Like other controls, menu bars do not block your application and
+are updated as events are read with 'PollEvent' or 'WaitForEvent'. In
+addition, the following information should be noted:
+
+- Menu items are added with 'AddMenuBarItem', 'AddMenuBarCheckItem', and
+'AddMenuBarSeparator'. Items added to another item form a drop-down panel
+or a nested submenu.
+
+- Placing an '&' before a character of a menu item label makes it the
+hotkey for that item, and it is drawn underlined. Pressing 'alt' with the
+hotkey of a top-level item opens it, while pressing the hotkey of an item
+in an open panel selects it. Use '&&' to display an '&' character. Pressing
+'f10' opens the first menu bar available.
+
+- While a menu bar is open, it receives all keystrokes. The arrow keys move
+between items and panels, 'enter' selects the item highlighted, and 'esc'
+closes the current panel. Clicking the mouse outside of the menu bar closes
+it.
+
+- When the user selects a menu item, a control event with the action
+'ControlActionSubmit' is returned. The alias of the item selected can be
+found in its item alias. If the item is checkable, it is checked or
+unchecked before the event is returned.
+
+- Drop-down panels are drawn on their own text layers in front of all other
+text layers, and cast a shadow on whatever is beneath them. They are
+removed as soon as the menu bar is closed.
+
+- Menu bars do not receive focus, and are not part of the tab order. If the
+width of your menu bar is less than or equal to 0, a panic will be
+generated to fail as fast as possible.
+*/
+func AddMenuBar(layerAlias string, menuBarAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int) {
+	if width <= 0 {
+		panic(fmt.Sprintf("The specified menu bar width of '%d' is invalid!", width))
+	}
+	memory.AddMenuBar(layerAlias, menuBarAlias, styleEntry, xLocation, yLocation, width)
+	markMenuBarAsDirty(layerAlias, menuBarAlias)
+}
+
+/*
+DeleteMenuBar allows you to remove a menu bar from a text layer. If the menu
+bar is open, its drop-down panels are removed as well. In addition, the
+following information should be noted:
+
+- If you attempt to delete a menu bar which does not exist, then the request
+will simply be ignored.
+*/
+func DeleteMenuBar(layerAlias string, menuBarAlias string) {
+	if !memory.IsMenuBarExists(layerAlias, menuBarAlias) {
+		return
+	}
+	closeMenuBar(layerAlias, memory.GetMenuBar(layerAlias, menuBarAlias))
+	markMenuBarAsDirty(layerAlias, menuBarAlias)
+	memory.DeleteMenuBar(layerAlias, menuBarAlias)
+}
+
+/*
+AddMenuBarItem allows you to add an item to a menu bar. In addition, the
+following information should be noted:
+
+- If the parent item alias is empty, the item is added to the menu bar row
+itself. Otherwise, it is added to the drop-down panel of the parent item.
+An item which has items of its own opens a submenu when selected.
+
+- Items are displayed in the order they are added.
+
+- If the menu bar or parent item does not exist, or an item with the same
+alias already exists in the menu bar, a panic will be generated to fail as
+fast as possible.
+*/
+func AddMenuBarItem(layerAlias string, menuBarAlias string, parentItemAlias string, itemAlias string, label string) {
+	addMenuBarItem(layerAlias, menuBarAlias, parentItemAlias, itemAlias, label, false, false)
+}
+
+/*
+AddMenuBarCheckItem allows you to add an item to a menu bar which is
+checked or unchecked every time the user selects it. A check mark is drawn
+beside the item while it is checked. All the same rules as
+'AddMenuBarItem' apply.
+*/
+func AddMenuBarCheckItem(layerAlias string, menuBarAlias string, parentItemAlias string, itemAlias string, label string, isChecked bool) {
+	addMenuBarItem(layerAlias, menuBarAlias, parentItemAlias, itemAlias, label, true, isChecked)
+}
+
+/*
+AddMenuBarSeparator allows you to add a horizontal line to the drop-down
+panel of a menu item, so that related items can be grouped together.
+Separators can never be highlighted or selected. If the menu bar or parent
+item does not exist, a panic will be generated to fail as fast as possible.
+*/
+func AddMenuBarSeparator(layerAlias string, menuBarAlias string, parentItemAlias string) {
+	menuBarEntry := memory.GetMenuBar(layerAlias, menuBarAlias)
+	if menuBarEntry.GetItem(parentItemAlias) == nil {
+		panic(fmt.Sprintf("The separator could not be added since the parent item '%s' does not exist in the menu bar '%s'!", parentItemAlias, menuBarAlias))
+	}
+	menuBarItemEntry := memory.NewMenuBarItemEntry()
+	menuBarItemEntry.ParentItemAlias = parentItemAlias
+	menuBarItemEntry.IsSeparator = true
+	menuBarEntry.Items = append(menuBarEntry.Items, menuBarItemEntry)
+	updateMenuBar(layerAlias, menuBarEntry)
+}
+
+/*
+addMenuBarItem allows you to add a normal or checkable item to a menu bar.
+*/
+func addMenuBarItem(layerAlias string, menuBarAlias string, parentItemAlias string, itemAlias string, label string, isCheckable bool, isChecked bool) {
+	menuBarEntry := memory.GetMenuBar(layerAlias, menuBarAlias)
+	if itemAlias == "" || menuBarEntry.GetItem(itemAlias) != nil {
+		panic(fmt.Sprintf("The item '%s' could not be added since its alias is already in use by the menu bar '%s'!", itemAlias, menuBarAlias))
+	}
+	if parentItemAlias != "" && menuBarEntry.GetItem(parentItemAlias) == nil {
+		panic(fmt.Sprintf("The item '%s' could not be added since the parent item '%s' does not exist in the menu bar '%s'!", itemAlias, parentItemAlias, menuBarAlias))
+	}
+	menuBarItemEntry := memory.NewMenuBarItemEntry()
+	menuBarItemEntry.ItemAlias = itemAlias
+	menuBarItemEntry.ParentItemAlias = parentItemAlias
+	menuBarItemEntry.Label = label
+	menuBarItemEntry.IsEnabled = true
+	menuBarItemEntry.IsCheckable = isCheckable
+	menuBarItemEntry.IsChecked = isChecked
+	menuBarEntry.Items = append(menuBarEntry.Items, menuBarItemEntry)
+	updateMenuBar(layerAlias, menuBarEntry)
+}
+
+/*
+SetMenuBarItemEnabled allows you to enable or disable an item of a menu bar.
+Disabled items are drawn using the disabled menu color of the menu bar
+style, and cannot be highlighted or selected. If the menu bar or item does
+not exist, a panic will be generated to fail as fast as possible.
+*/
+func SetMenuBarItemEnabled(layerAlias string, menuBarAlias string, itemAlias string, isEnabled bool) {
+	menuBarEntry := memory.GetMenuBar(layerAlias, menuBarAlias)
+	getMenuBarItem(menuBarEntry, itemAlias).IsEnabled = isEnabled
+	updateMenuBar(layerAlias, menuBarEntry)
+}
+
+/*
+SetMenuBarItemChecked allows you to check or uncheck an item of a menu bar.
+If the item is not checkable, it becomes checkable. If the menu bar or item
+does not exist, a panic will be generated to fail as fast as possible.
+*/
+func SetMenuBarItemChecked(layerAlias string, menuBarAlias string, itemAlias string, isChecked bool) {
+	menuBarEntry := memory.GetMenuBar(layerAlias, menuBarAlias)
+	menuBarItemEntry := getMenuBarItem(menuBarEntry, itemAlias)
+	menuBarItemEntry.IsCheckable = true
+	menuBarItemEntry.IsChecked = isChecked
+	updateMenuBar(layerAlias, menuBarEntry)
+}
+
+/*
+IsMenuBarItemChecked allows you to detect if an item of a menu bar is
+currently checked. If the menu bar or item does not exist, a panic will be
+generated to fail as fast as possible.
+*/
+func IsMenuBarItemChecked(layerAlias string, menuBarAlias string, itemAlias string) bool {
+	return getMenuBarItem(memory.GetMenuBar(layerAlias, menuBarAlias), itemAlias).IsChecked
+}
+
+/*
+getMenuBarItem allows you to obtain an item of a menu bar by its alias. If
+the item does not exist, a panic will be generated to fail as fast as
+possible.
+*/
+func getMenuBarItem(menuBarEntry *memory.MenuBarEntryType, itemAlias string) *memory.MenuBarItemEntryType {
+	menuBarItemEntry := menuBarEntry.GetItem(itemAlias)
+	if menuBarItemEntry == nil {
+		panic(fmt.Sprintf("The item '%s' does not exist in the menu bar '%s'!", itemAlias, menuBarEntry.MenuBarAlias))
+	}
+	return menuBarItemEntry
+}
+
+/*
+isMenuBarItemSelectable allows you to detect if a menu bar item can be
+highlighted or selected by the user.
+*/
+func isMenuBarItemSelectable(menuBarItemEntry *memory.MenuBarItemEntryType) bool {
+	return !menuBarItemEntry.IsSeparator && menuBarItemEntry.IsEnabled
+}
+
+/*
+getMenuBarItemIndex allows you to obtain the index of an item in a list of
+menu bar items. If the item is not in the list, then
+'constants.NullSelectionIndex' is returned instead.
+*/
+func getMenuBarItemIndex(menuBarItems []*memory.MenuBarItemEntryType, itemAlias string) int {
+	for currentIndex, currentMenuBarItemEntry := range menuBarItems {
+		if !currentMenuBarItemEntry.IsSeparator && currentMenuBarItemEntry.ItemAlias == itemAlias {
+			return currentIndex
+		}
+	}
+	return constants.NullSelectionIndex
+}
+
+/*
+getNextSelectableMenuBarItem allows you to find the next item in a list of
+menu bar items which can be selected, starting after the index provided
+and moving in the direction provided. The search wraps around the ends of
+the list. If no item can be selected, then 'nil' is returned instead.
+*/
+func getNextSelectableMenuBarItem(menuBarItems []*memory.MenuBarItemEntryType, currentIndex int, direction int) *memory.MenuBarItemEntryType {
+	numberOfItems := len(menuBarItems)
+	for currentOffset := 1; currentOffset <= numberOfItems; currentOffset++ {
+		itemIndex := ((currentIndex+currentOffset*direction)%numberOfItems + numberOfItems) % numberOfItems
+		if isMenuBarItemSelectable(menuBarItems[itemIndex]) {
+			return menuBarItems[itemIndex]
+		}
+	}
+	return nil
+}
+
+/*
+getMenuBarItemByHotkey allows you to find the selectable item in a list of
+menu bar items which uses the hotkey provided. The comparison is not case
+sensitive. If no item uses the hotkey, then 'nil' is returned instead.
+*/
+func getMenuBarItemByHotkey(menuBarItems []*memory.MenuBarItemEntryType, hotkey rune) *memory.MenuBarItemEntryType {
+	for _, currentMenuBarItemEntry := range menuBarItems {
+		_, itemHotkey, hotkeyColumn := getMenuBarLabelText(currentMenuBarItemEntry.Label)
+		if isMenuBarItemSelectable(currentMenuBarItemEntry) && hotkeyColumn != constants.NullSelectionIndex && unicode.ToLower(itemHotkey) == unicode.ToLower(hotkey) {
+			return currentMenuBarItemEntry
+		}
+	}
+	return nil
+}
+
+/*
+getMenuBarLabelText allows you to obtain the text of a menu bar label as it
+should be displayed, along with its hotkey character and the column where
+the hotkey is drawn. If the label has no hotkey, then
+'constants.NullSelectionIndex' is returned as its column.
+*/
+func getMenuBarLabelText(label string) ([]rune, rune, int) {
+	var labelText []rune
+	var hotkey rune
+	hotkeyColumn := constants.NullSelectionIndex
+	arrayOfRunes := []rune(label)
+	for currentIndex := 0; currentIndex < len(arrayOfRunes); currentIndex++ {
+		if arrayOfRunes[currentIndex] == '&' && currentIndex+1 < len(arrayOfRunes) {
+			currentIndex++
+			if arrayOfRunes[currentIndex] != '&' && hotkeyColumn == constants.NullSelectionIndex {
+				hotkey = arrayOfRunes[currentIndex]
+				hotkeyColumn = getGraphemeClustersWidth(stringformat.GetGraphemeClusters(labelText))
+			}
+		}
+		labelText = append(labelText, arrayOfRunes[currentIndex])
+	}
+	return labelText, hotkey, hotkeyColumn
+}
+
+/*
+getMenuBarLabelWidth allows you to obtain the number of columns needed to
+display the text of a menu bar label.
+*/
+func getMenuBarLabelWidth(labelText []rune) int {
+	return getGraphemeClustersWidth(stringformat.GetGraphemeClusters(labelText))
+}
+
+/*
+getExpandedMenuBarPath allows you to extend the path of open menu bar items
+with the first selectable item of the last item's panel. If the last item
+has no selectable items, then the path is returned unchanged.
+*/
+func getExpandedMenuBarPath(menuBarEntry *memory.MenuBarEntryType, openItemAliases []string) []string {
+	openItemAliases = append([]string(nil), openItemAliases...)
+	childItem := getNextSelectableMenuBarItem(menuBarEntry.GetChildItems(openItemAliases[len(openItemAliases)-1]), -1, 1)
+	if childItem != nil {
+		openItemAliases = append(openItemAliases, childItem.ItemAlias)
+	}
+	return openItemAliases
+}
+
+/*
+openMenuBar allows you to change which items of a menu bar are open. The
+first alias is the top-level item highlighted, and each following alias is
+the item highlighted in the panel of the one before it. If another menu bar
+is currently open, it is closed first.
+*/
+func openMenuBar(layerAlias string, menuBarEntry *memory.MenuBarEntryType, openItemAliases []string) {
+	openLayerAlias, openMenuBarEntry := getOpenMenuBar()
+	if openMenuBarEntry != nil && openMenuBarEntry != menuBarEntry {
+		closeMenuBar(openLayerAlias, openMenuBarEntry)
+	}
+	menuBarEntry.OpenItemAliases = openItemAliases
+	updateMenuBar(layerAlias, menuBarEntry)
+}
+
+/*
+closeMenuBar allows you to close a menu bar, removing all of its drop-down
+panels. If the menu bar is not open, then no operation takes place.
+*/
+func closeMenuBar(layerAlias string, menuBarEntry *memory.MenuBarEntryType) {
+	if len(menuBarEntry.OpenItemAliases) == 0 {
+		return
+	}
+	menuBarEntry.OpenItemAliases = nil
+	updateMenuBar(layerAlias, menuBarEntry)
+}
+
+/*
+selectMenuBarItem allows you to select an item of a menu bar on behalf of
+the user. If the item is checkable, it is checked or unchecked. The menu bar
+is then closed and the control event reporting the selection is returned.
+*/
+func selectMenuBarItem(layerAlias string, menuBarEntry *memory.MenuBarEntryType, menuBarItemEntry *memory.MenuBarItemEntryType) []memory.EventEntryType {
+	if menuBarItemEntry.IsCheckable {
+		menuBarItemEntry.IsChecked = !menuBarItemEntry.IsChecked
+	}
+	closeMenuBar(layerAlias, menuBarEntry)
+	eventEntry := newEventEntry(constants.EventTypeControl)
+	eventEntry.ControlEvent.LayerAlias = layerAlias
+	eventEntry.ControlEvent.ControlAlias = menuBarEntry.MenuBarAlias
+	eventEntry.ControlEvent.ControlType = constants.ControlTypeMenuBar
+	eventEntry.ControlEvent.Action = constants.ControlActionSubmit
+	eventEntry.ControlEvent.ItemAlias = menuBarItemEntry.ItemAlias
+	return []memory.EventEntryType{eventEntry}
+}
+
+/*
+getMenuBars allows you to obtain all menu bars on visible text layers,
+sorted by their layer alias and menu bar alias.
+*/
+func getMenuBars() []menuBarReferenceType {
+	var menuBars []menuBarReferenceType
+	for currentLayerAlias, currentMenuBars := range memory.MenuBarMemory {
+		if !isLayerVisible(currentLayerAlias) {
+			continue
+		}
+		for _, currentMenuBarEntry := range currentMenuBars {
+			menuBars = append(menuBars, menuBarReferenceType{layerAlias: currentLayerAlias, menuBarEntry: currentMenuBarEntry})
+		}
+	}
+	sort.Slice(menuBars, func(firstIndex int, secondIndex int) bool {
+		if menuBars[firstIndex].layerAlias != menuBars[secondIndex].layerAlias {
+			return menuBars[firstIndex].layerAlias < menuBars[secondIndex].layerAlias
+		}
+		return menuBars[firstIndex].menuBarEntry.MenuBarAlias < menuBars[secondIndex].menuBarEntry.MenuBarAlias
+	})
+	return menuBars
+}
+
+/*
+getOpenMenuBar allows you to obtain the menu bar which is currently open,
+along with the text layer it belongs to. If no menu bar is open, then
+'nil' is returned instead.
+*/
+func getOpenMenuBar() (string, *memory.MenuBarEntryType) {
+	for currentLayerAlias, currentMenuBars := range memory.MenuBarMemory {
+		for _, currentMenuBarEntry := range currentMenuBars {
+			if len(currentMenuBarEntry.OpenItemAliases) > 0 {
+				return currentLayerAlias, currentMenuBarEntry
+			}
+		}
+	}
+	return "", nil
+}
+
+/*
+getMenuBarKeyEvents allows you to update the menu bars with a key event. If
+the key event is used by a menu bar, then the events which should be
+reported in its place are returned along with 'true'. Otherwise, 'false' is
+returned and the key event should be processed as normal.
+*/
+func getMenuBarKeyEvents(eventEntry memory.EventEntryType) ([]memory.EventEntryType, bool) {
+	if modalLayerAlias != "" {
+		return nil, false
+	}
+	keyEvent := eventEntry.KeyEvent
+	isAltPressed := keyEvent.Key == tcell.KeyRune && keyEvent.ModifierMask&tcell.ModAlt != 0 && keyEvent.ModifierMask&tcell.ModCtrl == 0
+	layerAlias, menuBarEntry := getOpenMenuBar()
+	if menuBarEntry == nil || isAltPressed {
+		for _, currentMenuBar := range getMenuBars() {
+			topLevelItems := currentMenuBar.menuBarEntry.GetChildItems("")
+			menuBarItemEntry := getNextSelectableMenuBarItem(topLevelItems, -1, 1)
+			if isAltPressed {
+				menuBarItemEntry = getMenuBarItemByHotkey(topLevelItems, keyEvent.Character)
+			} else if keyEvent.Chord != "f10" {
+				break
+			}
+			if menuBarItemEntry != nil {
+				openMenuBar(currentMenuBar.layerAlias, currentMenuBar.menuBarEntry, getExpandedMenuBarPath(currentMenuBar.menuBarEntry, []string{menuBarItemEntry.ItemAlias}))
+				return nil, true
+			}
+		}
+		return nil, menuBarEntry != nil
+	}
+	openItemAliases := menuBarEntry.OpenItemAliases
+	lastItemIndex := len(openItemAliases) - 1
+	lastItemEntry := getMenuBarItem(menuBarEntry, openItemAliases[lastItemIndex])
+	isLastItemExpandable := getNextSelectableMenuBarItem(menuBarEntry.GetChildItems(lastItemEntry.ItemAlias), -1, 1) != nil
+	topLevelItems := menuBarEntry.GetChildItems("")
+	switch keyEvent.Chord {
+	case "esc", "left":
+		if lastItemIndex > 1 {
+			openMenuBar(layerAlias, menuBarEntry, openItemAliases[:lastItemIndex])
+		} else if keyEvent.Chord == "esc" {
+			closeMenuBar(layerAlias, menuBarEntry)
+		} else {
+			topLevelItemEntry := getNextSelectableMenuBarItem(topLevelItems, getMenuBarItemIndex(topLevelItems, openItemAliases[0]), -1)
+			openMenuBar(layerAlias, menuBarEntry, getExpandedMenuBarPath(menuBarEntry, []string{topLevelItemEntry.ItemAlias}))
+		}
+	case "right":
+		if lastItemIndex > 0 && isLastItemExpandable {
+			openMenuBar(layerAlias, menuBarEntry, getExpandedMenuBarPath(menuBarEntry, openItemAliases))
+		} else {
+			topLevelItemEntry := getNextSelectableMenuBarItem(topLevelItems, getMenuBarItemIndex(topLevelItems, openItemAliases[0]), 1)
+			openMenuBar(layerAlias, menuBarEntry, getExpandedMenuBarPath(menuBarEntry, []string{topLevelItemEntry.ItemAlias}))
+		}
+	case "up", "down":
+		direction := 1
+		if keyEvent.Chord == "up" {
+			direction = -1
+		}
+		if lastItemIndex == 0 {
+			if direction == 1 {
+				openMenuBar(layerAlias, menuBarEntry, getExpandedMenuBarPath(menuBarEntry, openItemAliases))
+			}
+			return nil, true
+		}
+		siblingItems := menuBarEntry.GetChildItems(openItemAliases[lastItemIndex-1])
+		siblingItemEntry := getNextSelectableMenuBarItem(siblingItems, getMenuBarItemIndex(siblingItems, lastItemEntry.ItemAlias), direction)
+		openMenuBar(layerAlias, menuBarEntry, append(append([]string(nil), openItemAliases[:lastItemIndex]...), siblingItemEntry.ItemAlias))
+	case "enter":
+		if isLastItemExpandable {
+			openMenuBar(layerAlias, menuBarEntry, getExpandedMenuBarPath(menuBarEntry, openItemAliases))
+		} else if len(menuBarEntry.GetChildItems(lastItemEntry.ItemAlias)) == 0 {
+			return selectMenuBarItem(layerAlias, menuBarEntry, lastItemEntry), true
+		}
+	case "f10":
+		closeMenuBar(layerAlias, menuBarEntry)
+	default:
+		if keyEvent.Key != tcell.KeyRune || keyEvent.ModifierMask&(tcell.ModCtrl|tcell.ModAlt) != 0 {
+			return nil, true
+		}
+		parentItemAlias := ""
+		if lastItemIndex > 0 {
+			parentItemAlias = openItemAliases[lastItemIndex-1]
+		}
+		menuBarItemEntry := getMenuBarItemByHotkey(menuBarEntry.GetChildItems(parentItemAlias), keyEvent.Character)
+		if menuBarItemEntry == nil {
+			return nil, true
+		}
+		openItemAliases = append(append([]string(nil), openItemAliases[:lastItemIndex]...), menuBarItemEntry.ItemAlias)
+		if len(menuBarEntry.GetChildItems(menuBarItemEntry.ItemAlias)) == 0 {
+			return selectMenuBarItem(layerAlias, menuBarEntry, menuBarItemEntry), true
+		}
+		openMenuBar(layerAlias, menuBarEntry, getExpandedMenuBarPath(menuBarEntry, openItemAliases))
+	}
+	return nil, true
+}
+
+/*
+getMenuBarMouseEvents allows you to update the menu bars with a mouse event.
+If the mouse event is used by a menu bar, then the events which should be
+reported in its place are returned along with 'true'. Otherwise, 'false' is
+returned and the mouse event should be processed as normal. In addition,
+the following information should be noted:
+
+- Pressing a top-level item opens it, or closes the menu bar if it was
+already open. Pressing an item with a submenu opens the submenu.
+
+- Releasing the mouse over the item highlighted selects it. Dragging the
+mouse over items highlights them.
+
+- Pressing the mouse anywhere else closes the menu bar, and the mouse event
+is processed as normal.
+*/
+func getMenuBarMouseEvents(eventEntry memory.EventEntryType) ([]memory.EventEntryType, bool) {
+	if modalLayerAlias != "" {
+		return nil, false
+	}
+	mouseEvent := eventEntry.MouseEvent
+	isPressed := mouseEvent.Action == constants.MouseActionPress && mouseEvent.ButtonPressed == 1
+	layerAlias, menuBarEntry := getOpenMenuBar()
+	if menuBarEntry != nil {
+		panelIndex, menuBarItemEntry := getMenuBarPanelItemAtLocation(layerAlias, menuBarEntry, mouseEvent.XLocation, mouseEvent.YLocation)
+		if panelIndex != constants.NullSelectionIndex {
+			if menuBarItemEntry == nil || !isMenuBarItemSelectable(menuBarItemEntry) {
+				return nil, true
+			}
+			openItemAliases := append(append([]string(nil), menuBarEntry.OpenItemAliases[:panelIndex+1]...), menuBarItemEntry.ItemAlias)
+			isLeafItem := len(menuBarEntry.GetChildItems(menuBarItemEntry.ItemAlias)) == 0
+			if mouseEvent.Action == constants.MouseActionRelease && isLeafItem && menuBarEntry.OpenItemAliases[len(menuBarEntry.OpenItemAliases)-1] == menuBarItemEntry.ItemAlias {
+				return selectMenuBarItem(layerAlias, menuBarEntry, menuBarItemEntry), true
+			}
+			if isPressed && !isLeafItem {
+				openMenuBar(layerAlias, menuBarEntry, getExpandedMenuBarPath(menuBarEntry, openItemAliases))
+			} else if isPressed || mouseEvent.Action == constants.MouseActionDrag {
+				openMenuBar(layerAlias, menuBarEntry, openItemAliases)
+			}
+			return nil, true
+		}
+	}
+	if isPressed || (menuBarEntry != nil && mouseEvent.Action == constants.MouseActionDrag) {
+		barLayerAlias, barMenuBarEntry, menuBarItemEntry := getMenuBarItemAtLocation(mouseEvent.LayerAlias, mouseEvent.XLocation, mouseEvent.YLocation)
+		if barMenuBarEntry != nil {
+			if isPressed && barMenuBarEntry == menuBarEntry && menuBarItemEntry != nil && menuBarEntry.OpenItemAliases[0] == menuBarItemEntry.ItemAlias {
+				closeMenuBar(layerAlias, menuBarEntry)
+			} else if menuBarItemEntry != nil && isMenuBarItemSelectable(menuBarItemEntry) && (barMenuBarEntry != menuBarEntry || menuBarEntry.OpenItemAliases[0] != menuBarItemEntry.ItemAlias) {
+				openMenuBar(barLayerAlias, barMenuBarEntry, getExpandedMenuBarPath(barMenuBarEntry, []string{menuBarItemEntry.ItemAlias}))
+			}
+			return nil, true
+		}
+	}
+	if menuBarEntry == nil {
+		return nil, false
+	}
+	if mouseEvent.Action == constants.MouseActionPress {
+		closeMenuBar(layerAlias, menuBarEntry)
+		return nil, false
+	}
+	return nil, mouseEvent.Action == constants.MouseActionRelease || mouseEvent.Action == constants.MouseActionDrag
+}
+
+/*
+getMenuBarItemAtLocation allows you to find the top-level menu bar item at
+the specified terminal location of a text layer. The menu bar under the
+location is returned even if no item is there. If no menu bar is at that
+location, then 'nil' is returned instead.
+*/
+func getMenuBarItemAtLocation(layerAlias string, xLocation int, yLocation int) (string, *memory.MenuBarEntryType, *memory.MenuBarItemEntryType) {
+	if !memory.IsLayerExists(layerAlias) {
+		return "", nil, nil
+	}
+	layerXLocation, layerYLocation := getLayerScreenLocation(memory.GetLayer(layerAlias))
+	xLocation -= layerXLocation
+	yLocation -= layerYLocation
+	for _, currentMenuBarEntry := range memory.MenuBarMemory[layerAlias] {
+		if yLocation != currentMenuBarEntry.YLocation || xLocation < currentMenuBarEntry.XLocation || xLocation >= currentMenuBarEntry.XLocation+currentMenuBarEntry.Width {
+			continue
+		}
+		itemXLocation := currentMenuBarEntry.XLocation + 1
+		for _, currentMenuBarItemEntry := range currentMenuBarEntry.GetChildItems("") {
+			labelText, _, _ := getMenuBarLabelText(currentMenuBarItemEntry.Label)
+			if xLocation >= itemXLocation && xLocation < itemXLocation+getMenuBarLabelWidth(labelText)+2 {
+				return layerAlias, currentMenuBarEntry, currentMenuBarItemEntry
+			}
+			itemXLocation += getMenuBarLabelWidth(labelText) + 2
+		}
+		return layerAlias, currentMenuBarEntry, nil
+	}
+	return "", nil, nil
+}
+
+/*
+getMenuBarPanelItemAtLocation allows you to find the item of an open menu
+bar panel at the specified terminal location. The index of the panel is
+returned, where 0 is the drop-down panel of the top-level item. If the
+location is on a panel but not on an item, then 'nil' is returned as the
+item. If no panel is at that location, then
+'constants.NullSelectionIndex' is returned as the index.
+*/
+func getMenuBarPanelItemAtLocation(layerAlias string, menuBarEntry *memory.MenuBarEntryType, xLocation int, yLocation int) (int, *memory.MenuBarItemEntryType) {
+	for panelIndex := len(menuBarEntry.OpenItemAliases) - 1; panelIndex >= 0; panelIndex-- {
+		panelLayerAlias := getMenuBarPanelLayerAlias(layerAlias, menuBarEntry.MenuBarAlias, panelIndex)
+		if !memory.IsLayerExists(panelLayerAlias) {
+			continue
+		}
+		panelLayerEntry := memory.GetLayer(panelLayerAlias)
+		panelWidth := panelLayerEntry.Width - 2
+		panelHeight := panelLayerEntry.Height - 1
+		column := xLocation - panelLayerEntry.ScreenXLocation
+		row := yLocation - panelLayerEntry.ScreenYLocation
+		if column < 0 || column >= panelWidth || row < 0 || row >= panelHeight {
+			continue
+		}
+		if column == 0 || column == panelWidth-1 || row == 0 || row == panelHeight-1 {
+			return panelIndex, nil
+		}
+		return panelIndex, menuBarEntry.GetChildItems(menuBarEntry.OpenItemAliases[panelIndex])[row-1]
+	}
+	return constants.NullSelectionIndex, nil
+}
+
+/*
+getMenuBarPanelLayerAlias allows you to obtain the alias of the text layer
+used to draw a drop-down panel of a menu bar.
+*/
+func getMenuBarPanelLayerAlias(layerAlias string, menuBarAlias string, panelIndex int) string {
+	return fmt.Sprintf("MenuBarPanel:%s:%s:%d", layerAlias, menuBarAlias, panelIndex)
+}
+
+/*
+updateMenuBar allows you to redraw a menu bar and recreate the drop-down
+panels of all items which are open. In addition, the following information
+should be noted:
+
+- A panel is shown for every open item except the last one, since the last
+one is only highlighted. The top-level item always shows its panel if it
+has any items.
+
+- Panels are placed beneath their top-level item, or beside the item which
+opened them. They are moved left if required to fit on the terminal.
+*/
+func updateMenuBar(layerAlias string, menuBarEntry *memory.MenuBarEntryType) {
+	markMenuBarAsDirty(layerAlias, menuBarEntry.MenuBarAlias)
+	for panelIndex := 0; memory.IsLayerExists(getMenuBarPanelLayerAlias(layerAlias, menuBarEntry.MenuBarAlias, panelIndex)); panelIndex++ {
+		panelLayerAlias := getMenuBarPanelLayerAlias(layerAlias, menuBarEntry.MenuBarAlias, panelIndex)
+		markLayerAsDirty(memory.GetLayer(panelLayerAlias))
+		memory.DeleteLayer(panelLayerAlias)
+	}
+	if !memory.IsLayerExists(layerAlias) {
+		return
+	}
+	openItemAliases := menuBarEntry.OpenItemAliases
+	for panelIndex, currentItemAlias := range openItemAliases {
+		childItems := menuBarEntry.GetChildItems(currentItemAlias)
+		if len(childItems) == 0 || (panelIndex > 0 && panelIndex == len(openItemAliases)-1) {
+			break
+		}
+		panelWidth := 0
+		for _, currentMenuBarItemEntry := range childItems {
+			labelText, _, _ := getMenuBarLabelText(currentMenuBarItemEntry.Label)
+			if getMenuBarLabelWidth(labelText)+8 > panelWidth {
+				panelWidth = getMenuBarLabelWidth(labelText) + 8
+			}
+		}
+		panelHeight := len(childItems) + 2
+		var xLocation, yLocation int
+		if panelIndex == 0 {
+			barXLocation, barYLocation := getLayerScreenLocation(memory.GetLayer(layerAlias))
+			xLocation = barXLocation + menuBarEntry.XLocation + 1
+			for _, currentMenuBarItemEntry := range menuBarEntry.GetChildItems("") {
+				if currentMenuBarItemEntry.ItemAlias == currentItemAlias {
+					break
+				}
+				labelText, _, _ := getMenuBarLabelText(currentMenuBarItemEntry.Label)
+				xLocation += getMenuBarLabelWidth(labelText) + 2
+			}
+			yLocation = barYLocation + menuBarEntry.YLocation + 1
+		} else {
+			parentLayerEntry := memory.GetLayer(getMenuBarPanelLayerAlias(layerAlias, menuBarEntry.MenuBarAlias, panelIndex-1))
+			xLocation = parentLayerEntry.ScreenXLocation + parentLayerEntry.Width - 2
+			yLocation = parentLayerEntry.ScreenYLocation + getMenuBarItemIndex(menuBarEntry.GetChildItems(openItemAliases[panelIndex-1]), currentItemAlias)
+		}
+		panelLayerEntry := addMenuPanelLayer(getMenuBarPanelLayerAlias(layerAlias, menuBarEntry.MenuBarAlias, panelIndex), xLocation, yLocation, panelWidth, panelHeight)
+		highlightedItemAlias := ""
+		if panelIndex+1 < len(openItemAliases) {
+			highlightedItemAlias = openItemAliases[panelIndex+1]
+		}
+		drawMenuBarPanel(panelLayerEntry, menuBarEntry, childItems, highlightedItemAlias)
+	}
+}
+
+/*
+addMenuPanelLayer allows you to create a text layer in front of all other
+text layers, which is used to draw a menu panel of the size provided. The
+text layer is two columns wider and one row taller than the panel to make
+room for its shadow. In addition, the following information should be
+noted:
+
+- If the panel and its shadow do not fit on the terminal at the location
+provided, it is moved up or left until they do.
+
+- If a text layer with the same alias already exists, it is replaced.
+*/
+func addMenuPanelLayer(layerAlias string, xLocation int, yLocation int, panelWidth int, panelHeight int) *memory.LayerEntryType {
+	if xLocation+panelWidth+2 > commonResource.terminalWidth {
+		xLocation = commonResource.terminalWidth - panelWidth - 2
+	}
+	if yLocation+panelHeight+1 > commonResource.terminalHeight {
+		yLocation = commonResource.terminalHeight - panelHeight - 1
+	}
+	if xLocation < 0 {
+		xLocation = 0
+	}
+	if yLocation < 0 {
+		yLocation = 0
+	}
+	highestZOrder := 0
+	for currentLayerAlias, currentLayerEntry := range memory.ScreenMemory {
+		if currentLayerAlias != layerAlias && currentLayerEntry.ParentAlias == "" && currentLayerEntry.ZOrder > highestZOrder {
+			highestZOrder = currentLayerEntry.ZOrder
+		}
+	}
+	if memory.IsLayerExists(layerAlias) {
+		markLayerAsDirty(memory.GetLayer(layerAlias))
+		memory.DeleteLayer(layerAlias)
+	}
+	memory.AddLayer(layerAlias, xLocation, yLocation, panelWidth+2, panelHeight+1, highestZOrder+1, "")
+	layerEntry := memory.GetLayer(layerAlias)
+	markLayerAsDirty(layerEntry)
+	return layerEntry
+}
+
+/*
+markMenuBarAsDirty allows you to flag the row of a text layer covered by a
+menu bar as modified, so that the menu bar is redrawn the next time the
+display is updated. If the text layer does not exist, then the request will
+simply be ignored.
+*/
+func markMenuBarAsDirty(layerAlias string, menuBarAlias string) {
+	if !memory.IsLayerExists(layerAlias) || !memory.IsMenuBarExists(layerAlias, menuBarAlias) {
+		return
+	}
+	menuBarEntry := memory.GetMenuBar(layerAlias, menuBarAlias)
+	memory.GetLayer(layerAlias).MarkDirtyRegion(menuBarEntry.XLocation, menuBarEntry.YLocation, menuBarEntry.Width, 1)
+}
+
+/*
+drawMenuBarsOnLayer allows you to draw all menu bars on a given text layer
+entry.
+*/
+func drawMenuBarsOnLayer(layerEntry memory.LayerEntryType) {
+	for _, currentMenuBarEntry := range memory.MenuBarMemory[layerEntry.LayerAlias] {
+		drawMenuBar(&layerEntry, currentMenuBarEntry)
+	}
+}
+
+/*
+drawMenuBar allows you to draw the row of top-level items of a menu bar on
+a given text layer. The top-level item which is open is drawn using the
+highlight colors of the menu bar style. Items which do not fit inside the
+width of the menu bar are not drawn.
+*/
+func drawMenuBar(layerEntry *memory.LayerEntryType, menuBarEntry *memory.MenuBarEntryType) {
+	styleEntry := menuBarEntry.StyleEntry
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.MenuForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.MenuBackgroundColor
+	fillArea(layerEntry, attributeEntry, " ", menuBarEntry.XLocation, menuBarEntry.YLocation, menuBarEntry.Width, 1)
+	column := 1
+	for _, currentMenuBarItemEntry := range menuBarEntry.GetChildItems("") {
+		labelText, _, _ := getMenuBarLabelText(currentMenuBarItemEntry.Label)
+		if column+getMenuBarLabelWidth(labelText)+2 > menuBarEntry.Width {
+			break
+		}
+		itemAttributeEntry := getMenuBarItemAttributeEntry(styleEntry, currentMenuBarItemEntry, len(menuBarEntry.OpenItemAliases) > 0 && menuBarEntry.OpenItemAliases[0] == currentMenuBarItemEntry.ItemAlias)
+		fillArea(layerEntry, itemAttributeEntry, " ", menuBarEntry.XLocation+column, menuBarEntry.YLocation, getMenuBarLabelWidth(labelText)+2, 1)
+		drawMenuBarLabel(layerEntry, itemAttributeEntry, currentMenuBarItemEntry, menuBarEntry.XLocation+column+1, menuBarEntry.YLocation)
+		column += getMenuBarLabelWidth(labelText) + 2
+	}
+}
+
+/*
+drawMenuBarPanel allows you to draw a drop-down panel of menu bar items on
+a given text layer. The panel fills the text layer, except for the last
+two columns and the last row where its shadow is drawn.
+*/
+func drawMenuBarPanel(layerEntry *memory.LayerEntryType, menuBarEntry *memory.MenuBarEntryType, menuBarItems []*memory.MenuBarItemEntryType, highlightedItemAlias string) {
+	styleEntry := menuBarEntry.StyleEntry
+	panelStyleEntry := styleEntry
+	panelStyleEntry.TextForegroundColor = styleEntry.MenuForegroundColor
+	panelStyleEntry.TextBackgroundColor = styleEntry.MenuBackgroundColor
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.MenuForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.MenuBackgroundColor
+	panelWidth := layerEntry.Width - 2
+	drawMenuPanel(layerEntry, styleEntry)
+	for currentIndex, currentMenuBarItemEntry := range menuBarItems {
+		yLocation := currentIndex + 1
+		if currentMenuBarItemEntry.IsSeparator {
+			drawHorizontalLine(layerEntry, panelStyleEntry, attributeEntry, 0, yLocation, panelWidth, true)
+			continue
+		}
+		itemAttributeEntry := getMenuBarItemAttributeEntry(styleEntry, currentMenuBarItemEntry, currentMenuBarItemEntry.ItemAlias == highlightedItemAlias)
+		fillArea(layerEntry, itemAttributeEntry, " ", 1, yLocation, panelWidth-2, 1)
+		if currentMenuBarItemEntry.IsChecked {
+			printLayer(layerEntry, itemAttributeEntry, 2, yLocation, []rune{constants.CharCheckMark})
+		}
+		drawMenuBarLabel(layerEntry, itemAttributeEntry, currentMenuBarItemEntry, 4, yLocation)
+		if len(menuBarEntry.GetChildItems(currentMenuBarItemEntry.ItemAlias)) > 0 {
+			printLayer(layerEntry, itemAttributeEntry, panelWidth-3, yLocation, []rune{constants.CharArrowRight})
+		}
+	}
+}
+
+/*
+getMenuBarItemAttributeEntry allows you to obtain the attributes used to
+draw a menu bar item, depending on whether it is highlighted or disabled.
+*/
+func getMenuBarItemAttributeEntry(styleEntry memory.TuiStyleEntryType, menuBarItemEntry *memory.MenuBarItemEntryType, isHighlighted bool) memory.AttributeEntryType {
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.MenuForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.MenuBackgroundColor
+	if isHighlighted {
+		attributeEntry.ForegroundColor = styleEntry.HighlightForegroundColor
+		attributeEntry.BackgroundColor = styleEntry.HighlightBackgroundColor
+	}
+	if !menuBarItemEntry.IsEnabled {
+		attributeEntry.ForegroundColor = styleEntry.MenuDisabledForegroundColor
+	}
+	return attributeEntry
+}
+
+/*
+drawMenuBarLabel allows you to draw the label of a menu bar item on a given
+text layer. The hotkey of the item is drawn underlined, unless the item is
+disabled.
+*/
+func drawMenuBarLabel(layerEntry *memory.LayerEntryType, attributeEntry memory.AttributeEntryType, menuBarItemEntry *memory.MenuBarItemEntryType, xLocation int, yLocation int) {
+	labelText, _, hotkeyColumn := getMenuBarLabelText(menuBarItemEntry.Label)
+	column := 0
+	for _, currentGraphemeCluster := range stringformat.GetGraphemeClusters(labelText) {
+		characterAttributeEntry := attributeEntry
+		if column == hotkeyColumn && menuBarItemEntry.IsEnabled {
+			characterAttributeEntry.IsUnderlined = true
+		}
+		printLayer(layerEntry, characterAttributeEntry, xLocation+column, yLocation, currentGraphemeCluster)
+		column += stringformat.GetGraphemeClusterWidth(currentGraphemeCluster)
+	}
+}
@@ -0,0 +1,114 @@
+package dosktop
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestMenuBar(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(30, 12)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Desktop", 0, 0, 30, 12, 1, "")
+	AddMenuBar("Desktop", "Main", NewTuiStyleEntry(), 0, 0, 30)
+	AddMenuBarItem("Desktop", "Main", "", "file", "&File")
+	AddMenuBarItem("Desktop", "Main", "file", "new", "&New")
+	AddMenuBarItem("Desktop", "Main", "file", "open", "&Open")
+	AddMenuBarSeparator("Desktop", "Main", "file")
+	AddMenuBarItem("Desktop", "Main", "file", "recent", "&Recent")
+	AddMenuBarItem("Desktop", "Main", "recent", "alpha", "&Alpha")
+	AddMenuBarItem("Desktop", "Main", "recent", "beta", "&Beta")
+	AddMenuBarItem("Desktop", "Main", "file", "exit", "E&xit")
+	AddMenuBarItem("Desktop", "Main", "", "view", "&View")
+	AddMenuBarCheckItem("Desktop", "Main", "view", "wrap", "&Wrap", false)
+	AddMenuBarItem("Desktop", "Main", "view", "status", "&Status")
+	SetMenuBarItemEnabled("Desktop", "Main", "status", false)
+	injectKey := func(key tcell.Key, character rune, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventKey(key, character, modifierMask))
+	}
+	injectMouse := func(xLocation int, yLocation int, buttonMask tcell.ButtonMask, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventMouse(xLocation, yLocation, buttonMask, modifierMask))
+	}
+	getSelectedItem := func() string {
+		for {
+			if eventEntry := WaitForEvent(); eventEntry.EventType == constants.EventTypeControl {
+				assert.Equalf(test, []interface{}{"Main", constants.ControlTypeMenuBar, constants.ControlActionSubmit}, []interface{}{eventEntry.ControlEvent.ControlAlias, eventEntry.ControlEvent.ControlType, eventEntry.ControlEvent.Action}, "The menu bar did not report a submit event!")
+				return eventEntry.ControlEvent.ItemAlias
+			}
+		}
+	}
+	injectKey(tcell.KeyRune, 'f', tcell.ModAlt)
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	for len(memory.GetMenuBar("Desktop", "Main").OpenItemAliases) == 0 || memory.GetMenuBar("Desktop", "Main").OpenItemAliases[1] != "recent" {
+		PollEvent()
+	}
+	UpdateDisplay()
+	cells, width, _ := simulationScreen.GetContents()
+	getScreenText := func(xLocation int, yLocation int, length int) string {
+		obtainedValue := ""
+		for currentXLocation := xLocation; currentXLocation < xLocation+length; currentXLocation++ {
+			obtainedValue += string(cells[yLocation*width+currentXLocation].Runes)
+		}
+		return obtainedValue
+	}
+	_, _, attributeMask := cells[2*width+5].Style.Decompose()
+	assert.Equalf(test, []string{"  File  View", "┌────────────┐", "│   New      │", "├────────────┤", "│   Recent → │"}, []string{getScreenText(0, 0, 12), getScreenText(1, 1, 14), getScreenText(1, 2, 14), getScreenText(1, 4, 14), getScreenText(1, 5, 14)}, "The drop-down panel was not drawn correctly!")
+	assert.Truef(test, attributeMask&tcell.AttrUnderline != 0, "The hotkey of a menu item was not underlined!")
+	injectKey(tcell.KeyRight, 0, tcell.ModNone)
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, "beta", getSelectedItem(), "Navigating into a submenu did not select the correct item!")
+	assert.Falsef(test, memory.IsLayerExists(getMenuBarPanelLayerAlias("Desktop", "Main", 0)), "The drop-down panels were not removed after an item was selected!")
+	injectKey(tcell.KeyRune, 'v', tcell.ModAlt)
+	injectKey(tcell.KeyRune, 'w', tcell.ModNone)
+	assert.Equalf(test, "wrap", getSelectedItem(), "Pressing the hotkey of an item did not select it!")
+	assert.Truef(test, IsMenuBarItemChecked("Desktop", "Main", "wrap"), "Selecting a checkable item did not check it!")
+	injectKey(tcell.KeyRune, 'v', tcell.ModAlt)
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	injectKey(tcell.KeyRune, 's', tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, "wrap", getSelectedItem(), "A disabled item could be highlighted or selected!")
+	assert.Falsef(test, IsMenuBarItemChecked("Desktop", "Main", "wrap"), "Selecting a checked item did not uncheck it!")
+	injectMouse(2, 0, tcell.Button1, tcell.ModNone)
+	injectMouse(2, 0, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(5, 5, tcell.Button1, tcell.ModNone)
+	injectMouse(5, 5, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(18, 5, tcell.Button1, tcell.ModNone)
+	injectMouse(18, 5, tcell.ButtonNone, tcell.ModNone)
+	assert.Equalf(test, "alpha", getSelectedItem(), "Clicking an item in a submenu did not select it!")
+	injectMouse(2, 0, tcell.Button1, tcell.ModNone)
+	injectMouse(2, 0, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(20, 10, tcell.Button1, tcell.ModNone)
+	for {
+		eventEntry := WaitForEvent()
+		if eventEntry.EventType == constants.EventTypeMouse && eventEntry.MouseEvent.Action == constants.MouseActionPress {
+			assert.Equalf(test, []interface{}{20, 10}, []interface{}{eventEntry.MouseEvent.XLocation, eventEntry.MouseEvent.YLocation}, "Only a click outside the menu bar should be reported!")
+			break
+		}
+	}
+	assert.Emptyf(test, memory.GetMenuBar("Desktop", "Main").OpenItemAliases, "Clicking outside the menu bar did not close it!")
+	AddMenuBarItem("Desktop", "Main", "", "help", "日本&語")
+	_, _, hotkeyColumn := getMenuBarLabelText("日本&語")
+	_, _, firstMenuBarItemEntry := getMenuBarItemAtLocation("Desktop", 20, 0)
+	_, _, secondMenuBarItemEntry := getMenuBarItemAtLocation("Desktop", 21, 0)
+	assert.Equalf(test, []interface{}{4, "help", (*memory.MenuBarItemEntryType)(nil)}, []interface{}{hotkeyColumn, firstMenuBarItemEntry.ItemAlias, secondMenuBarItemEntry}, "The width of a label with wide characters was not measured in columns!")
+	assert.Panicsf(test, func() { AddMenuBarItem("Desktop", "Main", "file", "new", "New") }, "Adding an item with a duplicate alias did not panic!")
+	assert.Panicsf(test, func() { AddMenuBarItem("Desktop", "Main", "missing", "save", "Save") }, "Adding an item to a parent which does not exist did not panic!")
+	assert.Panicsf(test, func() { SetMenuBarItemEnabled("Desktop", "Main", "missing", true) }, "Enabling an item which does not exist did not panic!")
+	DeleteMenuBar("Desktop", "Main")
+	assert.Falsef(test, memory.IsMenuBarExists("Desktop", "Main"), "The menu bar was not deleted!")
+}
+
+func TestMenuPanelLayerShadowFitsTerminal(test *testing.T) {
+	_, err := InitializeHeadlessTerminal(20, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	layerEntry := addMenuPanelLayer("Panel", 15, 8, 6, 4)
+	assert.Equalf(test, []int{12, 5, 8, 5}, []int{layerEntry.ScreenXLocation, layerEntry.ScreenYLocation, layerEntry.Width, layerEntry.Height}, "The menu panel was not moved far enough for its shadow to fit on the terminal!")
+	layerEntry = addMenuPanelLayer("Panel", 2, 3, 6, 4)
+	assert.Equalf(test, []int{2, 3}, []int{layerEntry.ScreenXLocation, layerEntry.ScreenYLocation}, "A menu panel which fits on the terminal was moved!")
+}
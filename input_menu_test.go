@@ -1,6 +1,7 @@
 package dosktop
 
 import (
+	"github.com/gdamore/tcell"
 	"github.com/supercom32/dosktop/constants"
 	"github.com/supercom32/dosktop/internal/memory"
 	"github.com/stretchr/testify/assert"
@@ -119,4 +120,60 @@ func TestInput(test *testing.T) {
 	obtainedValue := layerEntry.GetBasicAnsiStringAsBase64()
 	expectedValue := "G1szODsyOzA7MDsxMjhtG1s0ODsyOzA7MTI4OzEyOG1hMWEyYTNhNGE1YTFhMmEzYTRhNRtbMzg7MjswOzA7MG0bWzQ4OzI7MDswOzBtChtbMzg7MjswOzA7MTI4bRtbNDg7MjswOzEyODsxMjhtYTEbWzM4OzI7MjU1OzI1NTsyNTVtG1s0ODsyOzA7MDswbWhpcyBpcyBhIGVkaXQh4paIG1szODsyOzA7MDsxMjhtG1s0ODsyOzA7MTI4OzEyOG00YTUbWzM4OzI7MDswOzBtG1s0ODsyOzA7MDswbQobWzM4OzI7MDswOzEyOG0bWzQ4OzI7MDsxMjg7MTI4bWExYTJhM2E0YTVhMWEyYTNhNGE1G1szODsyOzA7MDswbRtbNDg7MjswOzA7MG0K"
 	assert.Equalf(test, expectedValue, obtainedValue, "The updated screen does not match the master original!")
-}
\ No newline at end of file
+}
+
+func TestInputUnicode(test *testing.T) {
+	commonResource.isDebugEnabled = true
+	InitializeTerminal(20, 3)
+	AddLayer("Layer1", 0, 0, 20, 3, 1, "")
+	memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer("a", "e", "\u0301", "读", "👍", "🏽", "left", "backspace", "end", "x")
+	memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer("enter")
+	obtainedValue := GetInput("Layer1", memory.NewTuiStyleEntry(), 2, 1, 6, 4, false, "")
+	assert.Equalf(test, "ae\u0301👍🏽x", obtainedValue, "The input was not edited one grapheme cluster at a time!")
+	characterMemory := memory.GetLayer("Layer1").CharacterMemory
+	assert.Equalf(test, []interface{}{'e', "\u0301", '👍', "🏽", 'x'}, []interface{}{characterMemory[1][3].Character, characterMemory[1][3].CombiningCharacters, characterMemory[1][4].Character, characterMemory[1][4].CombiningCharacters, characterMemory[1][6].Character}, "The input was not drawn with combining and wide characters in the correct cells!")
+	memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer("读", "enter")
+	obtainedValue = GetInput("Layer1", memory.NewTuiStyleEntry(), 2, 1, 6, 4, false, "读读读")
+	assert.Equalf(test, "读读读读", obtainedValue, "The default value was not edited correctly!")
+	assert.Equalf(test, []rune{'读', ' ', '读', ' ', memory.NewTuiStyleEntry().CursorCharacter, ' '}, []rune{characterMemory[1][2].Character, characterMemory[1][3].Character, characterMemory[1][4].Character, characterMemory[1][5].Character, characterMemory[1][6].Character, characterMemory[1][7].Character}, "The viewport did not scroll to keep the cursor visible past wide characters!")
+}
+
+func TestMenuControl(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	selectionEntry := memory.NewSelectionEntry()
+	for _, currentItem := range []string{"One", "Two", "Three", "Four", "Five"} {
+		selectionEntry.Add(currentItem+"Alias", currentItem)
+	}
+	AddLayer("Form", 0, 0, 20, 10, 1, "")
+	AddMenu("Form", "MyMenu", NewTuiStyleEntry(), selectionEntry, 1, 1, 10, 3, 1)
+	SetFocus("Form", "MyMenu")
+	simulationScreen.InjectKey(tcell.KeyDown, 0, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyDown, 0, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyEnd, 0, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyEnd, 0, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	for currentIndex := 0; currentIndex < 3; currentIndex++ {
+		eventEntry := WaitForEvent()
+		assert.Equalf(test, []interface{}{constants.EventTypeControl, constants.ControlActionChange}, []interface{}{eventEntry.EventType, eventEntry.ControlEvent.Action}, "Moving the selection did not report a change!")
+	}
+	eventEntry := WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.EventTypeControl, constants.ControlActionSubmit}, []interface{}{eventEntry.EventType, eventEntry.ControlEvent.Action}, "Pressing enter did not submit the menu!")
+	assert.Equalf(test, "FiveAlias", GetMenuSelection("Form", "MyMenu"), "The menu selection is not correct!")
+	assert.Equalf(test, 2, memory.GetMenu("Form", "MyMenu").ViewportPosition, "The menu did not scroll to the item selected!")
+	simulationScreen.InjectMouse(3, 1, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(3, 1, tcell.ButtonNone, tcell.ModNone)
+	var controlActions []int
+	for len(controlActions) < 2 {
+		eventEntry = WaitForEvent()
+		if eventEntry.EventType == constants.EventTypeControl {
+			controlActions = append(controlActions, eventEntry.ControlEvent.Action)
+		}
+	}
+	assert.Equalf(test, []int{constants.ControlActionChange, constants.ControlActionSubmit}, controlActions, "Clicking a menu item did not select and submit it!")
+	assert.Equalf(test, 2, GetMenuSelectionIndex("Form", "MyMenu"), "Clicking a menu item did not select it!")
+	assert.Panicsf(test, func() { SetMenuSelection("Form", "MyMenu", 5) }, "Selecting an invalid menu item did not panic!")
+	DeleteMenu("Form", "MyMenu")
+	assert.Falsef(test, memory.IsMenuExists("Form", "MyMenu"), "The menu was not deleted!")
+}
@@ -0,0 +1,213 @@
+package dosktop
+
+import (
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+)
+
+/*
+popupMenuLayerAlias is the alias of the temporary text layer used to draw
+popup menus.
+*/
+const popupMenuLayerAlias = "PopupMenu"
+
+/*
+GetSelectionFromPopupMenu allows you to obtain a user selection from a
+popup menu, such as one opened when the user clicks the right mouse button.
+In addition, the following information should be noted:
+
+- The menu is opened with its top left corner at the terminal location
+provided, which is usually the location of the mouse event that requested
+it. If the menu does not fit on the terminal, it is moved up or left until
+it does.
+
+- The menu is drawn on a temporary text layer in front of all other text
+layers, using the menu colors of the style provided, and casts a shadow on
+whatever is beneath it. The text layer is removed once the menu closes.
+
+- The returned value is the selection alias for the item that was chosen.
+If the user presses 'esc', clicks outside of the menu, or the terminal is
+resized, then an empty string is returned instead.
+
+- If the menu has more items than can fit on the terminal, a scroll bar is
+drawn and the menu can be scrolled with the keyboard or mouse wheel.
+
+- This method blocks until a selection is made. Any events received while
+the menu is open are used by the menu and are not reported by 'PollEvent'.
+*/
+func GetSelectionFromPopupMenu(styleEntry memory.TuiStyleEntryType, selectionEntry memory.SelectionEntryType, xLocation int, yLocation int) string {
+	selectionIndex := GetSelectionFromPopupMenuByIndex(styleEntry, selectionEntry, xLocation, yLocation)
+	if selectionIndex == constants.NullSelectionIndex {
+		return ""
+	}
+	return selectionEntry.SelectionAlias[selectionIndex]
+}
+
+/*
+GetSelectionFromPopupMenuByIndex allows you to obtain a user selection from
+a popup menu. All the same rules as 'GetSelectionFromPopupMenu' apply,
+except that the returned value is the index number of your selection,
+where 0 is the first item on your selection list. If no item was chosen,
+then 'constants.NullSelectionIndex' is returned instead. If the selection
+provided has no items, a panic will be generated to fail as fast as
+possible.
+*/
+func GetSelectionFromPopupMenuByIndex(styleEntry memory.TuiStyleEntryType, selectionEntry memory.SelectionEntryType, xLocation int, yLocation int) int {
+	numberOfItems := len(selectionEntry.SelectionValue)
+	if numberOfItems == 0 {
+		panic("The popup menu could not be opened since the selection provided has no items!")
+	}
+	menuWidth := 0
+	for _, currentMenuItem := range selectionEntry.SelectionValue {
+		arrayOfRunes := stringformat.GetRunesFromString(currentMenuItem)
+		if len(arrayOfRunes)+stringformat.GetNumberOfWideCharacters(arrayOfRunes) > menuWidth {
+			menuWidth = len(arrayOfRunes) + stringformat.GetNumberOfWideCharacters(arrayOfRunes)
+		}
+	}
+	menuWidth += 2
+	menuHeight := numberOfItems
+	if menuHeight > commonResource.terminalHeight-3 {
+		menuHeight = commonResource.terminalHeight - 3
+	}
+	if menuHeight < 1 {
+		menuHeight = 1
+	}
+	layerEntry := addMenuPanelLayer(popupMenuLayerAlias, xLocation, yLocation, menuWidth+2, menuHeight+2)
+	itemHighlighted := 0
+	viewportPosition := 0
+	isMouseTracking := false
+	returnValue := constants.NullSelectionIndex
+	isMenuClosed := false
+	for !isMenuClosed {
+		if itemHighlighted < viewportPosition {
+			viewportPosition = itemHighlighted
+		} else if itemHighlighted >= viewportPosition+menuHeight {
+			viewportPosition = itemHighlighted - menuHeight + 1
+		}
+		drawPopupMenu(layerEntry, styleEntry, selectionEntry, menuWidth, menuHeight, viewportPosition, itemHighlighted)
+		UpdateDisplay()
+		eventEntry := waitForPopupMenuEvent()
+		switch eventEntry.EventType {
+		case constants.EventTypeKey:
+			switch eventEntry.KeyEvent.Chord {
+			case "up":
+				if itemHighlighted > 0 {
+					itemHighlighted--
+				}
+			case "down":
+				if itemHighlighted < numberOfItems-1 {
+					itemHighlighted++
+				}
+			case "pgup":
+				itemHighlighted -= menuHeight
+				if itemHighlighted < 0 {
+					itemHighlighted = 0
+				}
+			case "pgdn":
+				itemHighlighted += menuHeight
+				if itemHighlighted >= numberOfItems {
+					itemHighlighted = numberOfItems - 1
+				}
+			case "home":
+				itemHighlighted = 0
+			case "end":
+				itemHighlighted = numberOfItems - 1
+			case "enter":
+				returnValue = itemHighlighted
+				isMenuClosed = true
+			case "esc":
+				isMenuClosed = true
+			}
+		case constants.EventTypeMouse:
+			mouseEvent := eventEntry.MouseEvent
+			column := mouseEvent.XLocation - layerEntry.ScreenXLocation
+			row := mouseEvent.YLocation - layerEntry.ScreenYLocation
+			isInsideMenu := column >= 0 && column < menuWidth+2 && row >= 0 && row < menuHeight+2
+			itemIndex := constants.NullSelectionIndex
+			if column >= 1 && column <= menuWidth && row >= 1 && row <= menuHeight && viewportPosition+row-1 < numberOfItems {
+				itemIndex = viewportPosition + row - 1
+			}
+			switch mouseEvent.Action {
+			case constants.MouseActionPress, constants.MouseActionDrag:
+				if !isInsideMenu && mouseEvent.Action == constants.MouseActionPress {
+					isMenuClosed = true
+				} else if column == menuWidth+1 && row >= 1 && row <= menuHeight && numberOfItems > menuHeight {
+					viewportPosition = getVerticalScrollBarViewportPosition(row-1, menuHeight, numberOfItems, menuHeight)
+					itemHighlighted = viewportPosition
+				} else if itemIndex != constants.NullSelectionIndex {
+					itemHighlighted = itemIndex
+					isMouseTracking = true
+				}
+			case constants.MouseActionRelease:
+				if isMouseTracking && itemIndex != constants.NullSelectionIndex {
+					returnValue = itemIndex
+					isMenuClosed = true
+				}
+				isMouseTracking = false
+			case constants.MouseActionWheel:
+				if mouseEvent.WheelState == "Up" && itemHighlighted > 0 {
+					itemHighlighted--
+				} else if mouseEvent.WheelState == "Down" && itemHighlighted < numberOfItems-1 {
+					itemHighlighted++
+				}
+			}
+		case constants.EventTypeResize:
+			isMenuClosed = true
+		}
+	}
+	markLayerAsDirty(layerEntry)
+	memory.DeleteLayer(popupMenuLayerAlias)
+	UpdateDisplay()
+	return returnValue
+}
+
+/*
+waitForPopupMenuEvent allows you to wait until a keyboard, mouse, or resize
+event is available and then obtain it directly from the event queue. Since
+the event does not pass through 'PollEvent', it is not delivered to any
+control, menu bar, or key binding. All other events are discarded.
+*/
+func waitForPopupMenuEvent() memory.EventEntryType {
+	pauseEventChannel()
+	defer resumeEventChannel()
+	notificationChannel := memory.EventMemory.GetNotificationChannel()
+	for {
+		eventEntry, isEventAvailable := memory.EventMemory.GetEvent()
+		if !isEventAvailable {
+			<-notificationChannel
+			continue
+		}
+		switch eventEntry.EventType {
+		case constants.EventTypeResize:
+			applyPendingResize()
+			return eventEntry
+		case constants.EventTypeKey, constants.EventTypeMouse:
+			return eventEntry
+		}
+	}
+}
+
+/*
+drawPopupMenu allows you to draw a popup menu on a given text layer entry.
+The item highlighted is the index of the item in the selection provided,
+and must be visible within the viewport.
+*/
+func drawPopupMenu(layerEntry *memory.LayerEntryType, styleEntry memory.TuiStyleEntryType, selectionEntry memory.SelectionEntryType, menuWidth int, menuHeight int, viewportPosition int, itemHighlighted int) {
+	drawMenuPanel(layerEntry, styleEntry)
+	for currentRow := 0; currentRow < menuHeight && viewportPosition+currentRow < len(selectionEntry.SelectionValue); currentRow++ {
+		attributeEntry := memory.NewAttributeEntry()
+		attributeEntry.ForegroundColor = styleEntry.MenuForegroundColor
+		attributeEntry.BackgroundColor = styleEntry.MenuBackgroundColor
+		if viewportPosition+currentRow == itemHighlighted {
+			attributeEntry.ForegroundColor = styleEntry.HighlightForegroundColor
+			attributeEntry.BackgroundColor = styleEntry.HighlightBackgroundColor
+		}
+		fillArea(layerEntry, attributeEntry, " ", 1, currentRow+1, menuWidth, 1)
+		printLayer(layerEntry, attributeEntry, 2, currentRow+1, stringformat.GetRunesFromString(selectionEntry.SelectionValue[viewportPosition+currentRow]))
+	}
+	if len(selectionEntry.SelectionValue) > menuHeight {
+		drawVerticalScrollBar(layerEntry, styleEntry, menuWidth+1, 1, menuHeight, len(selectionEntry.SelectionValue), menuHeight, viewportPosition)
+	}
+	markLayerAsDirty(layerEntry)
+}
@@ -0,0 +1,63 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+	"time"
+)
+
+func TestPopupMenu(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Desktop", 0, 0, 20, 10, 1, "")
+	selectionEntry := memory.NewSelectionEntry()
+	selectionEntry.Add("cut", "Cut")
+	selectionEntry.Add("copy", "Copy")
+	selectionEntry.Add("paste", "Paste")
+	injectKey := func(key tcell.Key, character rune, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventKey(key, character, modifierMask))
+	}
+	injectMouse := func(xLocation int, yLocation int, buttonMask tcell.ButtonMask, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventMouse(xLocation, yLocation, buttonMask, modifierMask))
+	}
+	waitForMouseEvents := func(numberOfEvents int) {
+		numberOfEvents += memory.EventMemory.GetNumberOfEvents() + 1
+		injectKey(tcell.KeyF12, 0, tcell.ModNone)
+		timeout := time.Now().Add(time.Second)
+		for memory.EventMemory.GetNumberOfEvents() < numberOfEvents && time.Now().Before(timeout) {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, "copy", GetSelectionFromPopupMenu(NewTuiStyleEntry(), selectionEntry, 2, 2), "Selecting an item with the keyboard did not return its alias!")
+	assert.Falsef(test, memory.IsLayerExists(popupMenuLayerAlias), "The popup menu layer was not removed after the menu closed!")
+	injectMouse(18, 8, tcell.Button2, tcell.ModNone)
+	for eventEntry := WaitForEvent(); eventEntry.EventType != constants.EventTypeMouse; eventEntry = WaitForEvent() {
+	}
+	injectMouse(18, 8, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(13, 5, tcell.Button1, tcell.ModNone)
+	injectMouse(13, 5, tcell.ButtonNone, tcell.ModNone)
+	waitForMouseEvents(3)
+	assert.Equalf(test, "cut", GetSelectionFromPopupMenu(NewTuiStyleEntry(), selectionEntry, 18, 8), "The popup menu was not moved to fit on the terminal, or the release which opened it selected an item!")
+	injectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	assert.Equalf(test, "", GetSelectionFromPopupMenu(NewTuiStyleEntry(), selectionEntry, 2, 2), "Pressing escape did not cancel the popup menu!")
+	injectMouse(0, 0, tcell.Button1, tcell.ModNone)
+	injectMouse(0, 0, tcell.ButtonNone, tcell.ModNone)
+	waitForMouseEvents(2)
+	assert.Equalf(test, "", GetSelectionFromPopupMenu(NewTuiStyleEntry(), selectionEntry, 2, 2), "Clicking outside of the popup menu did not cancel it!")
+	selectionEntry = memory.NewSelectionEntry()
+	for currentIndex := 0; currentIndex < 20; currentIndex++ {
+		selectionEntry.Add(fmt.Sprintf("item%d", currentIndex), fmt.Sprintf("Item %d", currentIndex))
+	}
+	injectKey(tcell.KeyEnd, 0, tcell.ModNone)
+	injectKey(tcell.KeyUp, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, 18, GetSelectionFromPopupMenuByIndex(NewTuiStyleEntry(), selectionEntry, 0, 0), "Scrolling a popup menu did not select the correct item!")
+	assert.Panicsf(test, func() { GetSelectionFromPopupMenu(NewTuiStyleEntry(), memory.NewSelectionEntry(), 0, 0) }, "Opening a popup menu without items did not panic!")
+}
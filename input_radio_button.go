@@ -0,0 +1,203 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+)
+
+/*
+AddRadioButtonGroup allows you to add a group of radio buttons to a text
+layer. Each item of the selection entry provided is drawn on its own row,
+and exactly one item of the group is selected at a time. In addition, the
+following information should be noted:
+
+- The first item is selected when the radio button group is added.
+
+- Radio button groups are not drawn physically to the text layer provided.
+Instead they are rendered to the terminal at the same time when the text
+layer is rendered, in the same way as buttons.
+
+- A radio button group can receive focus as a single control. While
+focused, the label of its selected item is underlined, and the keys 'up',
+'down', 'left', 'right', 'home', and 'end' change which item is selected.
+Clicking on an item or its label also selects it. Every time the user
+changes the selection, a control event with the action
+'ControlActionChange' is returned. The selection can then be obtained with
+'GetRadioButtonGroupSelection'.
+
+- If the selection entry provided has no items, a panic will be generated
+to fail as fast as possible.
+*/
+func AddRadioButtonGroup(layerAlias string, radioButtonGroupAlias string, styleEntry memory.TuiStyleEntryType, selectionEntry memory.SelectionEntryType, xLocation int, yLocation int) {
+	if len(selectionEntry.SelectionAlias) == 0 {
+		panic(fmt.Sprintf("The radio button group '%s' must have at least one item!", radioButtonGroupAlias))
+	}
+	memory.AddRadioButtonGroup(layerAlias, radioButtonGroupAlias, styleEntry, selectionEntry, xLocation, yLocation)
+	addControl(layerAlias, radioButtonGroupAlias, constants.ControlTypeRadioButtonGroup)
+	markControlAsDirty(layerAlias, radioButtonGroupAlias)
+}
+
+/*
+DeleteRadioButtonGroup allows you to remove a radio button group from a
+text layer. In addition, the following information should be noted:
+
+- If you attempt to delete a radio button group which does not exist, then
+the request will simply be ignored.
+*/
+func DeleteRadioButtonGroup(layerAlias string, radioButtonGroupAlias string) {
+	if !memory.IsRadioButtonGroupExists(layerAlias, radioButtonGroupAlias) {
+		return
+	}
+	markControlAsDirty(layerAlias, radioButtonGroupAlias)
+	deleteControl(layerAlias, radioButtonGroupAlias)
+	memory.DeleteRadioButtonGroup(layerAlias, radioButtonGroupAlias)
+}
+
+/*
+GetRadioButtonGroupSelection allows you to obtain the selection alias of the
+item currently selected in a radio button group. If the radio button group
+does not exist, then a panic will be generated to fail as fast as possible.
+*/
+func GetRadioButtonGroupSelection(layerAlias string, radioButtonGroupAlias string) string {
+	radioButtonGroupEntry := memory.GetRadioButtonGroup(layerAlias, radioButtonGroupAlias)
+	return radioButtonGroupEntry.SelectionEntry.SelectionAlias[radioButtonGroupEntry.ItemSelected]
+}
+
+/*
+SetRadioButtonGroupSelection allows you to change which item is selected in
+a radio button group. No control event is returned, since the change was
+not made by the user. If the radio button group does not exist, or the
+selection alias provided does not exist in the group, a panic will be
+generated to fail as fast as possible.
+*/
+func SetRadioButtonGroupSelection(layerAlias string, radioButtonGroupAlias string, selectionAlias string) {
+	radioButtonGroupEntry := memory.GetRadioButtonGroup(layerAlias, radioButtonGroupAlias)
+	for currentIndex, currentSelectionAlias := range radioButtonGroupEntry.SelectionEntry.SelectionAlias {
+		if currentSelectionAlias == selectionAlias {
+			radioButtonGroupEntry.ItemSelected = currentIndex
+			markControlAsDirty(layerAlias, radioButtonGroupAlias)
+			return
+		}
+	}
+	panic(fmt.Sprintf("The selection alias '%s' does not exist in the radio button group '%s'!", selectionAlias, radioButtonGroupAlias))
+}
+
+/*
+selectRadioButton allows you to select an item of a radio button group. If
+the item specified is out of range, then the closest valid item is selected
+instead. Returns 'true' if the selection has changed.
+*/
+func selectRadioButton(radioButtonGroupEntry *memory.RadioButtonGroupEntryType, itemSelected int) bool {
+	if itemSelected >= len(radioButtonGroupEntry.SelectionEntry.SelectionAlias) {
+		itemSelected = len(radioButtonGroupEntry.SelectionEntry.SelectionAlias) - 1
+	}
+	if itemSelected < 0 {
+		itemSelected = 0
+	}
+	isSelectionChanged := itemSelected != radioButtonGroupEntry.ItemSelected
+	radioButtonGroupEntry.ItemSelected = itemSelected
+	return isSelectionChanged
+}
+
+/*
+getRadioButtonGroupKeyEvents allows you to update a radio button group with
+a key event. The events which should be reported in its place are returned.
+If the key is not used by the radio button group, then the key event itself
+is returned.
+*/
+func getRadioButtonGroupKeyEvents(controlEntry *memory.ControlEntryType, eventEntry memory.EventEntryType) []memory.EventEntryType {
+	radioButtonGroupEntry := memory.GetRadioButtonGroup(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	itemSelected := radioButtonGroupEntry.ItemSelected
+	isSelectionChanged := false
+	switch eventEntry.KeyEvent.Chord {
+	case "up", "left":
+		isSelectionChanged = selectRadioButton(radioButtonGroupEntry, itemSelected-1)
+	case "down", "right":
+		isSelectionChanged = selectRadioButton(radioButtonGroupEntry, itemSelected+1)
+	case "home":
+		isSelectionChanged = selectRadioButton(radioButtonGroupEntry, 0)
+	case "end":
+		isSelectionChanged = selectRadioButton(radioButtonGroupEntry, len(radioButtonGroupEntry.SelectionEntry.SelectionAlias)-1)
+	default:
+		return []memory.EventEntryType{eventEntry}
+	}
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if isSelectionChanged {
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionChange)}
+	}
+	return nil
+}
+
+/*
+getRadioButtonGroupMouseEvents allows you to update a radio button group
+with a mouse release at the specified terminal location. The item selected
+is identified by the cell ID of the text cell under the mouse, which is
+only used if the cell was drawn by the radio button group. The events which
+should be reported are returned.
+*/
+func getRadioButtonGroupMouseEvents(controlEntry *memory.ControlEntryType, xLocation int, yLocation int) []memory.EventEntryType {
+	layerAlias, cellAlias := getButtonClickIdentifier(xLocation, yLocation)
+	itemIndex := getScreenCellId(xLocation, yLocation)
+	if layerAlias != controlEntry.LayerAlias || cellAlias != controlEntry.ControlAlias || itemIndex == constants.NullCellId {
+		return nil
+	}
+	radioButtonGroupEntry := memory.GetRadioButtonGroup(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if !selectRadioButton(radioButtonGroupEntry, itemIndex) {
+		return nil
+	}
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionChange)}
+}
+
+/*
+getRadioButtonGroupRegion allows you to obtain the area of a text layer
+covered by a radio button group, including the labels of all its items.
+*/
+func getRadioButtonGroupRegion(radioButtonGroupEntry *memory.RadioButtonGroupEntryType) memory.RegionEntryType {
+	width := 0
+	for _, currentSelectionValue := range radioButtonGroupEntry.SelectionEntry.SelectionValue {
+		labelWidth := getGraphemeClustersWidth(stringformat.GetGraphemeClusters([]rune(currentSelectionValue)))
+		if labelWidth > width {
+			width = labelWidth
+		}
+	}
+	return memory.NewRegionEntry(radioButtonGroupEntry.XLocation, radioButtonGroupEntry.YLocation, width+4, len(radioButtonGroupEntry.SelectionEntry.SelectionValue))
+}
+
+/*
+drawRadioButtonGroupsOnLayer allows you to draw all radio button groups on
+a given text layer entry.
+*/
+func drawRadioButtonGroupsOnLayer(layerEntry memory.LayerEntryType) {
+	for _, currentRadioButtonGroupEntry := range memory.RadioButtonGroupMemory[layerEntry.LayerAlias] {
+		drawRadioButtonGroup(&layerEntry, currentRadioButtonGroupEntry)
+	}
+}
+
+/*
+drawRadioButtonGroup allows you to draw a radio button group on a given
+text layer. Every text cell drawn is tagged with the alias of the radio
+button group, and a cell ID matching the index of its item, so that mouse
+clicks can be matched to the item clicked.
+*/
+func drawRadioButtonGroup(layerEntry *memory.LayerEntryType, radioButtonGroupEntry *memory.RadioButtonGroupEntryType) {
+	styleEntry := radioButtonGroupEntry.StyleEntry
+	for currentIndex, currentSelectionValue := range radioButtonGroupEntry.SelectionEntry.SelectionValue {
+		attributeEntry := memory.NewAttributeEntry()
+		attributeEntry.ForegroundColor = styleEntry.TextForegroundColor
+		attributeEntry.BackgroundColor = styleEntry.TextBackgroundColor
+		attributeEntry.CellType = constants.CellTypeRadioButton
+		attributeEntry.CellAlias = radioButtonGroupEntry.RadioButtonGroupAlias
+		attributeEntry.CellId = currentIndex
+		indicator := []rune("( ) ")
+		if currentIndex == radioButtonGroupEntry.ItemSelected {
+			indicator[1] = constants.CharDot
+		}
+		yLocation := radioButtonGroupEntry.YLocation + currentIndex
+		printLayer(layerEntry, attributeEntry, radioButtonGroupEntry.XLocation, yLocation, indicator)
+		attributeEntry.IsUnderlined = radioButtonGroupEntry.IsFocused && currentIndex == radioButtonGroupEntry.ItemSelected
+		printLayer(layerEntry, attributeEntry, radioButtonGroupEntry.XLocation+len(indicator), yLocation, []rune(currentSelectionValue))
+	}
+}
@@ -0,0 +1,69 @@
+package dosktop
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestRadioButtonGroup(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 6)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Form", 0, 0, 20, 6, 1, "")
+	selectionEntry := memory.NewSelectionEntry()
+	selectionEntry.Add("small", "Small")
+	selectionEntry.Add("medium", "Medium")
+	selectionEntry.Add("large", "Large")
+	AddRadioButtonGroup("Form", "Size", NewTuiStyleEntry(), selectionEntry, 1, 1)
+	injectKey := func(key tcell.Key, character rune, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventKey(key, character, modifierMask))
+	}
+	injectMouse := func(xLocation int, yLocation int, buttonMask tcell.ButtonMask, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventMouse(xLocation, yLocation, buttonMask, modifierMask))
+	}
+	getControlActions := func(numberOfActions int) []int {
+		var controlActions []int
+		for len(controlActions) < numberOfActions {
+			if eventEntry := WaitForEvent(); eventEntry.EventType == constants.EventTypeControl {
+				controlActions = append(controlActions, eventEntry.ControlEvent.Action)
+			}
+		}
+		return controlActions
+	}
+	getScreenRows := func() []string {
+		UpdateDisplay()
+		cells, width, _ := simulationScreen.GetContents()
+		var screenRows []string
+		for currentRow := 1; currentRow <= 3; currentRow++ {
+			screenRow := ""
+			for currentColumn := 1; currentColumn < 11; currentColumn++ {
+				screenRow += string(cells[currentRow*width+currentColumn].Runes)
+			}
+			screenRows = append(screenRows, screenRow)
+		}
+		return screenRows
+	}
+	assert.Equalf(test, []string{"(•) Small ", "( ) Medium", "( ) Large "}, getScreenRows(), "The radio button group was not drawn correctly!")
+	assert.Equalf(test, "small", GetRadioButtonGroupSelection("Form", "Size"), "The first item was not selected!")
+	SetFocus("Form", "Size")
+	injectKey(tcell.KeyUp, 0, tcell.ModNone)
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnd, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	injectKey(tcell.KeyLeft, 0, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange, constants.ControlActionChange, constants.ControlActionChange}, getControlActions(3), "Moving past the first item should not change the selection!")
+	assert.Equalf(test, "medium", GetRadioButtonGroupSelection("Form", "Size"), "The keyboard did not change the selection!")
+	assert.Equalf(test, []string{"( ) Small ", "(•) Medium", "( ) Large "}, getScreenRows(), "The selected item was not drawn correctly!")
+	injectMouse(8, 3, tcell.Button1, tcell.ModNone)
+	injectMouse(8, 3, tcell.ButtonNone, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange}, getControlActions(1), "Clicking an item did not change the selection!")
+	assert.Equalf(test, "large", GetRadioButtonGroupSelection("Form", "Size"), "Clicking the label of an item did not select it!")
+	SetRadioButtonGroupSelection("Form", "Size", "small")
+	assert.Equalf(test, "small", GetRadioButtonGroupSelection("Form", "Size"), "The selection could not be set!")
+	assert.Panicsf(test, func() { SetRadioButtonGroupSelection("Form", "Size", "huge") }, "Selecting an item which does not exist did not panic!")
+	DeleteRadioButtonGroup("Form", "Size")
+	assert.Falsef(test, memory.IsControlExists("Form", "Size"), "The radio button group was not deleted!")
+}
@@ -0,0 +1,447 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+)
+
+/*
+tabHeaderType is used to hold the location of a single tab header drawn on
+the header row of a tab container. The tab index refers to the position of
+the tab in the tab container, and the width may be smaller than the full
+header if it was clipped by the edge of the tab container.
+*/
+type tabHeaderType struct {
+	tabIndex  int
+	xLocation int
+	width     int
+}
+
+/*
+AddTabContainer allows you to add a tab strip to a text layer, where each
+tab owns a child text layer that is only visible while its tab is selected.
+For example:
+
+	// Create a tab container with two pages and print on the first one.
+	dosktop.AddTabContainer("MyLayer", "Settings", styleEntry, 0, 0, 40, 12)
+	dosktop.AddTab("MyLayer", "Settings", "General", "General")
+	dosktop.AddTab("MyLayer", "Settings", "Advanced", "Advanced")
+	dosktop.LocateLayer("General", 1, 1)
+	dosktop.PrintLayer("General", "Hello World")
+
+In addition, the following information should be noted:
+
+- The first row of the tab container holds the tab headers, and the
+remaining rows are surrounded by a border. The text layer of each tab fills
+the area inside the border, so it is two columns narrower and three rows
+shorter than the tab container.
+
+- Tab containers can receive focus. While focused, the header of the
+selected tab is underlined, and the keys 'left', 'right', 'home', and 'end'
+change which tab is selected. The keys 'ctrl+pgup' and 'ctrl+pgdn' also
+change the selected tab while focus is on any control inside one of its
+tabs. Pressing the mouse on a tab header selects that tab.
+
+- Every time the user selects a different tab, a control event with the
+action 'ControlActionChange' is returned. The selected tab can then be
+obtained with 'GetSelectedTab'. If a control on the tab being hidden had
+focus, focus is moved to the tab container itself.
+
+- If the tab headers do not fit in the width of the tab container, arrows
+are drawn at both ends of the header row. Pressing the mouse on an arrow,
+or moving the mouse wheel over the headers, scrolls them. The header of the
+selected tab is always scrolled into view.
+
+- Tab containers are not drawn physically to the text layer provided.
+Instead they are rendered to the terminal at the same time when the text
+layer is rendered.
+
+- If the tab container is less than 3 characters wide or 4 characters high,
+a panic will be generated to fail as fast as possible.
+*/
+func AddTabContainer(layerAlias string, tabContainerAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, height int) {
+	if width < 3 || height < 4 {
+		panic(fmt.Sprintf("The specified tab container size of '%dx%d' is invalid!", width, height))
+	}
+	memory.AddTabContainer(layerAlias, tabContainerAlias, styleEntry, xLocation, yLocation, width, height)
+	addControl(layerAlias, tabContainerAlias, constants.ControlTypeTabContainer)
+	markControlAsDirty(layerAlias, tabContainerAlias)
+}
+
+/*
+DeleteTabContainer allows you to remove a tab container from a text layer,
+along with the text layers owned by all of its tabs. In addition, the
+following information should be noted:
+
+- If you attempt to delete a tab container which does not exist, then the
+request will simply be ignored.
+*/
+func DeleteTabContainer(layerAlias string, tabContainerAlias string) {
+	if !memory.IsTabContainerExists(layerAlias, tabContainerAlias) {
+		return
+	}
+	for _, currentTabAlias := range memory.GetTabContainer(layerAlias, tabContainerAlias).Tabs.SelectionAlias {
+		DeleteLayer(currentTabAlias)
+	}
+	markControlAsDirty(layerAlias, tabContainerAlias)
+	deleteControl(layerAlias, tabContainerAlias)
+	memory.DeleteTabContainer(layerAlias, tabContainerAlias)
+}
+
+/*
+AddTab allows you to add a tab to the end of a tab container. The tab owns
+a new child text layer which uses the tab alias as its layer alias, so that
+you can print on it or add controls to it like any other text layer. In
+addition, the following information should be noted:
+
+- The first tab added becomes the selected tab. The text layers of all
+other tabs are hidden until their tab is selected.
+
+- Unlike 'AddLayer', adding a tab does not change your default text layer.
+
+- If the tab container does not exist, or a text layer with the same alias
+as the tab already exists, a panic will be generated to fail as fast as
+possible.
+*/
+func AddTab(layerAlias string, tabContainerAlias string, tabAlias string, label string) {
+	tabContainerEntry := memory.GetTabContainer(layerAlias, tabContainerAlias)
+	if memory.IsLayerExists(tabAlias) {
+		panic(fmt.Sprintf("The tab '%s' could not be added since a text layer with the same alias already exists!", tabAlias))
+	}
+	memory.AddLayer(tabAlias, tabContainerEntry.XLocation+1, tabContainerEntry.YLocation+2, tabContainerEntry.Width-2, tabContainerEntry.Height-3, 0, layerAlias)
+	tabLayerEntry := memory.GetLayer(tabAlias)
+	tabLayerEntry.IsVisible = len(tabContainerEntry.Tabs.SelectionAlias) == 0
+	tabContainerEntry.Tabs.Add(tabAlias, label)
+	markLayerAsDirty(tabLayerEntry)
+	markControlAsDirty(layerAlias, tabContainerAlias)
+}
+
+/*
+DeleteTab allows you to remove a tab from a tab container, along with the
+text layer it owns. In addition, the following information should be
+noted:
+
+- If the tab removed was selected, the tab which took its place becomes
+selected instead.
+
+- If you attempt to delete a tab which does not exist, then the request
+will simply be ignored. If the tab container does not exist, a panic will
+be generated to fail as fast as possible.
+*/
+func DeleteTab(layerAlias string, tabContainerAlias string, tabAlias string) {
+	tabContainerEntry := memory.GetTabContainer(layerAlias, tabContainerAlias)
+	tabIndex := getTabIndex(tabContainerEntry, tabAlias)
+	if tabIndex == constants.NullSelectionIndex {
+		return
+	}
+	markControlAsDirty(layerAlias, tabContainerAlias)
+	DeleteLayer(tabAlias)
+	tabContainerEntry.Tabs.SelectionAlias = append(tabContainerEntry.Tabs.SelectionAlias[:tabIndex], tabContainerEntry.Tabs.SelectionAlias[tabIndex+1:]...)
+	tabContainerEntry.Tabs.SelectionValue = append(tabContainerEntry.Tabs.SelectionValue[:tabIndex], tabContainerEntry.Tabs.SelectionValue[tabIndex+1:]...)
+	isSelectedTabDeleted := tabIndex == tabContainerEntry.TabSelected
+	if tabIndex < tabContainerEntry.TabSelected || tabContainerEntry.TabSelected >= len(tabContainerEntry.Tabs.SelectionAlias) {
+		tabContainerEntry.TabSelected--
+	}
+	if tabContainerEntry.TabSelected < 0 {
+		tabContainerEntry.TabSelected = 0
+	}
+	if isSelectedTabDeleted && len(tabContainerEntry.Tabs.SelectionAlias) > 0 {
+		setTabLayerVisible(tabContainerEntry.Tabs.SelectionAlias[tabContainerEntry.TabSelected], true)
+	}
+	scrollTabIntoView(tabContainerEntry)
+}
+
+/*
+GetSelectedTab allows you to obtain the alias of the tab currently selected
+in a tab container. If the tab container has no tabs, then an empty string
+is returned instead. If the tab container does not exist, then a panic will
+be generated to fail as fast as possible.
+*/
+func GetSelectedTab(layerAlias string, tabContainerAlias string) string {
+	tabContainerEntry := memory.GetTabContainer(layerAlias, tabContainerAlias)
+	if len(tabContainerEntry.Tabs.SelectionAlias) == 0 {
+		return ""
+	}
+	return tabContainerEntry.Tabs.SelectionAlias[tabContainerEntry.TabSelected]
+}
+
+/*
+SelectTab allows you to change which tab of a tab container is selected,
+showing the text layer of the tab specified and hiding all others. No
+control event is returned, since the change was not made by the user. If
+the tab container or tab does not exist, a panic will be generated to fail
+as fast as possible.
+*/
+func SelectTab(layerAlias string, tabContainerAlias string, tabAlias string) {
+	tabContainerEntry := memory.GetTabContainer(layerAlias, tabContainerAlias)
+	tabIndex := getTabIndex(tabContainerEntry, tabAlias)
+	if tabIndex == constants.NullSelectionIndex {
+		panic(fmt.Sprintf("The tab '%s' does not exist in the tab container '%s'!", tabAlias, tabContainerAlias))
+	}
+	getTabContainerChangeEvents(memory.GetControl(layerAlias, tabContainerAlias), tabIndex)
+}
+
+/*
+getTabIndex allows you to find the position of a tab in a tab container. If
+the tab does not exist, then 'constants.NullSelectionIndex' is returned
+instead.
+*/
+func getTabIndex(tabContainerEntry *memory.TabContainerEntryType, tabAlias string) int {
+	for currentIndex, currentTabAlias := range tabContainerEntry.Tabs.SelectionAlias {
+		if currentTabAlias == tabAlias {
+			return currentIndex
+		}
+	}
+	return constants.NullSelectionIndex
+}
+
+/*
+setTabLayerVisible allows you to show or hide the text layer owned by a
+tab. The area it covers is marked as dirty so that it is redrawn. If the
+text layer does not exist, then the request will simply be ignored.
+*/
+func setTabLayerVisible(tabAlias string, isVisible bool) {
+	if !memory.IsLayerExists(tabAlias) {
+		return
+	}
+	layerEntry := memory.GetLayer(tabAlias)
+	layerEntry.IsVisible = isVisible
+	markLayerAsDirty(layerEntry)
+}
+
+/*
+getTabContainerChangeEvents allows you to select a tab of a tab container
+for the user. If the tab specified is out of range, then the closest valid
+tab is selected instead. The events which should be reported are returned.
+In addition, the following information should be noted:
+
+- If the selected tab did not change, then no events are returned.
+
+- If the control which has focus is no longer visible once the tab is
+changed, focus is moved to the tab container.
+*/
+func getTabContainerChangeEvents(controlEntry *memory.ControlEntryType, tabIndex int) []memory.EventEntryType {
+	tabContainerEntry := memory.GetTabContainer(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if tabIndex >= len(tabContainerEntry.Tabs.SelectionAlias) {
+		tabIndex = len(tabContainerEntry.Tabs.SelectionAlias) - 1
+	}
+	if tabIndex < 0 {
+		tabIndex = 0
+	}
+	if tabIndex == tabContainerEntry.TabSelected || len(tabContainerEntry.Tabs.SelectionAlias) == 0 {
+		return nil
+	}
+	setTabLayerVisible(tabContainerEntry.Tabs.SelectionAlias[tabContainerEntry.TabSelected], false)
+	setTabLayerVisible(tabContainerEntry.Tabs.SelectionAlias[tabIndex], true)
+	tabContainerEntry.TabSelected = tabIndex
+	scrollTabIntoView(tabContainerEntry)
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	var tabEvents []memory.EventEntryType
+	if focusedLayerAlias, _ := GetFocus(); focusedLayerAlias != "" && !isLayerVisible(focusedLayerAlias) {
+		tabEvents = append(tabEvents, changeFocus(controlEntry)...)
+	}
+	return append(tabEvents, newControlEventEntry(controlEntry, constants.ControlActionChange))
+}
+
+/*
+getTabContainerForLayer allows you to find the tab container which owns a
+text layer, either directly or through one of its parents. If the text
+layer does not belong to a tab, then 'nil' is returned instead.
+*/
+func getTabContainerForLayer(layerAlias string) *memory.ControlEntryType {
+	for layerAlias != "" && memory.IsLayerExists(layerAlias) {
+		parentAlias := memory.GetLayer(layerAlias).ParentAlias
+		for _, currentTabContainerEntry := range memory.TabContainerMemory[parentAlias] {
+			if getTabIndex(currentTabContainerEntry, layerAlias) != constants.NullSelectionIndex && memory.IsControlExists(parentAlias, currentTabContainerEntry.TabContainerAlias) {
+				return memory.GetControl(parentAlias, currentTabContainerEntry.TabContainerAlias)
+			}
+		}
+		layerAlias = parentAlias
+	}
+	return nil
+}
+
+/*
+getTabContainerKeyEvents allows you to update a tab container with a key
+event. The events which should be reported in its place are returned. If
+the key is not used by the tab container, then the key event itself is
+returned.
+*/
+func getTabContainerKeyEvents(controlEntry *memory.ControlEntryType, eventEntry memory.EventEntryType) []memory.EventEntryType {
+	tabContainerEntry := memory.GetTabContainer(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	tabSelected := tabContainerEntry.TabSelected
+	switch eventEntry.KeyEvent.Chord {
+	case "left", "ctrl+pgup":
+		return getTabContainerChangeEvents(controlEntry, tabSelected-1)
+	case "right", "ctrl+pgdn":
+		return getTabContainerChangeEvents(controlEntry, tabSelected+1)
+	case "home":
+		return getTabContainerChangeEvents(controlEntry, 0)
+	case "end":
+		return getTabContainerChangeEvents(controlEntry, len(tabContainerEntry.Tabs.SelectionAlias)-1)
+	}
+	return []memory.EventEntryType{eventEntry}
+}
+
+/*
+getTabContainerMouseEvents allows you to update a tab container with a
+mouse press at the specified location of its text layer. Pressing a tab
+header selects that tab, and pressing an overflow arrow scrolls the
+headers. The events which should be reported are returned.
+*/
+func getTabContainerMouseEvents(controlEntry *memory.ControlEntryType, xLocation int, yLocation int) []memory.EventEntryType {
+	tabContainerEntry := memory.GetTabContainer(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if yLocation != tabContainerEntry.YLocation {
+		return nil
+	}
+	tabHeaders, isOverflow := getTabHeaders(tabContainerEntry)
+	if isOverflow && xLocation == tabContainerEntry.XLocation {
+		scrollTabContainer(controlEntry, -1)
+		return nil
+	}
+	if isOverflow && xLocation == tabContainerEntry.XLocation+tabContainerEntry.Width-1 {
+		scrollTabContainer(controlEntry, 1)
+		return nil
+	}
+	for _, currentTabHeader := range tabHeaders {
+		if xLocation >= currentTabHeader.xLocation && xLocation < currentTabHeader.xLocation+currentTabHeader.width {
+			return getTabContainerChangeEvents(controlEntry, currentTabHeader.tabIndex)
+		}
+	}
+	return nil
+}
+
+/*
+scrollTabContainer allows you to scroll the tab headers of a tab container
+without changing the tab selected. The headers will not scroll past the
+first or last tab.
+*/
+func scrollTabContainer(controlEntry *memory.ControlEntryType, numberOfTabs int) {
+	tabContainerEntry := memory.GetTabContainer(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if _, isOverflow := getTabHeaders(tabContainerEntry); !isOverflow {
+		return
+	}
+	viewportPosition := tabContainerEntry.ViewportPosition + numberOfTabs
+	if viewportPosition > len(tabContainerEntry.Tabs.SelectionAlias)-1 {
+		viewportPosition = len(tabContainerEntry.Tabs.SelectionAlias) - 1
+	}
+	if viewportPosition < 0 {
+		viewportPosition = 0
+	}
+	tabContainerEntry.ViewportPosition = viewportPosition
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+}
+
+/*
+scrollTabIntoView allows you to scroll the tab headers of a tab container
+so that the header of the selected tab is fully visible. If all headers
+fit, then the headers are scrolled back to the first tab.
+*/
+func scrollTabIntoView(tabContainerEntry *memory.TabContainerEntryType) {
+	if _, isOverflow := getTabHeaders(tabContainerEntry); !isOverflow {
+		tabContainerEntry.ViewportPosition = 0
+		return
+	}
+	if tabContainerEntry.TabSelected < tabContainerEntry.ViewportPosition {
+		tabContainerEntry.ViewportPosition = tabContainerEntry.TabSelected
+	}
+	for tabContainerEntry.ViewportPosition < tabContainerEntry.TabSelected {
+		tabHeaders, _ := getTabHeaders(tabContainerEntry)
+		lastTabHeader := tabHeaders[len(tabHeaders)-1]
+		if lastTabHeader.tabIndex > tabContainerEntry.TabSelected || (lastTabHeader.tabIndex == tabContainerEntry.TabSelected && lastTabHeader.width == getTabHeaderWidth(tabContainerEntry, lastTabHeader.tabIndex)) {
+			break
+		}
+		tabContainerEntry.ViewportPosition++
+	}
+}
+
+/*
+getTabHeaderWidth allows you to obtain the full width of a tab header,
+which is its label padded with a space on each side.
+*/
+func getTabHeaderWidth(tabContainerEntry *memory.TabContainerEntryType, tabIndex int) int {
+	return getGraphemeClustersWidth(stringformat.GetGraphemeClusters([]rune(tabContainerEntry.Tabs.SelectionValue[tabIndex]))) + 2
+}
+
+/*
+getTabHeaders allows you to obtain the location of every tab header which
+is currently visible on the header row of a tab container. In addition,
+the following information should be noted:
+
+- Tab headers are separated by a single column. When they do not all fit,
+the first and last columns of the header row are reserved for the overflow
+arrows, and the headers are drawn starting from the viewport position.
+
+- The returned flag indicates if the tab headers do not all fit.
+*/
+func getTabHeaders(tabContainerEntry *memory.TabContainerEntryType) ([]tabHeaderType, bool) {
+	totalWidth := 0
+	for currentIndex := range tabContainerEntry.Tabs.SelectionValue {
+		totalWidth += getTabHeaderWidth(tabContainerEntry, currentIndex) + 1
+	}
+	isOverflow := totalWidth-1 > tabContainerEntry.Width
+	firstTabIndex := 0
+	xLocation := tabContainerEntry.XLocation
+	lastXLocation := tabContainerEntry.XLocation + tabContainerEntry.Width
+	if isOverflow {
+		firstTabIndex = tabContainerEntry.ViewportPosition
+		xLocation++
+		lastXLocation--
+	}
+	var tabHeaders []tabHeaderType
+	for currentIndex := firstTabIndex; currentIndex < len(tabContainerEntry.Tabs.SelectionValue) && xLocation < lastXLocation; currentIndex++ {
+		width := getTabHeaderWidth(tabContainerEntry, currentIndex)
+		if xLocation+width > lastXLocation {
+			width = lastXLocation - xLocation
+		}
+		tabHeaders = append(tabHeaders, tabHeaderType{tabIndex: currentIndex, xLocation: xLocation, width: width})
+		xLocation += width + 1
+	}
+	return tabHeaders, isOverflow
+}
+
+/*
+drawTabContainersOnLayer allows you to draw all tab containers on a given
+text layer entry.
+*/
+func drawTabContainersOnLayer(layerEntry memory.LayerEntryType) {
+	for _, currentTabContainerEntry := range memory.TabContainerMemory[layerEntry.LayerAlias] {
+		drawTabContainer(&layerEntry, currentTabContainerEntry)
+	}
+}
+
+/*
+drawTabContainer allows you to draw the header row and border of a tab
+container on a given text layer. The header of the selected tab is drawn
+using the highlight colors of its style. The text layers owned by its tabs
+are rendered on top afterwards, since they are children of the text layer.
+*/
+func drawTabContainer(layerEntry *memory.LayerEntryType, tabContainerEntry *memory.TabContainerEntryType) {
+	styleEntry := tabContainerEntry.StyleEntry
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.TextForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.TextBackgroundColor
+	fillArea(layerEntry, attributeEntry, " ", tabContainerEntry.XLocation, tabContainerEntry.YLocation, tabContainerEntry.Width, 1)
+	drawBorder(layerEntry, styleEntry, attributeEntry, tabContainerEntry.XLocation, tabContainerEntry.YLocation+1, tabContainerEntry.Width, tabContainerEntry.Height-1)
+	tabHeaders, isOverflow := getTabHeaders(tabContainerEntry)
+	if isOverflow {
+		printLayer(layerEntry, attributeEntry, tabContainerEntry.XLocation, tabContainerEntry.YLocation, []rune{constants.CharArrowLeft})
+		printLayer(layerEntry, attributeEntry, tabContainerEntry.XLocation+tabContainerEntry.Width-1, tabContainerEntry.YLocation, []rune{constants.CharArrowRight})
+	}
+	for _, currentTabHeader := range tabHeaders {
+		headerAttributeEntry := memory.NewAttributeEntry(&attributeEntry)
+		if currentTabHeader.tabIndex == tabContainerEntry.TabSelected {
+			headerAttributeEntry.ForegroundColor = styleEntry.HighlightForegroundColor
+			headerAttributeEntry.BackgroundColor = styleEntry.HighlightBackgroundColor
+			headerAttributeEntry.IsUnderlined = tabContainerEntry.IsFocused
+		}
+		headerText := getTruncatedString(" "+tabContainerEntry.Tabs.SelectionValue[currentTabHeader.tabIndex]+" ", currentTabHeader.width)
+		printLayer(layerEntry, headerAttributeEntry, currentTabHeader.xLocation, tabContainerEntry.YLocation, []rune(headerText))
+		separatorXLocation := currentTabHeader.xLocation + currentTabHeader.width
+		if separatorXLocation < tabContainerEntry.XLocation+tabContainerEntry.Width-1 {
+			printLayer(layerEntry, attributeEntry, separatorXLocation, tabContainerEntry.YLocation, []rune{constants.CharSingleLineVertical})
+		}
+	}
+}
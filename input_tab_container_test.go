@@ -0,0 +1,73 @@
+package dosktop
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestTabContainer(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(30, 8)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Form", 0, 0, 30, 8, 1, "")
+	AddTabContainer("Form", "Tabs", NewTuiStyleEntry(), 0, 0, 20, 6)
+	AddTab("Form", "Tabs", "General", "General")
+	AddTab("Form", "Tabs", "Advanced", "Advanced")
+	AddCheckBox("General", "Bold", "Bold", NewTuiStyleEntry(), 0, 0, false)
+	injectKey := func(key tcell.Key, character rune, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventKey(key, character, modifierMask))
+	}
+	injectMouse := func(xLocation int, yLocation int, buttonMask tcell.ButtonMask, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventMouse(xLocation, yLocation, buttonMask, modifierMask))
+	}
+	getControlActions := func(numberOfActions int) []int {
+		var controlActions []int
+		for len(controlActions) < numberOfActions {
+			if eventEntry := WaitForEvent(); eventEntry.EventType == constants.EventTypeControl {
+				controlActions = append(controlActions, eventEntry.ControlEvent.Action)
+			}
+		}
+		return controlActions
+	}
+	getScreenText := func(xLocation int, yLocation int, length int) string {
+		UpdateDisplay()
+		cells, width, _ := simulationScreen.GetContents()
+		obtainedValue := ""
+		for currentXLocation := xLocation; currentXLocation < xLocation+length; currentXLocation++ {
+			obtainedValue += string(cells[yLocation*width+currentXLocation].Runes)
+		}
+		return obtainedValue
+	}
+	assert.Equalf(test, []string{" General │ Advanced ", "│[ ] Bold"}, []string{getScreenText(0, 0, 20), getScreenText(0, 2, 9)}, "The tab container was not drawn correctly!")
+	assert.Equalf(test, "Form", commonResource.layerAlias, "Adding a tab changed the default text layer!")
+	SetFocus("General", "Bold")
+	injectKey(tcell.KeyPgDn, 0, tcell.ModCtrl)
+	assert.Equalf(test, []int{constants.ControlActionChange}, getControlActions(1), "Pressing 'ctrl+pgdn' inside a tab did not change the tab selected!")
+	layerAlias, controlAlias := GetFocus()
+	assert.Equalf(test, []interface{}{"Advanced", false, true, "Form", "Tabs"}, []interface{}{GetSelectedTab("Form", "Tabs"), memory.GetLayer("General").IsVisible, memory.GetLayer("Advanced").IsVisible, layerAlias, controlAlias}, "Changing tabs did not toggle the tab layers or move focus!")
+	injectKey(tcell.KeyLeft, 0, tcell.ModNone)
+	injectKey(tcell.KeyLeft, 0, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange}, getControlActions(1), "Moving past the first tab should not change the selection!")
+	AddTab("Form", "Tabs", "Help", "Help")
+	assert.Equalf(test, "← General │ Advance→", getScreenText(0, 0, 20), "The overflowing tab headers were not drawn correctly!")
+	injectKey(tcell.KeyEnd, 0, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange}, getControlActions(1), "Pressing 'end' did not select the last tab!")
+	assert.Equalf(test, "← Advanced │ Help │→", getScreenText(0, 0, 20), "The selected tab was not scrolled into view!")
+	injectMouse(0, 0, tcell.Button1, tcell.ModNone)
+	injectMouse(0, 0, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(3, 0, tcell.Button1, tcell.ModNone)
+	injectMouse(3, 0, tcell.ButtonNone, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange}, getControlActions(1), "Clicking a tab header did not change the tab selected!")
+	assert.Equalf(test, []interface{}{"General", 0}, []interface{}{GetSelectedTab("Form", "Tabs"), memory.GetTabContainer("Form", "Tabs").ViewportPosition}, "Clicking the overflow arrow did not scroll the tab headers!")
+	DeleteTab("Form", "Tabs", "General")
+	assert.Equalf(test, []interface{}{"Advanced", true, false}, []interface{}{GetSelectedTab("Form", "Tabs"), memory.GetLayer("Advanced").IsVisible, memory.IsLayerExists("General")}, "Deleting the selected tab did not select the next one!")
+	SelectTab("Form", "Tabs", "Help")
+	assert.Equalf(test, []bool{false, true}, []bool{memory.GetLayer("Advanced").IsVisible, memory.GetLayer("Help").IsVisible}, "Selecting a tab did not toggle the tab layers!")
+	assert.Panicsf(test, func() { SelectTab("Form", "Tabs", "Missing") }, "Selecting a tab which does not exist did not panic!")
+	assert.Panicsf(test, func() { AddTab("Form", "Tabs", "Form", "Form") }, "Adding a tab with the alias of an existing text layer did not panic!")
+	DeleteTabContainer("Form", "Tabs")
+	assert.Falsef(test, memory.IsControlExists("Form", "Tabs") || memory.IsLayerExists("Help"), "The tab container or its tab layers were not deleted!")
+}
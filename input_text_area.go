@@ -0,0 +1,668 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+	"strconv"
+	"strings"
+)
+
+/*
+These constants represent limits placed on text areas.
+*/
+const (
+	textAreaUndoLimit = 100
+)
+
+/*
+textAreaRowType is a structure used to describe a single row of text drawn
+in a text area. When word wrap is enabled, a single line of text may be
+drawn over several rows.
+*/
+type textAreaRowType struct {
+	startIndex       int
+	endIndex         int
+	lineNumber       int
+	isFirstRowOfLine bool
+}
+
+/*
+clipboardText is a variable used to hold text which was cut or copied from a
+text area, so that it can be pasted into any other text area of your
+application.
+*/
+var clipboardText string
+
+/*
+AddTextArea allows you to add a multi-line text editor to a text layer. Like
+text fields, text areas do not block your application and are updated as
+events are read with 'PollEvent' or 'WaitForEvent'. In addition, the
+following information should be noted:
+
+- A text area only receives keystrokes while it has focus. Pressing 'enter'
+starts a new line, and the arrow keys, 'home', 'end', 'pgup', and 'pgdn'
+move the cursor. Holding 'shift' while moving the cursor selects text.
+
+- The keys 'ctrl+x', 'ctrl+c', and 'ctrl+v' cut, copy, and paste text using
+a clipboard shared by all text areas of your application. The keys
+'ctrl+z' and 'ctrl+y' undo and redo changes, and 'ctrl+a' selects all text.
+
+- Clicking the mouse on a text area moves the cursor, and dragging it
+selects text. The mouse wheel scrolls the text area.
+
+- Every time the value of the text area changes, a control event with the
+action 'ControlActionChange' is returned. Pressing 'esc' returns a
+'ControlActionCancel' event.
+
+- If word wrap is enabled, lines too long to fit are wrapped at the last
+space which fits. Otherwise, the text area scrolls horizontally to keep the
+cursor visible.
+
+- If line numbers are enabled, they are drawn in the left-most columns of
+the text area using the text colors of your style entry.
+
+- Text areas are not drawn physically to the text layer provided. Instead
+they are rendered to the terminal at the same time when the text layer is
+rendered.
+
+- The max length allowed counts every character, including line breaks. If
+the width, height, or max length of your text area is less than or equal
+to 0, a panic will be generated to fail as fast as possible.
+*/
+func AddTextArea(layerAlias string, textAreaAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, height int, maxLengthAllowed int, isWordWrapEnabled bool, isLineNumbersEnabled bool, defaultValue string) {
+	if width <= 0 || height <= 0 {
+		panic(fmt.Sprintf("The specified text area size of '%dx%d' is invalid!", width, height))
+	}
+	if maxLengthAllowed <= 0 {
+		panic(fmt.Sprintf("The specified maximum input length of '%d' is invalid!", maxLengthAllowed))
+	}
+	memory.AddTextArea(layerAlias, textAreaAlias, styleEntry, xLocation, yLocation, width, height, maxLengthAllowed, isWordWrapEnabled, isLineNumbersEnabled)
+	addControl(layerAlias, textAreaAlias, constants.ControlTypeTextArea)
+	SetTextAreaValue(layerAlias, textAreaAlias, defaultValue)
+}
+
+/*
+DeleteTextArea allows you to remove a text area from a text layer. In
+addition, the following information should be noted:
+
+- If you attempt to delete a text area which does not exist, then the
+request will simply be ignored.
+*/
+func DeleteTextArea(layerAlias string, textAreaAlias string) {
+	if !memory.IsTextAreaExists(layerAlias, textAreaAlias) {
+		return
+	}
+	markControlAsDirty(layerAlias, textAreaAlias)
+	deleteControl(layerAlias, textAreaAlias)
+	memory.DeleteTextArea(layerAlias, textAreaAlias)
+}
+
+/*
+GetTextAreaValue allows you to obtain the text currently entered in a text
+area. Lines are separated by '\n' characters. If the text area does not
+exist, then a panic will be generated to fail as fast as possible.
+*/
+func GetTextAreaValue(layerAlias string, textAreaAlias string) string {
+	return memory.GetTextArea(layerAlias, textAreaAlias).CurrentValue
+}
+
+/*
+SetTextAreaValue allows you to replace the text entered in a text area. In
+addition, the following information should be noted:
+
+- The cursor is moved to the start of the new value, any selection is
+removed, and the undo history is cleared.
+
+- If the value is longer than the max length of the text area, then it will
+be truncated.
+
+- No control event is returned, since the change was not made by the user.
+
+- If the text area does not exist, then a panic will be generated to fail as
+fast as possible.
+*/
+func SetTextAreaValue(layerAlias string, textAreaAlias string, value string) {
+	textAreaEntry := memory.GetTextArea(layerAlias, textAreaAlias)
+	graphemeClusters := stringformat.GetGraphemeClusters([]rune(value))
+	if len(graphemeClusters) > textAreaEntry.MaxLengthAllowed {
+		graphemeClusters = graphemeClusters[:textAreaEntry.MaxLengthAllowed]
+	}
+	textAreaEntry.CurrentValue = getStringFromGraphemeClusters(graphemeClusters)
+	textAreaEntry.CursorPosition = 0
+	textAreaEntry.SelectionAnchor = 0
+	textAreaEntry.ViewportXPosition = 0
+	textAreaEntry.ViewportYPosition = 0
+	textAreaEntry.UndoHistory = nil
+	textAreaEntry.RedoHistory = nil
+	markControlAsDirty(layerAlias, textAreaAlias)
+}
+
+/*
+GetTextAreaSelection allows you to obtain the text currently selected in a
+text area. If no text is selected, then an empty string is returned. If the
+text area does not exist, then a panic will be generated to fail as fast as
+possible.
+*/
+func GetTextAreaSelection(layerAlias string, textAreaAlias string) string {
+	textAreaEntry := memory.GetTextArea(layerAlias, textAreaAlias)
+	graphemeClusters := stringformat.GetGraphemeClusters([]rune(textAreaEntry.CurrentValue))
+	selectionStart, selectionEnd := getTextAreaSelectionRange(textAreaEntry)
+	return getStringFromGraphemeClusters(graphemeClusters[selectionStart:selectionEnd])
+}
+
+/*
+GetClipboardText allows you to obtain the text most recently cut or copied
+from a text area. This clipboard is internal to your application, and is
+not shared with the clipboard of the operating system.
+*/
+func GetClipboardText() string {
+	return clipboardText
+}
+
+/*
+SetClipboardText allows you to replace the text held in the clipboard used
+by text areas. The next time the user pastes, this text will be inserted.
+*/
+func SetClipboardText(text string) {
+	clipboardText = text
+}
+
+/*
+getTextAreaKeyEvents allows you to update a text area with a key event. The
+events which should be reported in its place are returned. If the key is
+not used by the text area, then the key event itself is returned.
+*/
+func getTextAreaKeyEvents(controlEntry *memory.ControlEntryType, eventEntry memory.EventEntryType) []memory.EventEntryType {
+	textAreaEntry := memory.GetTextArea(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	previousValue := textAreaEntry.CurrentValue
+	keyEvent := eventEntry.KeyEvent
+	keystroke := keyEvent.Chord
+	if keyEvent.Key == tcell.KeyRune && keyEvent.ModifierMask&(tcell.ModCtrl|tcell.ModAlt) == 0 {
+		keystroke = string(keyEvent.Character)
+	}
+	if keystroke == "esc" {
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionCancel)}
+	}
+	if !updateTextAreaValue(textAreaEntry, keystroke) {
+		return []memory.EventEntryType{eventEntry}
+	}
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if textAreaEntry.CurrentValue != previousValue {
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionChange)}
+	}
+	return nil
+}
+
+/*
+updateTextAreaValue allows you to edit a text area entry with a keystroke.
+Returns 'true' if the keystroke was used by the text area. In addition, the
+following information should be noted:
+
+- Like text fields, text is edited one grapheme cluster at a time.
+
+- Typing while text is selected replaces the selection.
+
+- Cursor movement keys combined with 'shift' extend the selection instead
+of removing it.
+*/
+func updateTextAreaValue(textAreaEntry *memory.TextAreaEntryType, keystroke string) bool {
+	graphemeClusters := stringformat.GetGraphemeClusters([]rune(textAreaEntry.CurrentValue))
+	rows := getTextAreaRows(textAreaEntry, graphemeClusters)
+	rowIndex := getTextAreaRowIndex(rows, textAreaEntry.CursorPosition)
+	selectionStart, selectionEnd := getTextAreaSelectionRange(textAreaEntry)
+	isSelecting := strings.Contains(keystroke, "shift+")
+	switch strings.Replace(keystroke, "shift+", "", 1) {
+	case "left":
+		moveTextAreaCursor(textAreaEntry, textAreaEntry.CursorPosition-1, isSelecting)
+	case "right":
+		moveTextAreaCursor(textAreaEntry, textAreaEntry.CursorPosition+1, isSelecting)
+	case "up":
+		moveTextAreaCursorByRow(textAreaEntry, graphemeClusters, rows, rowIndex-1, isSelecting)
+	case "down":
+		moveTextAreaCursorByRow(textAreaEntry, graphemeClusters, rows, rowIndex+1, isSelecting)
+	case "pgup":
+		moveTextAreaCursorByRow(textAreaEntry, graphemeClusters, rows, rowIndex-textAreaEntry.Height, isSelecting)
+	case "pgdn":
+		moveTextAreaCursorByRow(textAreaEntry, graphemeClusters, rows, rowIndex+textAreaEntry.Height, isSelecting)
+	case "home":
+		moveTextAreaCursor(textAreaEntry, rows[rowIndex].startIndex, isSelecting)
+	case "end":
+		moveTextAreaCursor(textAreaEntry, getTextAreaRowEndPosition(rows, rowIndex), isSelecting)
+	case "ctrl+home":
+		moveTextAreaCursor(textAreaEntry, 0, isSelecting)
+	case "ctrl+end":
+		moveTextAreaCursor(textAreaEntry, len(graphemeClusters), isSelecting)
+	case "ctrl+a":
+		textAreaEntry.SelectionAnchor = 0
+		textAreaEntry.CursorPosition = len(graphemeClusters)
+	case "ctrl+c":
+		if selectionStart != selectionEnd {
+			clipboardText = getStringFromGraphemeClusters(graphemeClusters[selectionStart:selectionEnd])
+		}
+	case "ctrl+x":
+		if selectionStart != selectionEnd {
+			clipboardText = getStringFromGraphemeClusters(graphemeClusters[selectionStart:selectionEnd])
+			replaceTextAreaSelection(textAreaEntry, "")
+		}
+	case "ctrl+v":
+		replaceTextAreaSelection(textAreaEntry, clipboardText)
+	case "ctrl+z":
+		restoreTextAreaHistory(textAreaEntry, &textAreaEntry.UndoHistory, &textAreaEntry.RedoHistory)
+	case "ctrl+y":
+		restoreTextAreaHistory(textAreaEntry, &textAreaEntry.RedoHistory, &textAreaEntry.UndoHistory)
+	case "enter":
+		replaceTextAreaSelection(textAreaEntry, "\n")
+	case "backspace", "backspace2":
+		if selectionStart == selectionEnd && textAreaEntry.CursorPosition > 0 {
+			textAreaEntry.SelectionAnchor = textAreaEntry.CursorPosition - 1
+		}
+		replaceTextAreaSelection(textAreaEntry, "")
+	case "delete":
+		if selectionStart == selectionEnd && textAreaEntry.CursorPosition < len(graphemeClusters) {
+			textAreaEntry.SelectionAnchor = textAreaEntry.CursorPosition + 1
+		}
+		replaceTextAreaSelection(textAreaEntry, "")
+	default:
+		if !isKeystrokePrintable(keystroke) {
+			return false
+		}
+		replaceTextAreaSelection(textAreaEntry, keystroke)
+	}
+	updateTextAreaViewport(textAreaEntry)
+	return true
+}
+
+/*
+replaceTextAreaSelection allows you to replace the text selected in a text
+area with the text provided, leaving the cursor after the inserted text. If
+no text is selected, the text is inserted at the cursor instead. In
+addition, the following information should be noted:
+
+- If the result would be longer than the max length allowed, then the text
+inserted is truncated to fit.
+
+- If the value of the text area changes, the previous value is recorded so
+that it can be undone.
+*/
+func replaceTextAreaSelection(textAreaEntry *memory.TextAreaEntryType, text string) {
+	graphemeClusters := stringformat.GetGraphemeClusters([]rune(textAreaEntry.CurrentValue))
+	selectionStart, selectionEnd := getTextAreaSelectionRange(textAreaEntry)
+	insertedGraphemeClusters := stringformat.GetGraphemeClusters([]rune(text))
+	numberOfClustersAllowed := textAreaEntry.MaxLengthAllowed - len(graphemeClusters) + selectionEnd - selectionStart
+	if len(insertedGraphemeClusters) > numberOfClustersAllowed {
+		insertedGraphemeClusters = insertedGraphemeClusters[:numberOfClustersAllowed]
+	}
+	leadingText := getStringFromGraphemeClusters(graphemeClusters[:selectionStart]) + getStringFromGraphemeClusters(insertedGraphemeClusters)
+	updatedValue := leadingText + getStringFromGraphemeClusters(graphemeClusters[selectionEnd:])
+	if len(stringformat.GetGraphemeClusters([]rune(updatedValue))) > textAreaEntry.MaxLengthAllowed {
+		return
+	}
+	if updatedValue != textAreaEntry.CurrentValue {
+		addTextAreaHistory(&textAreaEntry.UndoHistory, textAreaEntry.CurrentValue)
+		textAreaEntry.RedoHistory = nil
+	}
+	textAreaEntry.CurrentValue = updatedValue
+	textAreaEntry.CursorPosition = len(stringformat.GetGraphemeClusters([]rune(leadingText)))
+	textAreaEntry.SelectionAnchor = textAreaEntry.CursorPosition
+}
+
+/*
+addTextAreaHistory allows you to record a value in the undo or redo history
+of a text area. If the history is full, the oldest value is discarded.
+*/
+func addTextAreaHistory(history *[]string, value string) {
+	*history = append(*history, value)
+	if len(*history) > textAreaUndoLimit {
+		*history = (*history)[1:]
+	}
+}
+
+/*
+restoreTextAreaHistory allows you to undo or redo a change to a text area.
+The most recent value is taken from the source history and restored, while
+the current value is recorded in the target history. The cursor is placed
+where the restored text differs from the current text. If the source
+history is empty, no operation takes place.
+*/
+func restoreTextAreaHistory(textAreaEntry *memory.TextAreaEntryType, sourceHistory *[]string, targetHistory *[]string) {
+	if len(*sourceHistory) == 0 {
+		return
+	}
+	restoredValue := (*sourceHistory)[len(*sourceHistory)-1]
+	*sourceHistory = (*sourceHistory)[:len(*sourceHistory)-1]
+	addTextAreaHistory(targetHistory, textAreaEntry.CurrentValue)
+	currentGraphemeClusters := stringformat.GetGraphemeClusters([]rune(textAreaEntry.CurrentValue))
+	restoredGraphemeClusters := stringformat.GetGraphemeClusters([]rune(restoredValue))
+	cursorPosition := 0
+	for cursorPosition < len(currentGraphemeClusters) && cursorPosition < len(restoredGraphemeClusters) &&
+		string(currentGraphemeClusters[cursorPosition]) == string(restoredGraphemeClusters[cursorPosition]) {
+		cursorPosition++
+	}
+	if len(restoredGraphemeClusters) > len(currentGraphemeClusters) {
+		cursorPosition += len(restoredGraphemeClusters) - len(currentGraphemeClusters)
+	}
+	textAreaEntry.CurrentValue = restoredValue
+	textAreaEntry.CursorPosition = cursorPosition
+	textAreaEntry.SelectionAnchor = cursorPosition
+}
+
+/*
+moveTextAreaCursor allows you to move the cursor of a text area to a new
+position, keeping it within the text entered. If selecting, the selection
+is extended to the new position. Otherwise, any selection is removed.
+*/
+func moveTextAreaCursor(textAreaEntry *memory.TextAreaEntryType, cursorPosition int, isSelecting bool) {
+	numberOfGraphemeClusters := len(stringformat.GetGraphemeClusters([]rune(textAreaEntry.CurrentValue)))
+	if cursorPosition < 0 {
+		cursorPosition = 0
+	}
+	if cursorPosition > numberOfGraphemeClusters {
+		cursorPosition = numberOfGraphemeClusters
+	}
+	textAreaEntry.CursorPosition = cursorPosition
+	if !isSelecting {
+		textAreaEntry.SelectionAnchor = cursorPosition
+	}
+}
+
+/*
+moveTextAreaCursorByRow allows you to move the cursor of a text area to
+another row, keeping it as close as possible to the column it is currently
+in. If the row specified is out of range, then the first or last row is
+used instead.
+*/
+func moveTextAreaCursorByRow(textAreaEntry *memory.TextAreaEntryType, graphemeClusters [][]rune, rows []textAreaRowType, rowIndex int, isSelecting bool) {
+	currentRow := rows[getTextAreaRowIndex(rows, textAreaEntry.CursorPosition)]
+	column := getGraphemeClustersWidth(graphemeClusters[currentRow.startIndex:textAreaEntry.CursorPosition])
+	if rowIndex < 0 {
+		rowIndex = 0
+	}
+	if rowIndex >= len(rows) {
+		rowIndex = len(rows) - 1
+	}
+	moveTextAreaCursor(textAreaEntry, getTextAreaPositionAtColumn(graphemeClusters, rows, rowIndex, column), isSelecting)
+}
+
+/*
+setTextAreaCursorByLocation allows you to move the cursor of a text area to
+the character under the specified location of its text layer. If
+selecting, the selection is extended to the new position.
+*/
+func setTextAreaCursorByLocation(controlEntry *memory.ControlEntryType, xLocation int, yLocation int, isSelecting bool) {
+	textAreaEntry := memory.GetTextArea(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	graphemeClusters := stringformat.GetGraphemeClusters([]rune(textAreaEntry.CurrentValue))
+	rows := getTextAreaRows(textAreaEntry, graphemeClusters)
+	rowIndex := textAreaEntry.ViewportYPosition + yLocation - textAreaEntry.YLocation
+	if rowIndex < 0 {
+		rowIndex = 0
+	}
+	if rowIndex >= len(rows) {
+		rowIndex = len(rows) - 1
+	}
+	column := textAreaEntry.ViewportXPosition + xLocation - textAreaEntry.XLocation - getTextAreaGutterWidth(textAreaEntry, graphemeClusters)
+	moveTextAreaCursor(textAreaEntry, getTextAreaPositionAtColumn(graphemeClusters, rows, rowIndex, column), isSelecting)
+	updateTextAreaViewport(textAreaEntry)
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+}
+
+/*
+scrollTextArea allows you to scroll the rows of a text area without moving
+its cursor. The text area will not scroll past its first or last row.
+*/
+func scrollTextArea(controlEntry *memory.ControlEntryType, numberOfRows int) {
+	textAreaEntry := memory.GetTextArea(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	rows := getTextAreaRows(textAreaEntry, stringformat.GetGraphemeClusters([]rune(textAreaEntry.CurrentValue)))
+	viewportYPosition := textAreaEntry.ViewportYPosition + numberOfRows
+	if viewportYPosition > len(rows)-textAreaEntry.Height {
+		viewportYPosition = len(rows) - textAreaEntry.Height
+	}
+	if viewportYPosition < 0 {
+		viewportYPosition = 0
+	}
+	textAreaEntry.ViewportYPosition = viewportYPosition
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+}
+
+/*
+getTextAreaSelectionRange allows you to obtain the start and end positions
+of the text selected in a text area. If no text is selected, then both
+positions are equal to the cursor position.
+*/
+func getTextAreaSelectionRange(textAreaEntry *memory.TextAreaEntryType) (int, int) {
+	if textAreaEntry.SelectionAnchor < textAreaEntry.CursorPosition {
+		return textAreaEntry.SelectionAnchor, textAreaEntry.CursorPosition
+	}
+	return textAreaEntry.CursorPosition, textAreaEntry.SelectionAnchor
+}
+
+/*
+getTextAreaRows allows you to split the text of a text area into the rows
+which are drawn. In addition, the following information should be noted:
+
+- Each line of text starts a new row. The line break itself is not part of
+any row.
+
+- If word wrap is enabled, lines are split after the last space which fits
+in the text area. If a single word is too long to fit, it is split wherever
+it reaches the edge of the text area.
+
+- There is always at least one row, even if no text is entered.
+*/
+func getTextAreaRows(textAreaEntry *memory.TextAreaEntryType, graphemeClusters [][]rune) []textAreaRowType {
+	var rows []textAreaRowType
+	textWidth := textAreaEntry.Width - getTextAreaGutterWidth(textAreaEntry, graphemeClusters)
+	lineStartIndex := 0
+	for lineNumber := 1; ; lineNumber++ {
+		lineEndIndex := lineStartIndex
+		for lineEndIndex < len(graphemeClusters) && string(graphemeClusters[lineEndIndex]) != "\n" {
+			lineEndIndex++
+		}
+		rowStartIndex := lineStartIndex
+		for {
+			rowEndIndex := lineEndIndex
+			if textAreaEntry.IsWordWrapEnabled {
+				rowWidth := 0
+				for currentIndex := rowStartIndex; currentIndex < lineEndIndex; currentIndex++ {
+					rowWidth += stringformat.GetGraphemeClusterWidth(graphemeClusters[currentIndex])
+					if rowWidth > textWidth {
+						rowEndIndex = currentIndex
+						break
+					}
+				}
+				if rowEndIndex < lineEndIndex {
+					for currentIndex := rowEndIndex; currentIndex > rowStartIndex; currentIndex-- {
+						if string(graphemeClusters[currentIndex]) == " " {
+							rowEndIndex = currentIndex + 1
+							break
+						}
+					}
+					if rowEndIndex == rowStartIndex {
+						rowEndIndex++
+					}
+				}
+			}
+			rows = append(rows, textAreaRowType{startIndex: rowStartIndex, endIndex: rowEndIndex, lineNumber: lineNumber, isFirstRowOfLine: rowStartIndex == lineStartIndex})
+			if rowEndIndex >= lineEndIndex {
+				break
+			}
+			rowStartIndex = rowEndIndex
+		}
+		if lineEndIndex >= len(graphemeClusters) {
+			break
+		}
+		lineStartIndex = lineEndIndex + 1
+	}
+	return rows
+}
+
+/*
+getTextAreaRowIndex allows you to find which row of a text area a cursor
+position is drawn on. A position at the end of a wrapped row belongs to the
+row which follows it.
+*/
+func getTextAreaRowIndex(rows []textAreaRowType, cursorPosition int) int {
+	for currentIndex, currentRow := range rows {
+		if cursorPosition >= currentRow.startIndex && cursorPosition <= getTextAreaRowEndPosition(rows, currentIndex) {
+			return currentIndex
+		}
+	}
+	return len(rows) - 1
+}
+
+/*
+getTextAreaRowEndPosition allows you to obtain the last cursor position
+which belongs to a row. For wrapped rows, this is the position before the
+next row starts.
+*/
+func getTextAreaRowEndPosition(rows []textAreaRowType, rowIndex int) int {
+	if rowIndex < len(rows)-1 && rows[rowIndex+1].startIndex == rows[rowIndex].endIndex {
+		return rows[rowIndex].endIndex - 1
+	}
+	return rows[rowIndex].endIndex
+}
+
+/*
+getTextAreaPositionAtColumn allows you to obtain the cursor position of the
+character drawn at the specified column of a row. If the column is past the
+end of the row, the last position of the row is returned instead.
+*/
+func getTextAreaPositionAtColumn(graphemeClusters [][]rune, rows []textAreaRowType, rowIndex int, column int) int {
+	currentColumn := 0
+	rowEndPosition := getTextAreaRowEndPosition(rows, rowIndex)
+	for currentIndex := rows[rowIndex].startIndex; currentIndex < rowEndPosition; currentIndex++ {
+		currentColumn += stringformat.GetGraphemeClusterWidth(graphemeClusters[currentIndex])
+		if column < currentColumn {
+			return currentIndex
+		}
+	}
+	return rowEndPosition
+}
+
+/*
+getTextAreaGutterWidth allows you to obtain the number of columns used to
+draw line numbers in a text area. If line numbers are disabled, then 0 is
+returned. The gutter never uses more than half the width of the text area.
+*/
+func getTextAreaGutterWidth(textAreaEntry *memory.TextAreaEntryType, graphemeClusters [][]rune) int {
+	if !textAreaEntry.IsLineNumbersEnabled {
+		return 0
+	}
+	numberOfLines := 1
+	for _, currentGraphemeCluster := range graphemeClusters {
+		if string(currentGraphemeCluster) == "\n" {
+			numberOfLines++
+		}
+	}
+	gutterWidth := len(strconv.Itoa(numberOfLines)) + 1
+	if gutterWidth > textAreaEntry.Width/2 {
+		gutterWidth = textAreaEntry.Width / 2
+	}
+	return gutterWidth
+}
+
+/*
+updateTextAreaViewport allows you to scroll a text area so that its cursor
+is always visible. Horizontal scrolling only takes place when word wrap is
+disabled.
+*/
+func updateTextAreaViewport(textAreaEntry *memory.TextAreaEntryType) {
+	graphemeClusters := stringformat.GetGraphemeClusters([]rune(textAreaEntry.CurrentValue))
+	rows := getTextAreaRows(textAreaEntry, graphemeClusters)
+	rowIndex := getTextAreaRowIndex(rows, textAreaEntry.CursorPosition)
+	if rowIndex < textAreaEntry.ViewportYPosition {
+		textAreaEntry.ViewportYPosition = rowIndex
+	}
+	if rowIndex >= textAreaEntry.ViewportYPosition+textAreaEntry.Height {
+		textAreaEntry.ViewportYPosition = rowIndex - textAreaEntry.Height + 1
+	}
+	if textAreaEntry.ViewportYPosition > len(rows)-1 {
+		textAreaEntry.ViewportYPosition = len(rows) - 1
+	}
+	textAreaEntry.ViewportXPosition = 0
+	if !textAreaEntry.IsWordWrapEnabled {
+		textWidth := textAreaEntry.Width - getTextAreaGutterWidth(textAreaEntry, graphemeClusters)
+		column := getGraphemeClustersWidth(graphemeClusters[rows[rowIndex].startIndex:textAreaEntry.CursorPosition])
+		if column >= textWidth {
+			textAreaEntry.ViewportXPosition = column - textWidth + 1
+		}
+	}
+}
+
+/*
+drawTextAreasOnLayer allows you to draw all text areas on a given text layer
+entry.
+*/
+func drawTextAreasOnLayer(layerEntry memory.LayerEntryType) {
+	for _, currentTextAreaEntry := range memory.TextAreaMemory[layerEntry.LayerAlias] {
+		drawTextArea(&layerEntry, currentTextAreaEntry)
+	}
+}
+
+/*
+drawTextArea allows you to draw a text area on a given text layer. In
+addition, the following information should be noted:
+
+- Only the rows and columns which fall inside the viewport of the text area
+are drawn. If a wide character does not fit in the last column, it is not
+drawn.
+
+- Selected text is drawn using the highlight colors of the text area style.
+
+- The cursor is only drawn when the text area has focus.
+*/
+func drawTextArea(layerEntry *memory.LayerEntryType, textAreaEntry *memory.TextAreaEntryType) {
+	graphemeClusters := stringformat.GetGraphemeClusters([]rune(textAreaEntry.CurrentValue))
+	rows := getTextAreaRows(textAreaEntry, graphemeClusters)
+	gutterWidth := getTextAreaGutterWidth(textAreaEntry, graphemeClusters)
+	textWidth := textAreaEntry.Width - gutterWidth
+	selectionStart, selectionEnd := getTextAreaSelectionRange(textAreaEntry)
+	styleEntry := textAreaEntry.StyleEntry
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.TextInputForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.TextInputBackgroundColor
+	attributeEntry.CellType = constants.CellTypeTextInput
+	highlightAttributeEntry := memory.NewAttributeEntry(&attributeEntry)
+	highlightAttributeEntry.ForegroundColor = styleEntry.HighlightForegroundColor
+	highlightAttributeEntry.BackgroundColor = styleEntry.HighlightBackgroundColor
+	gutterAttributeEntry := memory.NewAttributeEntry()
+	gutterAttributeEntry.ForegroundColor = styleEntry.TextForegroundColor
+	gutterAttributeEntry.BackgroundColor = styleEntry.TextBackgroundColor
+	fillArea(layerEntry, attributeEntry, " ", textAreaEntry.XLocation+gutterWidth, textAreaEntry.YLocation, textWidth, textAreaEntry.Height)
+	fillArea(layerEntry, gutterAttributeEntry, " ", textAreaEntry.XLocation, textAreaEntry.YLocation, gutterWidth, textAreaEntry.Height)
+	for currentRowIndex := textAreaEntry.ViewportYPosition; currentRowIndex < len(rows) && currentRowIndex < textAreaEntry.ViewportYPosition+textAreaEntry.Height; currentRowIndex++ {
+		currentRow := rows[currentRowIndex]
+		yLocation := textAreaEntry.YLocation + currentRowIndex - textAreaEntry.ViewportYPosition
+		if gutterWidth > 0 && currentRow.isFirstRowOfLine {
+			lineNumber := fmt.Sprintf("%*d", gutterWidth-1, currentRow.lineNumber)
+			printLayer(layerEntry, gutterAttributeEntry, textAreaEntry.XLocation, yLocation, []rune(lineNumber))
+		}
+		column := 0
+		for currentIndex := currentRow.startIndex; currentIndex < currentRow.endIndex; currentIndex++ {
+			graphemeClusterWidth := stringformat.GetGraphemeClusterWidth(graphemeClusters[currentIndex])
+			drawColumn := column - textAreaEntry.ViewportXPosition
+			column += graphemeClusterWidth
+			if drawColumn < 0 {
+				continue
+			}
+			if drawColumn+graphemeClusterWidth > textWidth {
+				break
+			}
+			if currentIndex >= selectionStart && currentIndex < selectionEnd {
+				printLayer(layerEntry, highlightAttributeEntry, textAreaEntry.XLocation+gutterWidth+drawColumn, yLocation, graphemeClusters[currentIndex])
+			} else {
+				printLayer(layerEntry, attributeEntry, textAreaEntry.XLocation+gutterWidth+drawColumn, yLocation, graphemeClusters[currentIndex])
+			}
+		}
+	}
+	cursorRowIndex := getTextAreaRowIndex(rows, textAreaEntry.CursorPosition)
+	if textAreaEntry.IsFocused && cursorRowIndex >= textAreaEntry.ViewportYPosition && cursorRowIndex < textAreaEntry.ViewportYPosition+textAreaEntry.Height {
+		cursorColumn := getGraphemeClustersWidth(graphemeClusters[rows[cursorRowIndex].startIndex:textAreaEntry.CursorPosition]) - textAreaEntry.ViewportXPosition
+		if cursorColumn > textWidth-1 {
+			cursorColumn = textWidth - 1
+		}
+		drawCursor(layerEntry, styleEntry, textAreaEntry.XLocation+gutterWidth, textAreaEntry.YLocation+cursorRowIndex-textAreaEntry.ViewportYPosition, cursorColumn, false)
+	}
+}
@@ -0,0 +1,79 @@
+package dosktop
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestTextAreaEditing(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 8)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Form", 0, 0, 20, 8, 1, "")
+	AddTextArea("Form", "Notes", NewTuiStyleEntry(), 1, 1, 10, 3, 30, true, true, "")
+	AddTextArea("Form", "Short", NewTuiStyleEntry(), 1, 5, 4, 1, 5, false, false, "")
+	injectKey := func(key tcell.Key, character rune, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventKey(key, character, modifierMask))
+	}
+	SetFocus("Form", "Notes")
+	for _, currentCharacter := range "hello world foo" {
+		injectKey(tcell.KeyRune, currentCharacter, tcell.ModNone)
+	}
+	injectKey(tcell.KeyHome, 0, tcell.ModShift)
+	injectKey(tcell.KeyCtrlC, 0, tcell.ModCtrl)
+	injectKey(tcell.KeyEnd, 0, tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	injectKey(tcell.KeyCtrlV, 0, tcell.ModCtrl)
+	injectKey(tcell.KeyCtrlZ, 0, tcell.ModCtrl)
+	injectKey(tcell.KeyCtrlZ, 0, tcell.ModCtrl)
+	injectKey(tcell.KeyCtrlY, 0, tcell.ModCtrl)
+	injectKey(tcell.KeyCtrlV, 0, tcell.ModCtrl)
+	injectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	var numberOfChanges int
+	eventEntry := WaitForEvent()
+	for ; eventEntry.EventType == constants.EventTypeControl && eventEntry.ControlEvent.Action == constants.ControlActionChange; eventEntry = WaitForEvent() {
+		numberOfChanges++
+	}
+	assert.Equalf(test, 21, numberOfChanges, "Only keystrokes which edit the text area should report a change!")
+	assert.Equalf(test, []interface{}{constants.EventTypeControl, "Notes", constants.ControlActionCancel}, []interface{}{eventEntry.EventType, eventEntry.ControlEvent.ControlAlias, eventEntry.ControlEvent.Action}, "Pressing esc did not cancel the text area!")
+	assert.Equalf(test, "foo", GetClipboardText(), "Copying a selection did not fill the clipboard!")
+	assert.Equalf(test, "hello world foo\nfoo", GetTextAreaValue("Form", "Notes"), "The text area value was not edited correctly!")
+	UpdateDisplay()
+	cells, width, _ := simulationScreen.GetContents()
+	getScreenText := func(xLocation int, yLocation int, length int) string {
+		obtainedValue := ""
+		for currentXLocation := xLocation; currentXLocation < xLocation+length; currentXLocation++ {
+			obtainedValue += string(cells[yLocation*width+currentXLocation].Runes)
+		}
+		return obtainedValue
+	}
+	assert.Equalf(test, []string{"  world ", "  foo", "2 foo"}, []string{getScreenText(1, 1, 8), getScreenText(1, 2, 5), getScreenText(1, 3, 5)}, "The text area did not wrap its lines or follow the cursor!")
+	simulationScreen.InjectMouse(3, 1, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(6, 1, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(6, 1, tcell.ButtonNone, tcell.ModNone)
+	simulationScreen.InjectMouse(3, 1, tcell.WheelUp, tcell.ModNone)
+	injectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	for eventEntry = WaitForEvent(); eventEntry.EventType != constants.EventTypeControl; eventEntry = WaitForEvent() {
+	}
+	assert.Equalf(test, "wor", GetTextAreaSelection("Form", "Notes"), "Dragging the mouse did not select text!")
+	assert.Equalf(test, 0, memory.GetTextArea("Form", "Notes").ViewportYPosition, "The mouse wheel did not scroll the text area!")
+	SetClipboardText("abcdefgh")
+	SetFocus("Form", "Short")
+	injectKey(tcell.KeyCtrlV, 0, tcell.ModCtrl)
+	eventEntry = WaitForEvent()
+	assert.Equalf(test, constants.ControlActionChange, eventEntry.ControlEvent.Action, "Pasting did not change the text area!")
+	assert.Equalf(test, "abcde", GetTextAreaValue("Form", "Short"), "Pasting did not respect the max length!")
+	assert.Equalf(test, 2, memory.GetTextArea("Form", "Short").ViewportXPosition, "The text area did not scroll horizontally!")
+	SetTextAreaValue("Form", "Short", "xy")
+	injectKey(tcell.KeyCtrlZ, 0, tcell.ModCtrl)
+	injectKey(tcell.KeyRune, 'x', tcell.ModAlt)
+	eventEntry = WaitForEvent()
+	assert.Equalf(test, constants.EventTypeKey, eventEntry.EventType, "Setting a value did not clear the undo history!")
+	assert.Equalf(test, "xy", GetTextAreaValue("Form", "Short"), "Undo restored a value from before it was set!")
+	DeleteTextArea("Form", "Short")
+	assert.Falsef(test, memory.IsTextAreaExists("Form", "Short") || memory.IsControlExists("Form", "Short"), "The text area was not deleted!")
+	assert.Panicsf(test, func() { AddTextArea("Form", "Bad", NewTuiStyleEntry(), 0, 0, 5, 0, 10, false, false, "") }, "A text area with no height did not panic!")
+}
@@ -0,0 +1,412 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+	"unicode"
+)
+
+/*
+AddTextField allows you to add a text field to a text layer. Unlike
+'GetInput', a text field does not block your application while the user
+types. Instead, it keeps its own value and cursor position, and is updated
+as events are read with 'PollEvent' or 'WaitForEvent'. This lets you run
+several text fields at once alongside animations and timers. For example:
+
+	dosktop.AddTextField("Form", "Name", styleEntry, 2, 2, 20, 40, false, "")
+	dosktop.SetFocus("Form", "Name")
+	for {
+		eventEntry := dosktop.WaitForEvent()
+		if eventEntry.EventType == constants.EventTypeControl && eventEntry.ControlEvent.Action == constants.ControlActionSubmit {
+			dosktop.Print(dosktop.GetTextFieldValue("Form", "Name"))
+		}
+	}
+
+In addition, the following information should be noted:
+
+- A text field only receives keystrokes while it has focus. Clicking on the
+text field with the mouse gives it focus and moves the cursor to the
+location clicked.
+
+- Every time the value of the text field changes, a control event with the
+action 'ControlActionChange' is returned. When the user presses 'enter' a
+'ControlActionSubmit' event is returned, and when the user presses 'esc' a
+'ControlActionCancel' event is returned. The text field is left in place
+either way, so that you can decide whether to delete it.
+
+- Keys which the text field does not use, such as 'up' or 'down', are
+reported as regular key events.
+
+- Text fields are not drawn physically to the text layer provided. Instead
+they are rendered to the terminal at the same time when the text layer is
+rendered.
+
+- Password protection will echo back '*' characters to the terminal instead
+of the actual characters entered.
+
+- If the width or max length of your text field is less than or equal to 0,
+a panic will be generated to fail as fast as possible.
+*/
+func AddTextField(layerAlias string, textFieldAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, maxLengthAllowed int, isPasswordProtected bool, defaultValue string) {
+	if width <= 0 {
+		panic(fmt.Sprintf("The specified text field width of '%d' is invalid!", width))
+	}
+	if maxLengthAllowed <= 0 {
+		panic(fmt.Sprintf("The specified maximum input length of '%d' is invalid!", maxLengthAllowed))
+	}
+	memory.AddTextField(layerAlias, textFieldAlias, styleEntry, xLocation, yLocation, width, maxLengthAllowed, isPasswordProtected, "")
+	addControl(layerAlias, textFieldAlias, constants.ControlTypeTextField)
+	SetTextFieldValue(layerAlias, textFieldAlias, defaultValue)
+}
+
+/*
+DeleteTextField allows you to remove a text field from a text layer. In
+addition, the following information should be noted:
+
+- If you attempt to delete a text field which does not exist, then the
+request will simply be ignored.
+*/
+func DeleteTextField(layerAlias string, textFieldAlias string) {
+	if !memory.IsTextFieldExists(layerAlias, textFieldAlias) {
+		return
+	}
+	markControlAsDirty(layerAlias, textFieldAlias)
+	deleteControl(layerAlias, textFieldAlias)
+	memory.DeleteTextField(layerAlias, textFieldAlias)
+}
+
+/*
+GetTextFieldValue allows you to obtain the text currently entered in a text
+field. If the text field does not exist, then a panic will be generated to
+fail as fast as possible.
+*/
+func GetTextFieldValue(layerAlias string, textFieldAlias string) string {
+	return memory.GetTextField(layerAlias, textFieldAlias).CurrentValue
+}
+
+/*
+SetTextFieldValue allows you to replace the text entered in a text field.
+In addition, the following information should be noted:
+
+- The cursor is moved to the end of the new value.
+
+- If the value is longer than the max length of the text field, then it
+will be truncated.
+
+- No control event is returned, since the change was not made by the user.
+
+- If the text field does not exist, then a panic will be generated to fail
+as fast as possible.
+*/
+func SetTextFieldValue(layerAlias string, textFieldAlias string, value string) {
+	textFieldEntry := memory.GetTextField(layerAlias, textFieldAlias)
+	setTextFieldValue(textFieldEntry, value)
+	markControlAsDirty(layerAlias, textFieldAlias)
+}
+
+/*
+setTextFieldValue allows you to replace the text entered in a text field
+entry, truncating it to the max length allowed and moving the cursor to
+the end.
+*/
+func setTextFieldValue(textFieldEntry *memory.TextFieldEntryType, value string) {
+	graphemeClusters := stringformat.GetGraphemeClusters([]rune(value))
+	if len(graphemeClusters) > textFieldEntry.MaxLengthAllowed {
+		graphemeClusters = graphemeClusters[:textFieldEntry.MaxLengthAllowed]
+	}
+	textFieldEntry.CurrentValue = getStringFromGraphemeClusters(graphemeClusters)
+	textFieldEntry.CursorPosition = len(graphemeClusters)
+	updateTextFieldViewport(textFieldEntry)
+}
+
+/*
+getTextFieldKeyEvents allows you to update a text field with a key event.
+The events which should be reported in its place are returned. If the key
+is not used by the text field, then the key event itself is returned.
+*/
+func getTextFieldKeyEvents(controlEntry *memory.ControlEntryType, eventEntry memory.EventEntryType) []memory.EventEntryType {
+	textFieldEntry := memory.GetTextField(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	previousValue := textFieldEntry.CurrentValue
+	keyEvent := eventEntry.KeyEvent
+	keystroke := keyEvent.Chord
+	if keyEvent.Key == tcell.KeyRune && keyEvent.ModifierMask&(tcell.ModCtrl|tcell.ModAlt) == 0 {
+		keystroke = string(keyEvent.Character)
+	}
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if updateTextFieldCompletion(textFieldEntry, keystroke) {
+		markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+		if textFieldEntry.CurrentValue != previousValue {
+			return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionChange)}
+		}
+		return nil
+	}
+	switch keystroke {
+	case "enter":
+		addTextFieldHistory(textFieldEntry)
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionSubmit)}
+	case "esc":
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionCancel)}
+	}
+	if !updateTextFieldValue(textFieldEntry, keystroke) {
+		return []memory.EventEntryType{eventEntry}
+	}
+	if textFieldEntry.CurrentValue != previousValue {
+		updateTextFieldCompletionItems(textFieldEntry)
+	}
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if textFieldEntry.CurrentValue != previousValue {
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionChange)}
+	}
+	return nil
+}
+
+/*
+updateTextFieldValue allows you to edit a text field entry with a
+keystroke. Returns 'true' if the keystroke was used by the text field. In
+addition, the following information should be noted:
+
+- Text is edited one grapheme cluster at a time. This means that a
+character and any accents combined with it, or an emoji sequence, are
+treated as a single character when moving the cursor, deleting, or
+checking the max length allowed.
+
+- Typing a combining mark, such as an accent, combines it with the character
+before the cursor.
+
+- Printable keystrokes which would make the value longer than the max
+length allowed are used, but ignored.
+
+- Edits are checked by the validator of the text field, if one is set.
+*/
+func updateTextFieldValue(textFieldEntry *memory.TextFieldEntryType, keystroke string) bool {
+	graphemeClusters := stringformat.GetGraphemeClusters([]rune(textFieldEntry.CurrentValue))
+	cursorPosition := textFieldEntry.CursorPosition
+	switch keystroke {
+	case "backspace", "backspace2":
+		if cursorPosition > 0 {
+			return replaceTextFieldText(textFieldEntry, graphemeClusters, cursorPosition-1, cursorPosition, "")
+		}
+	case "delete":
+		if cursorPosition < len(graphemeClusters) {
+			return replaceTextFieldText(textFieldEntry, graphemeClusters, cursorPosition, cursorPosition+1, "")
+		}
+	case "left":
+		if cursorPosition > 0 {
+			cursorPosition--
+		}
+	case "right":
+		if cursorPosition < len(graphemeClusters) {
+			cursorPosition++
+		}
+	case "home":
+		cursorPosition = 0
+	case "end":
+		cursorPosition = len(graphemeClusters)
+	default:
+		if !isKeystrokePrintable(keystroke) {
+			return false
+		}
+		return replaceTextFieldText(textFieldEntry, graphemeClusters, cursorPosition, cursorPosition, keystroke)
+	}
+	textFieldEntry.CursorPosition = cursorPosition
+	updateTextFieldViewport(textFieldEntry)
+	return true
+}
+
+/*
+replaceTextFieldText allows you to replace the grapheme clusters of a text
+field between the start and end index provided with new text, leaving the
+cursor after the text inserted. Returns 'true' since the keystroke which
+caused the edit was used, even if the edit itself was rejected. In
+addition, the following information should be noted:
+
+- If the edit would make the value longer than the max length allowed, it
+is ignored.
+
+- If the edit is rejected by the validator of the text field, the text
+field is flagged so that it is drawn using error colors. The flag is
+cleared by the next edit which is accepted.
+*/
+func replaceTextFieldText(textFieldEntry *memory.TextFieldEntryType, graphemeClusters [][]rune, startIndex int, endIndex int, text string) bool {
+	updatedValue, cursorPosition, isAllowed := getValidatedInputValue(&textFieldEntry.Validator, graphemeClusters, startIndex, endIndex, text)
+	if !isAllowed {
+		textFieldEntry.IsErrorDisplayed = true
+	} else if len(stringformat.GetGraphemeClusters([]rune(updatedValue))) <= textFieldEntry.MaxLengthAllowed {
+		textFieldEntry.CurrentValue = updatedValue
+		textFieldEntry.CursorPosition = cursorPosition
+		textFieldEntry.IsErrorDisplayed = false
+	}
+	updateTextFieldViewport(textFieldEntry)
+	return true
+}
+
+/*
+isKeystrokePrintable allows you to determine if a keystroke represents text
+which can be typed, rather than the name of a special key such as 'enter'
+or 'up'.
+*/
+func isKeystrokePrintable(keystroke string) bool {
+	arrayOfRunes := []rune(keystroke)
+	if len(arrayOfRunes) == 0 || len(stringformat.GetGraphemeClusters(arrayOfRunes)) != 1 {
+		return false
+	}
+	return unicode.IsPrint(arrayOfRunes[0])
+}
+
+/*
+setTextFieldCursorByLocation allows you to move the cursor of a text field
+to the character under the specified location of its text layer. If the
+location is past the end of the text entered, the cursor is moved to the
+end instead.
+*/
+func setTextFieldCursorByLocation(controlEntry *memory.ControlEntryType, xLocation int) {
+	textFieldEntry := memory.GetTextField(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	textFieldEntry.CursorPosition = getTextFieldCursorAtColumn(textFieldEntry, xLocation-textFieldEntry.XLocation)
+	updateTextFieldViewport(textFieldEntry)
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+}
+
+/*
+getTextFieldCursorAtColumn allows you to obtain the cursor position of the
+character drawn at the specified column of a text field. Since wide
+characters occupy two columns, either column will return the same cursor
+position. If the column is past the end of the text entered, then the
+position after the last character is returned.
+*/
+func getTextFieldCursorAtColumn(textFieldEntry *memory.TextFieldEntryType, column int) int {
+	graphemeClusters := getTextFieldGraphemeClusters(textFieldEntry)
+	currentColumn := 0
+	for currentIndex := textFieldEntry.ViewportPosition; currentIndex < len(graphemeClusters); currentIndex++ {
+		currentColumn += stringformat.GetGraphemeClusterWidth(graphemeClusters[currentIndex])
+		if column < currentColumn {
+			return currentIndex
+		}
+	}
+	return len(graphemeClusters)
+}
+
+/*
+updateTextFieldViewport allows you to scroll a text field so that its cursor
+is always visible. In addition, the following information should be noted:
+
+- The viewport is measured in grapheme clusters, but the space available is
+measured in columns. This ensures wide characters are accounted for when
+deciding how much text fits.
+
+- If all the text from an earlier viewport position fits in the text field,
+the text field is scrolled back so that as much text as possible is shown.
+*/
+func updateTextFieldViewport(textFieldEntry *memory.TextFieldEntryType) {
+	graphemeClusters := getTextFieldGraphemeClusters(textFieldEntry)
+	if textFieldEntry.CursorPosition < textFieldEntry.ViewportPosition {
+		textFieldEntry.ViewportPosition = textFieldEntry.CursorPosition
+	}
+	for textFieldEntry.ViewportPosition < textFieldEntry.CursorPosition && getGraphemeClustersWidth(graphemeClusters[textFieldEntry.ViewportPosition:textFieldEntry.CursorPosition]) >= textFieldEntry.Width {
+		textFieldEntry.ViewportPosition++
+	}
+	for textFieldEntry.ViewportPosition > 0 && getGraphemeClustersWidth(graphemeClusters[textFieldEntry.ViewportPosition-1:]) < textFieldEntry.Width {
+		textFieldEntry.ViewportPosition--
+	}
+}
+
+/*
+getTextFieldGraphemeClusters allows you to obtain the grapheme clusters
+which should be displayed for a text field. If the text field is password
+protected, then each grapheme cluster is replaced with a '*' character.
+*/
+func getTextFieldGraphemeClusters(textFieldEntry *memory.TextFieldEntryType) [][]rune {
+	graphemeClusters := stringformat.GetGraphemeClusters([]rune(textFieldEntry.CurrentValue))
+	if textFieldEntry.IsPasswordProtected {
+		for currentIndex := range graphemeClusters {
+			graphemeClusters[currentIndex] = []rune{'*'}
+		}
+	}
+	return graphemeClusters
+}
+
+/*
+getGraphemeClustersWidth allows you to obtain the number of columns needed
+to display a list of grapheme clusters.
+*/
+func getGraphemeClustersWidth(graphemeClusters [][]rune) int {
+	width := 0
+	for _, currentGraphemeCluster := range graphemeClusters {
+		width += stringformat.GetGraphemeClusterWidth(currentGraphemeCluster)
+	}
+	return width
+}
+
+/*
+getStringFromGraphemeClusters allows you to join a list of grapheme clusters
+back into a single string.
+*/
+func getStringFromGraphemeClusters(graphemeClusters [][]rune) string {
+	var arrayOfRunes []rune
+	for _, currentGraphemeCluster := range graphemeClusters {
+		arrayOfRunes = append(arrayOfRunes, currentGraphemeCluster...)
+	}
+	return string(arrayOfRunes)
+}
+
+/*
+drawTextFieldsOnLayer allows you to draw all text fields on a given text
+layer entry.
+*/
+func drawTextFieldsOnLayer(layerEntry memory.LayerEntryType) {
+	for _, currentTextFieldEntry := range memory.TextFieldMemory[layerEntry.LayerAlias] {
+		drawTextField(&layerEntry, currentTextFieldEntry)
+	}
+}
+
+/*
+drawTextField allows you to draw a text field on a given text layer. In
+addition, the following information should be noted:
+
+- Only the portion of the text entered which falls inside the viewport of
+the text field is drawn. If a wide character does not fit in the last
+column, it is not drawn.
+
+- Each cell of the text field is given a cell ID equal to its column, so
+that the column under the mouse can be detected.
+
+- The cursor is only drawn when the text field has focus.
+
+- If the last edit was rejected by the validator of the text field, the
+text field is drawn using the error colors of its style entry.
+
+- If the text field has focus and completion candidates, its completion
+list is drawn beneath it.
+*/
+func drawTextField(layerEntry *memory.LayerEntryType, textFieldEntry *memory.TextFieldEntryType) {
+	graphemeClusters := getTextFieldGraphemeClusters(textFieldEntry)
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = textFieldEntry.StyleEntry.TextInputForegroundColor
+	attributeEntry.BackgroundColor = textFieldEntry.StyleEntry.TextInputBackgroundColor
+	if textFieldEntry.IsErrorDisplayed {
+		attributeEntry.ForegroundColor = textFieldEntry.StyleEntry.TextInputErrorForegroundColor
+		attributeEntry.BackgroundColor = textFieldEntry.StyleEntry.TextInputErrorBackgroundColor
+	}
+	attributeEntry.CellType = constants.CellTypeTextInput
+	for currentColumn := 0; currentColumn < textFieldEntry.Width; currentColumn++ {
+		attributeEntry.CellId = currentColumn
+		printLayer(layerEntry, attributeEntry, textFieldEntry.XLocation+currentColumn, textFieldEntry.YLocation, []rune{' '})
+	}
+	currentColumn := 0
+	for currentIndex := textFieldEntry.ViewportPosition; currentIndex < len(graphemeClusters); currentIndex++ {
+		graphemeClusterWidth := stringformat.GetGraphemeClusterWidth(graphemeClusters[currentIndex])
+		if currentColumn+graphemeClusterWidth > textFieldEntry.Width {
+			break
+		}
+		attributeEntry.CellId = currentColumn
+		printLayer(layerEntry, attributeEntry, textFieldEntry.XLocation+currentColumn, textFieldEntry.YLocation, graphemeClusters[currentIndex])
+		currentColumn += graphemeClusterWidth
+	}
+	if textFieldEntry.IsFocused {
+		cursorColumn := getGraphemeClustersWidth(graphemeClusters[textFieldEntry.ViewportPosition:textFieldEntry.CursorPosition])
+		drawCursor(layerEntry, textFieldEntry.StyleEntry, textFieldEntry.XLocation, textFieldEntry.YLocation, cursorColumn, false)
+	}
+	drawTextFieldCompletionList(layerEntry, textFieldEntry)
+}
@@ -0,0 +1,55 @@
+package dosktop
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestTextFieldEditing(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 5)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Form", 0, 0, 20, 5, 1, "")
+	AddTextField("Form", "Name", NewTuiStyleEntry(), 2, 1, 5, 8, false, "abc")
+	AddTextField("Form", "Secret", NewTuiStyleEntry(), 2, 3, 5, 8, true, "xyz")
+	SetFocus("Form", "Name")
+	for _, currentCharacter := range "défgh" {
+		simulationScreen.InjectKey(tcell.KeyRune, currentCharacter, tcell.ModNone)
+	}
+	simulationScreen.InjectKey(tcell.KeyHome, 0, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyDelete, 0, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyUp, 0, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	var numberOfChanges int
+	for eventEntry := WaitForEvent(); eventEntry.EventType == constants.EventTypeControl && eventEntry.ControlEvent.Action == constants.ControlActionChange; eventEntry = WaitForEvent() {
+		numberOfChanges++
+	}
+	assert.Equalf(test, 6, numberOfChanges, "Keystrokes beyond the max length should not change the text field!")
+	assert.Equalf(test, "bcdéfgh", GetTextFieldValue("Form", "Name"), "The text field value was not edited correctly!")
+	eventEntry := WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.EventTypeControl, "Name", constants.ControlActionSubmit}, []interface{}{eventEntry.EventType, eventEntry.ControlEvent.ControlAlias, eventEntry.ControlEvent.Action}, "Pressing enter did not submit the text field!")
+	UpdateDisplay()
+	cells, width, _ := simulationScreen.GetContents()
+	getScreenText := func(xLocation int, yLocation int, length int) string {
+		obtainedValue := ""
+		for currentXLocation := xLocation; currentXLocation < xLocation+length; currentXLocation++ {
+			obtainedValue += string(cells[yLocation*width+currentXLocation].Runes)
+		}
+		return obtainedValue
+	}
+	assert.Equalf(test, "***", getScreenText(2, 3, 3), "A password protected text field did not hide its value!")
+	assert.Equalf(test, "cdéf", getScreenText(3, 1, 4), "The text field viewport did not follow the cursor!")
+	simulationScreen.InjectMouse(5, 1, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(5, 1, tcell.ButtonNone, tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	for eventEntry = WaitForEvent(); eventEntry.EventType != constants.EventTypeControl; eventEntry = WaitForEvent() {
+	}
+	assert.Equalf(test, constants.ControlActionCancel, eventEntry.ControlEvent.Action, "Pressing esc did not cancel the text field!")
+	assert.Equalf(test, 3, memory.GetTextField("Form", "Name").CursorPosition, "Clicking the text field did not move the cursor!")
+	DeleteTextField("Form", "Name")
+	assert.Falsef(test, memory.IsTextFieldExists("Form", "Name") || memory.IsControlExists("Form", "Name"), "The text field was not deleted!")
+	assert.Panicsf(test, func() { AddTextField("Form", "Bad", NewTuiStyleEntry(), 0, 0, 5, 0, false, "") }, "A text field with no max length did not panic!")
+}
@@ -0,0 +1,661 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+)
+
+/*
+treeViewRowType is used to hold a single visible row of a tree view. The
+node index refers to the position of the node in the tree view, and the
+guides contain the line-drawing characters drawn to the left of its label.
+*/
+type treeViewRowType struct {
+	nodeIndex int
+	guides    []rune
+}
+
+/*
+AddTreeView allows you to add a hierarchical list of nodes to a text layer,
+such as a file tree or a configuration tree. Like list boxes, tree views do
+not block your application and are updated as events are read with
+'PollEvent' or 'WaitForEvent'. In addition, the following information
+should be noted:
+
+- Nodes are added with 'AddTreeViewNode'. Each node is drawn with
+line-drawing guides which connect it to its parent and siblings. Nodes
+which can be expanded are marked with a '+' when collapsed and a '-' when
+expanded.
+
+- If a load children callback is provided, it is called with the alias of
+a node the first time that node is expanded, but only if no children were
+added to it yet. This allows large trees to be loaded only as the user
+explores them. The callback should add the children of the node with
+'AddTreeViewNode'. If no children are added, the node is no longer marked
+as expandable. If the callback is 'nil', then no children are loaded.
+
+- A tree view only receives keystrokes while it has focus. The keys 'up',
+'down', 'pgup', 'pgdn', 'home', and 'end' move the highlighted node. The
+key 'right' expands the highlighted node, or moves to its first child if it
+is already expanded. The key 'left' collapses the highlighted node, or
+moves to its parent if it is already collapsed. The key 'space' toggles the
+highlighted node between expanded and collapsed.
+
+- Pressing the mouse on a node highlights it, and pressing the mouse on the
+'+' or '-' marker of a node expands or collapses it.
+
+- Every time the highlighted node changes, a control event with the action
+'ControlActionChange' is returned. When a node is expanded or collapsed by
+the user, a 'ControlActionExpand' or 'ControlActionCollapse' event is
+returned. When the user presses 'enter' or double clicks on a node, a
+'ControlActionSubmit' event is returned. When the user presses 'esc', a
+'ControlActionCancel' event is returned. The node can then be obtained with
+'GetTreeViewSelection'.
+
+- If the tree view has more visible nodes than its height allows, a scroll
+bar is drawn in its right-most column. Pressing or dragging the mouse on
+the scroll bar, or moving the mouse wheel over the tree view, scrolls it.
+
+- Tree views are not drawn physically to the text layer provided. Instead
+they are rendered to the terminal at the same time when the text layer is
+rendered.
+
+- If the width or height of your tree view is less than or equal to 0, a
+panic will be generated to fail as fast as possible.
+*/
+func AddTreeView(layerAlias string, treeViewAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, height int, loadChildrenCallback func(nodeAlias string)) {
+	if width <= 0 || height <= 0 {
+		panic(fmt.Sprintf("The specified tree view size of '%dx%d' is invalid!", width, height))
+	}
+	memory.AddTreeView(layerAlias, treeViewAlias, styleEntry, xLocation, yLocation, width, height, loadChildrenCallback)
+	addControl(layerAlias, treeViewAlias, constants.ControlTypeTreeView)
+	markControlAsDirty(layerAlias, treeViewAlias)
+}
+
+/*
+DeleteTreeView allows you to remove a tree view from a text layer. In
+addition, the following information should be noted:
+
+- If you attempt to delete a tree view which does not exist, then the
+request will simply be ignored.
+*/
+func DeleteTreeView(layerAlias string, treeViewAlias string) {
+	if !memory.IsTreeViewExists(layerAlias, treeViewAlias) {
+		return
+	}
+	markControlAsDirty(layerAlias, treeViewAlias)
+	deleteControl(layerAlias, treeViewAlias)
+	memory.DeleteTreeView(layerAlias, treeViewAlias)
+}
+
+/*
+AddTreeViewNode allows you to add a node to a tree view. In addition, the
+following information should be noted:
+
+- If the parent node alias is an empty string, the node is added to the
+top level of the tree view. Otherwise it is added after the last child of
+the parent node specified, and the parent node becomes expandable.
+
+- If the node is marked as expandable, it can be expanded even though it
+has no children yet. This allows its children to be loaded only when they
+are needed. For more information, see 'AddTreeView'.
+
+- New nodes are collapsed. If the tree view has no highlighted node, the
+node added becomes highlighted.
+
+- If the tree view or parent node does not exist, or a node with the same
+alias already exists, a panic will be generated to fail as fast as
+possible.
+*/
+func AddTreeViewNode(layerAlias string, treeViewAlias string, parentNodeAlias string, nodeAlias string, label string, isExpandable bool) {
+	treeViewEntry := memory.GetTreeView(layerAlias, treeViewAlias)
+	if getTreeViewNodeIndex(treeViewEntry, nodeAlias) != constants.NullSelectionIndex {
+		panic(fmt.Sprintf("The node alias '%s' already exists in the tree view '%s'!", nodeAlias, treeViewAlias))
+	}
+	if parentNodeAlias != "" {
+		parentNodeIndex := getTreeViewNodeIndex(treeViewEntry, parentNodeAlias)
+		if parentNodeIndex == constants.NullSelectionIndex {
+			panic(fmt.Sprintf("The parent node alias '%s' does not exist in the tree view '%s'!", parentNodeAlias, treeViewAlias))
+		}
+		treeViewEntry.Nodes[parentNodeIndex].IsExpandable = true
+		treeViewEntry.Nodes[parentNodeIndex].IsChildrenLoaded = true
+	}
+	nodeEntry := memory.TreeViewNodeEntryType{NodeAlias: nodeAlias, ParentAlias: parentNodeAlias, Label: label, IsExpandable: isExpandable}
+	treeViewEntry.Nodes = append(treeViewEntry.Nodes, nodeEntry)
+	if treeViewEntry.NodeHighlighted == "" && parentNodeAlias == "" {
+		treeViewEntry.NodeHighlighted = nodeAlias
+	}
+	updateTreeViewViewport(treeViewEntry)
+	markControlAsDirty(layerAlias, treeViewAlias)
+}
+
+/*
+DeleteTreeViewNode allows you to remove a node and all of its descendants
+from a tree view. In addition, the following information should be noted:
+
+- If the highlighted node is removed, the node before it becomes
+highlighted instead.
+
+- If the tree view does not exist, a panic will be generated to fail as
+fast as possible. If the node does not exist, then the request will simply
+be ignored.
+*/
+func DeleteTreeViewNode(layerAlias string, treeViewAlias string, nodeAlias string) {
+	treeViewEntry := memory.GetTreeView(layerAlias, treeViewAlias)
+	if getTreeViewNodeIndex(treeViewEntry, nodeAlias) == constants.NullSelectionIndex {
+		return
+	}
+	if isTreeViewNodeDescendant(treeViewEntry, treeViewEntry.NodeHighlighted, nodeAlias) {
+		rows := getTreeViewRows(treeViewEntry)
+		rowIndex := getTreeViewRowIndex(treeViewEntry, rows, nodeAlias)
+		treeViewEntry.NodeHighlighted = ""
+		if rowIndex > 0 {
+			treeViewEntry.NodeHighlighted = treeViewEntry.Nodes[rows[rowIndex-1].nodeIndex].NodeAlias
+		}
+	}
+	var remainingNodes []memory.TreeViewNodeEntryType
+	for _, currentNodeEntry := range treeViewEntry.Nodes {
+		if !isTreeViewNodeDescendant(treeViewEntry, currentNodeEntry.NodeAlias, nodeAlias) {
+			remainingNodes = append(remainingNodes, currentNodeEntry)
+		}
+	}
+	treeViewEntry.Nodes = remainingNodes
+	if treeViewEntry.NodeHighlighted == "" {
+		if rows := getTreeViewRows(treeViewEntry); len(rows) > 0 {
+			treeViewEntry.NodeHighlighted = treeViewEntry.Nodes[rows[0].nodeIndex].NodeAlias
+		}
+	}
+	updateTreeViewViewport(treeViewEntry)
+	markControlAsDirty(layerAlias, treeViewAlias)
+}
+
+/*
+SetTreeViewNodeExpanded allows you to expand or collapse a node of a tree
+view. In addition, the following information should be noted:
+
+- If the node is expanded for the first time and has no children, the load
+children callback of the tree view is called. For more information, see
+'AddTreeView'.
+
+- If the highlighted node is hidden by collapsing one of its ancestors, the
+collapsed node becomes highlighted instead.
+
+- No control event is returned, since the change was not made by the user.
+
+- If the tree view or node does not exist, a panic will be generated to
+fail as fast as possible.
+*/
+func SetTreeViewNodeExpanded(layerAlias string, treeViewAlias string, nodeAlias string, isExpanded bool) {
+	treeViewEntry := memory.GetTreeView(layerAlias, treeViewAlias)
+	nodeIndex := getTreeViewNodeIndex(treeViewEntry, nodeAlias)
+	if nodeIndex == constants.NullSelectionIndex {
+		panic(fmt.Sprintf("The node alias '%s' does not exist in the tree view '%s'!", nodeAlias, treeViewAlias))
+	}
+	setTreeViewNodeExpanded(treeViewEntry, nodeIndex, isExpanded)
+	markControlAsDirty(layerAlias, treeViewAlias)
+}
+
+/*
+IsTreeViewNodeExpanded allows you to detect if a node of a tree view is
+expanded. If the tree view or node does not exist, then a panic will be
+generated to fail as fast as possible.
+*/
+func IsTreeViewNodeExpanded(layerAlias string, treeViewAlias string, nodeAlias string) bool {
+	treeViewEntry := memory.GetTreeView(layerAlias, treeViewAlias)
+	nodeIndex := getTreeViewNodeIndex(treeViewEntry, nodeAlias)
+	if nodeIndex == constants.NullSelectionIndex {
+		panic(fmt.Sprintf("The node alias '%s' does not exist in the tree view '%s'!", nodeAlias, treeViewAlias))
+	}
+	return treeViewEntry.Nodes[nodeIndex].IsExpanded
+}
+
+/*
+GetTreeViewSelection allows you to obtain the alias of the node currently
+highlighted in a tree view. If the tree view has no nodes, then an empty
+string is returned instead. If the tree view does not exist, then a panic
+will be generated to fail as fast as possible.
+*/
+func GetTreeViewSelection(layerAlias string, treeViewAlias string) string {
+	treeViewEntry := memory.GetTreeView(layerAlias, treeViewAlias)
+	return treeViewEntry.NodeHighlighted
+}
+
+/*
+SetTreeViewSelection allows you to change which node is highlighted in a
+tree view. In addition, the following information should be noted:
+
+- All ancestors of the node are expanded, and the tree view will scroll if
+required to ensure the node is visible.
+
+- No control event is returned, since the change was not made by the user.
+
+- If the tree view or node does not exist, a panic will be generated to
+fail as fast as possible.
+*/
+func SetTreeViewSelection(layerAlias string, treeViewAlias string, nodeAlias string) {
+	treeViewEntry := memory.GetTreeView(layerAlias, treeViewAlias)
+	nodeIndex := getTreeViewNodeIndex(treeViewEntry, nodeAlias)
+	if nodeIndex == constants.NullSelectionIndex {
+		panic(fmt.Sprintf("The node alias '%s' does not exist in the tree view '%s'!", nodeAlias, treeViewAlias))
+	}
+	for parentNodeIndex := getTreeViewNodeIndex(treeViewEntry, treeViewEntry.Nodes[nodeIndex].ParentAlias); parentNodeIndex != constants.NullSelectionIndex; parentNodeIndex = getTreeViewNodeIndex(treeViewEntry, treeViewEntry.Nodes[parentNodeIndex].ParentAlias) {
+		treeViewEntry.Nodes[parentNodeIndex].IsExpanded = true
+	}
+	treeViewEntry.NodeHighlighted = nodeAlias
+	updateTreeViewViewport(treeViewEntry)
+	markControlAsDirty(layerAlias, treeViewAlias)
+}
+
+/*
+getTreeViewNodeIndex allows you to obtain the index of a tree view node by
+its alias. If the node does not exist, then 'constants.NullSelectionIndex'
+is returned instead.
+*/
+func getTreeViewNodeIndex(treeViewEntry *memory.TreeViewEntryType, nodeAlias string) int {
+	for currentIndex, currentNodeEntry := range treeViewEntry.Nodes {
+		if currentNodeEntry.NodeAlias == nodeAlias {
+			return currentIndex
+		}
+	}
+	return constants.NullSelectionIndex
+}
+
+/*
+isTreeViewNodeDescendant allows you to detect if a tree view node is the
+ancestor node specified, or one of its descendants.
+*/
+func isTreeViewNodeDescendant(treeViewEntry *memory.TreeViewEntryType, nodeAlias string, ancestorNodeAlias string) bool {
+	for nodeIndex := getTreeViewNodeIndex(treeViewEntry, nodeAlias); nodeIndex != constants.NullSelectionIndex; nodeIndex = getTreeViewNodeIndex(treeViewEntry, treeViewEntry.Nodes[nodeIndex].ParentAlias) {
+		if treeViewEntry.Nodes[nodeIndex].NodeAlias == ancestorNodeAlias {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+getTreeViewRows allows you to obtain the rows of a tree view which are
+visible when it is scrolled to the top. Children of collapsed nodes are not
+included. Each row includes the line-drawing guides for its node, followed
+by its expand marker and a space.
+*/
+func getTreeViewRows(treeViewEntry *memory.TreeViewEntryType) []treeViewRowType {
+	childNodeIndexes := make(map[string][]int)
+	for currentIndex, currentNodeEntry := range treeViewEntry.Nodes {
+		childNodeIndexes[currentNodeEntry.ParentAlias] = append(childNodeIndexes[currentNodeEntry.ParentAlias], currentIndex)
+	}
+	var rows []treeViewRowType
+	var addRows func(parentNodeAlias string, guides []rune)
+	addRows = func(parentNodeAlias string, guides []rune) {
+		for currentChild, currentNodeIndex := range childNodeIndexes[parentNodeAlias] {
+			nodeEntry := treeViewEntry.Nodes[currentNodeIndex]
+			isLastChild := currentChild == len(childNodeIndexes[parentNodeAlias])-1
+			connector := constants.CharSingleLineTRight
+			childGuides := []rune{constants.CharSingleLineVertical, ' '}
+			if isLastChild {
+				connector = constants.CharSingleLineLowerLeftCorner
+				childGuides = []rune{' ', ' '}
+			}
+			marker := constants.CharSingleLineHorizontal
+			if nodeEntry.IsExpandable && nodeEntry.IsExpanded {
+				marker = '-'
+			} else if nodeEntry.IsExpandable {
+				marker = '+'
+			}
+			rowGuides := append(append([]rune(nil), guides...), connector, constants.CharSingleLineHorizontal, marker, ' ')
+			rows = append(rows, treeViewRowType{nodeIndex: currentNodeIndex, guides: rowGuides})
+			if nodeEntry.IsExpandable && nodeEntry.IsExpanded {
+				addRows(nodeEntry.NodeAlias, append(append([]rune(nil), guides...), childGuides...))
+			}
+		}
+	}
+	addRows("", nil)
+	return rows
+}
+
+/*
+getTreeViewRowIndex allows you to obtain the row on which a tree view node
+is drawn. If the node is not visible, then 'constants.NullSelectionIndex'
+is returned instead.
+*/
+func getTreeViewRowIndex(treeViewEntry *memory.TreeViewEntryType, rows []treeViewRowType, nodeAlias string) int {
+	for currentIndex, currentRow := range rows {
+		if treeViewEntry.Nodes[currentRow.nodeIndex].NodeAlias == nodeAlias {
+			return currentIndex
+		}
+	}
+	return constants.NullSelectionIndex
+}
+
+/*
+updateTreeViewViewport allows you to keep the viewport of a tree view
+within the rows it has, and scroll it so that the highlighted node is
+visible.
+*/
+func updateTreeViewViewport(treeViewEntry *memory.TreeViewEntryType) {
+	rows := getTreeViewRows(treeViewEntry)
+	rowIndex := getTreeViewRowIndex(treeViewEntry, rows, treeViewEntry.NodeHighlighted)
+	if rowIndex != constants.NullSelectionIndex {
+		if rowIndex < treeViewEntry.ViewportPosition {
+			treeViewEntry.ViewportPosition = rowIndex
+		}
+		if rowIndex >= treeViewEntry.ViewportPosition+treeViewEntry.Height {
+			treeViewEntry.ViewportPosition = rowIndex - treeViewEntry.Height + 1
+		}
+	}
+	if treeViewEntry.ViewportPosition > len(rows)-treeViewEntry.Height {
+		treeViewEntry.ViewportPosition = len(rows) - treeViewEntry.Height
+	}
+	if treeViewEntry.ViewportPosition < 0 {
+		treeViewEntry.ViewportPosition = 0
+	}
+}
+
+/*
+highlightTreeViewRow allows you to highlight the node drawn on a row of a
+tree view, scrolling the tree view so that the node is visible. If the row
+specified is out of range, then the closest valid row is highlighted
+instead. Returns 'true' if the highlighted node has changed.
+*/
+func highlightTreeViewRow(treeViewEntry *memory.TreeViewEntryType, rows []treeViewRowType, rowIndex int) bool {
+	if len(rows) == 0 {
+		return false
+	}
+	if rowIndex >= len(rows) {
+		rowIndex = len(rows) - 1
+	}
+	if rowIndex < 0 {
+		rowIndex = 0
+	}
+	nodeAlias := treeViewEntry.Nodes[rows[rowIndex].nodeIndex].NodeAlias
+	isHighlightChanged := nodeAlias != treeViewEntry.NodeHighlighted
+	treeViewEntry.NodeHighlighted = nodeAlias
+	updateTreeViewViewport(treeViewEntry)
+	return isHighlightChanged
+}
+
+/*
+setTreeViewNodeExpanded allows you to expand or collapse a tree view node.
+In addition, the following information should be noted:
+
+- If an expandable node with no children is expanded for the first time,
+the load children callback of the tree view is called. If no children are
+loaded, the node is no longer marked as expandable and is not expanded.
+
+- If collapsing the node hides the highlighted node, the collapsed node
+becomes highlighted instead.
+
+Returns 'true' if the node was expanded or collapsed.
+*/
+func setTreeViewNodeExpanded(treeViewEntry *memory.TreeViewEntryType, nodeIndex int, isExpanded bool) bool {
+	nodeEntry := &treeViewEntry.Nodes[nodeIndex]
+	if !nodeEntry.IsExpandable || nodeEntry.IsExpanded == isExpanded {
+		return false
+	}
+	if isExpanded && !nodeEntry.IsChildrenLoaded {
+		nodeAlias := nodeEntry.NodeAlias
+		nodeEntry.IsChildrenLoaded = true
+		if treeViewEntry.LoadChildrenCallback != nil {
+			treeViewEntry.LoadChildrenCallback(nodeAlias)
+		}
+		// The callback may add nodes, so the node must be looked up again.
+		nodeIndex = getTreeViewNodeIndex(treeViewEntry, nodeAlias)
+		if nodeIndex == constants.NullSelectionIndex {
+			return false
+		}
+		nodeEntry = &treeViewEntry.Nodes[nodeIndex]
+		if !isTreeViewNodeParent(treeViewEntry, nodeAlias) {
+			nodeEntry.IsExpandable = false
+			return false
+		}
+	}
+	nodeEntry.IsExpanded = isExpanded
+	if !isExpanded && treeViewEntry.NodeHighlighted != nodeEntry.NodeAlias && isTreeViewNodeDescendant(treeViewEntry, treeViewEntry.NodeHighlighted, nodeEntry.NodeAlias) {
+		treeViewEntry.NodeHighlighted = nodeEntry.NodeAlias
+	}
+	updateTreeViewViewport(treeViewEntry)
+	return true
+}
+
+/*
+isTreeViewNodeParent allows you to detect if a tree view node has any
+children.
+*/
+func isTreeViewNodeParent(treeViewEntry *memory.TreeViewEntryType, nodeAlias string) bool {
+	for _, currentNodeEntry := range treeViewEntry.Nodes {
+		if currentNodeEntry.ParentAlias == nodeAlias {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+getTreeViewExpandEvents allows you to expand or collapse a tree view node
+for the user. The events which should be reported are returned. If
+collapsing the node caused the highlighted node to change, a change event
+is reported as well.
+*/
+func getTreeViewExpandEvents(controlEntry *memory.ControlEntryType, nodeIndex int, isExpanded bool) []memory.EventEntryType {
+	treeViewEntry := memory.GetTreeView(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	nodeHighlighted := treeViewEntry.NodeHighlighted
+	if !setTreeViewNodeExpanded(treeViewEntry, nodeIndex, isExpanded) {
+		return nil
+	}
+	var treeViewEvents []memory.EventEntryType
+	if nodeHighlighted != treeViewEntry.NodeHighlighted {
+		treeViewEvents = append(treeViewEvents, newControlEventEntry(controlEntry, constants.ControlActionChange))
+	}
+	if isExpanded {
+		return append(treeViewEvents, newControlEventEntry(controlEntry, constants.ControlActionExpand))
+	}
+	return append(treeViewEvents, newControlEventEntry(controlEntry, constants.ControlActionCollapse))
+}
+
+/*
+getTreeViewKeyEvents allows you to update a tree view with a key event. The
+events which should be reported in its place are returned. If the key is
+not used by the tree view, then the key event itself is returned.
+*/
+func getTreeViewKeyEvents(controlEntry *memory.ControlEntryType, eventEntry memory.EventEntryType) []memory.EventEntryType {
+	treeViewEntry := memory.GetTreeView(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	rows := getTreeViewRows(treeViewEntry)
+	rowIndex := getTreeViewRowIndex(treeViewEntry, rows, treeViewEntry.NodeHighlighted)
+	nodeIndex := getTreeViewNodeIndex(treeViewEntry, treeViewEntry.NodeHighlighted)
+	var treeViewEvents []memory.EventEntryType
+	isHighlightChanged := false
+	switch eventEntry.KeyEvent.Chord {
+	case "enter":
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionSubmit)}
+	case "esc":
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionCancel)}
+	case "up":
+		isHighlightChanged = highlightTreeViewRow(treeViewEntry, rows, rowIndex-1)
+	case "down":
+		isHighlightChanged = highlightTreeViewRow(treeViewEntry, rows, rowIndex+1)
+	case "pgup":
+		isHighlightChanged = highlightTreeViewRow(treeViewEntry, rows, rowIndex-treeViewEntry.Height)
+	case "pgdn":
+		isHighlightChanged = highlightTreeViewRow(treeViewEntry, rows, rowIndex+treeViewEntry.Height)
+	case "home":
+		isHighlightChanged = highlightTreeViewRow(treeViewEntry, rows, 0)
+	case "end":
+		isHighlightChanged = highlightTreeViewRow(treeViewEntry, rows, len(rows)-1)
+	case "right":
+		if nodeIndex == constants.NullSelectionIndex {
+			return nil
+		}
+		if treeViewEntry.Nodes[nodeIndex].IsExpanded {
+			isHighlightChanged = highlightTreeViewRow(treeViewEntry, rows, rowIndex+1)
+		} else {
+			treeViewEvents = getTreeViewExpandEvents(controlEntry, nodeIndex, true)
+		}
+	case "left":
+		if nodeIndex == constants.NullSelectionIndex {
+			return nil
+		}
+		if treeViewEntry.Nodes[nodeIndex].IsExpanded {
+			treeViewEvents = getTreeViewExpandEvents(controlEntry, nodeIndex, false)
+		} else if treeViewEntry.Nodes[nodeIndex].ParentAlias != "" {
+			isHighlightChanged = highlightTreeViewRow(treeViewEntry, rows, getTreeViewRowIndex(treeViewEntry, rows, treeViewEntry.Nodes[nodeIndex].ParentAlias))
+		}
+	case "space":
+		if nodeIndex == constants.NullSelectionIndex {
+			return nil
+		}
+		treeViewEvents = getTreeViewExpandEvents(controlEntry, nodeIndex, !treeViewEntry.Nodes[nodeIndex].IsExpanded)
+	default:
+		return []memory.EventEntryType{eventEntry}
+	}
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if isHighlightChanged {
+		return []memory.EventEntryType{newControlEventEntry(controlEntry, constants.ControlActionChange)}
+	}
+	return treeViewEvents
+}
+
+/*
+getTreeViewMouseEvents allows you to update a tree view with a mouse press
+at the specified location of its text layer. The events which should be
+reported are returned. In addition, the following information should be
+noted:
+
+- Pressing the scroll bar scrolls the tree view to the position pressed.
+
+- Pressing a node highlights it. If the '+' or '-' marker of the node was
+pressed, the node is also expanded or collapsed.
+*/
+func getTreeViewMouseEvents(controlEntry *memory.ControlEntryType, xLocation int, yLocation int) []memory.EventEntryType {
+	treeViewEntry := memory.GetTreeView(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	if isTreeViewScrollBarAtLocation(treeViewEntry, xLocation) {
+		scrollTreeViewByLocation(controlEntry, yLocation)
+		return nil
+	}
+	rows := getTreeViewRows(treeViewEntry)
+	rowIndex := getTreeViewRowAtLocation(treeViewEntry, rows, xLocation, yLocation)
+	if rowIndex == constants.NullSelectionIndex {
+		return nil
+	}
+	var treeViewEvents []memory.EventEntryType
+	if highlightTreeViewRow(treeViewEntry, rows, rowIndex) {
+		treeViewEvents = append(treeViewEvents, newControlEventEntry(controlEntry, constants.ControlActionChange))
+	}
+	nodeIndex := rows[rowIndex].nodeIndex
+	if xLocation == treeViewEntry.XLocation+len(rows[rowIndex].guides)-2 {
+		treeViewEvents = append(treeViewEvents, getTreeViewExpandEvents(controlEntry, nodeIndex, !treeViewEntry.Nodes[nodeIndex].IsExpanded)...)
+	}
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	return treeViewEvents
+}
+
+/*
+scrollTreeView allows you to scroll the nodes of a tree view without
+changing the node highlighted. The tree view will not scroll past its first
+or last visible node.
+*/
+func scrollTreeView(controlEntry *memory.ControlEntryType, numberOfRows int) {
+	treeViewEntry := memory.GetTreeView(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	numberOfVisibleRows := len(getTreeViewRows(treeViewEntry))
+	viewportPosition := treeViewEntry.ViewportPosition + numberOfRows
+	if viewportPosition > numberOfVisibleRows-treeViewEntry.Height {
+		viewportPosition = numberOfVisibleRows - treeViewEntry.Height
+	}
+	if viewportPosition < 0 {
+		viewportPosition = 0
+	}
+	treeViewEntry.ViewportPosition = viewportPosition
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+}
+
+/*
+scrollTreeViewByLocation allows you to scroll a tree view to the position
+represented by the specified row of its text layer on its scroll bar.
+*/
+func scrollTreeViewByLocation(controlEntry *memory.ControlEntryType, yLocation int) {
+	treeViewEntry := memory.GetTreeView(controlEntry.LayerAlias, controlEntry.ControlAlias)
+	treeViewEntry.ViewportPosition = getVerticalScrollBarViewportPosition(yLocation-treeViewEntry.YLocation, treeViewEntry.Height, len(getTreeViewRows(treeViewEntry)), treeViewEntry.Height)
+	markControlAsDirty(controlEntry.LayerAlias, controlEntry.ControlAlias)
+}
+
+/*
+isTreeViewScrollBarAtLocation allows you to detect if the specified column
+of a text layer falls on the scroll bar of a tree view. If the tree view
+has no scroll bar, then 'false' is always returned.
+*/
+func isTreeViewScrollBarAtLocation(treeViewEntry *memory.TreeViewEntryType, xLocation int) bool {
+	return xLocation == treeViewEntry.XLocation+treeViewEntry.Width-1 && len(getTreeViewRows(treeViewEntry)) > treeViewEntry.Height
+}
+
+/*
+getTreeViewRowAtLocation allows you to obtain the row of a tree view under
+the specified location of its text layer. If no row is at that location,
+then 'constants.NullSelectionIndex' is returned instead.
+*/
+func getTreeViewRowAtLocation(treeViewEntry *memory.TreeViewEntryType, rows []treeViewRowType, xLocation int, yLocation int) int {
+	rowIndex := treeViewEntry.ViewportPosition + yLocation - treeViewEntry.YLocation
+	if xLocation < treeViewEntry.XLocation || xLocation >= treeViewEntry.XLocation+treeViewEntry.Width ||
+		yLocation < treeViewEntry.YLocation || yLocation >= treeViewEntry.YLocation+treeViewEntry.Height ||
+		rowIndex >= len(rows) {
+		return constants.NullSelectionIndex
+	}
+	return rowIndex
+}
+
+/*
+drawTreeViewsOnLayer allows you to draw all tree views on a given text
+layer entry.
+*/
+func drawTreeViewsOnLayer(layerEntry memory.LayerEntryType) {
+	for _, currentTreeViewEntry := range memory.TreeViewMemory[layerEntry.LayerAlias] {
+		drawTreeView(&layerEntry, currentTreeViewEntry)
+	}
+}
+
+/*
+drawTreeView allows you to draw a tree view on a given text layer. In
+addition, the following information should be noted:
+
+- Only the nodes which fall inside the viewport of the tree view are drawn.
+Nodes too long to fit are truncated.
+
+- The label of the highlighted node is drawn using the highlight colors of
+the tree view style.
+*/
+func drawTreeView(layerEntry *memory.LayerEntryType, treeViewEntry *memory.TreeViewEntryType) {
+	styleEntry := treeViewEntry.StyleEntry
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.MenuForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.MenuBackgroundColor
+	highlightAttributeEntry := memory.NewAttributeEntry()
+	highlightAttributeEntry.ForegroundColor = styleEntry.HighlightForegroundColor
+	highlightAttributeEntry.BackgroundColor = styleEntry.HighlightBackgroundColor
+	rows := getTreeViewRows(treeViewEntry)
+	rowWidth := treeViewEntry.Width
+	if len(rows) > treeViewEntry.Height {
+		rowWidth--
+		drawVerticalScrollBar(layerEntry, styleEntry, treeViewEntry.XLocation+rowWidth, treeViewEntry.YLocation, treeViewEntry.Height, len(rows), treeViewEntry.Height, treeViewEntry.ViewportPosition)
+	}
+	fillArea(layerEntry, attributeEntry, " ", treeViewEntry.XLocation, treeViewEntry.YLocation, rowWidth, treeViewEntry.Height)
+	for currentRow := 0; currentRow < treeViewEntry.Height && treeViewEntry.ViewportPosition+currentRow < len(rows); currentRow++ {
+		row := rows[treeViewEntry.ViewportPosition+currentRow]
+		nodeEntry := treeViewEntry.Nodes[row.nodeIndex]
+		yLocation := treeViewEntry.YLocation + currentRow
+		guides := []rune(getTruncatedString(string(row.guides), rowWidth))
+		printLayer(layerEntry, attributeEntry, treeViewEntry.XLocation, yLocation, guides)
+		labelAttributeEntry := attributeEntry
+		if nodeEntry.NodeAlias == treeViewEntry.NodeHighlighted {
+			labelAttributeEntry = highlightAttributeEntry
+		}
+		column := len(guides)
+		for _, currentGraphemeCluster := range stringformat.GetGraphemeClusters([]rune(nodeEntry.Label)) {
+			graphemeClusterWidth := stringformat.GetGraphemeClusterWidth(currentGraphemeCluster)
+			if column+graphemeClusterWidth > rowWidth {
+				break
+			}
+			printLayer(layerEntry, labelAttributeEntry, treeViewEntry.XLocation+column, yLocation, currentGraphemeCluster)
+			column += graphemeClusterWidth
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestTreeView(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 12)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Form", 0, 0, 20, 12, 1, "")
+	var loadedNodes []string
+	AddTreeView("Form", "Files", NewTuiStyleEntry(), 0, 0, 16, 4, func(nodeAlias string) {
+		loadedNodes = append(loadedNodes, nodeAlias)
+		if nodeAlias == "photos" {
+			for currentIndex := 1; currentIndex <= 3; currentIndex++ {
+				AddTreeViewNode("Form", "Files", "photos", fmt.Sprintf("photo%d", currentIndex), fmt.Sprintf("img%d.png", currentIndex), false)
+			}
+		}
+	})
+	AddTreeViewNode("Form", "Files", "", "documents", "Documents", false)
+	AddTreeViewNode("Form", "Files", "documents", "report", "report.txt", false)
+	AddTreeViewNode("Form", "Files", "documents", "photos", "Photos", true)
+	AddTreeViewNode("Form", "Files", "", "empty", "Empty", true)
+	AddTreeViewNode("Form", "Files", "", "notes", "notes.txt", false)
+	injectKey := func(key tcell.Key, character rune, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventKey(key, character, modifierMask))
+	}
+	injectMouse := func(xLocation int, yLocation int, buttonMask tcell.ButtonMask, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventMouse(xLocation, yLocation, buttonMask, modifierMask))
+	}
+	getControlActions := func(numberOfActions int) []int {
+		var controlActions []int
+		for len(controlActions) < numberOfActions {
+			if eventEntry := WaitForEvent(); eventEntry.EventType == constants.EventTypeControl {
+				controlActions = append(controlActions, eventEntry.ControlEvent.Action)
+			}
+		}
+		return controlActions
+	}
+	getScreenRows := func(numberOfRows int) []string {
+		UpdateDisplay()
+		cells, width, _ := simulationScreen.GetContents()
+		var screenRows []string
+		for currentRow := 0; currentRow < numberOfRows; currentRow++ {
+			screenRow := ""
+			for currentColumn := 0; currentColumn < 16; currentColumn++ {
+				screenRow += string(cells[currentRow*width+currentColumn].Runes)
+			}
+			screenRows = append(screenRows, screenRow)
+		}
+		return screenRows
+	}
+	assert.Equalf(test, []string{"├─+ Documents   ", "├─+ Empty       ", "└── notes.txt   ", "                "}, getScreenRows(4), "The collapsed tree view was not drawn correctly!")
+	assert.Equalf(test, "documents", GetTreeViewSelection("Form", "Files"), "The first node added was not highlighted!")
+	SetFocus("Form", "Files")
+	injectKey(tcell.KeyRight, 0, tcell.ModNone)
+	injectKey(tcell.KeyRight, 0, tcell.ModNone)
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	injectKey(tcell.KeyRune, ' ', tcell.ModNone)
+	injectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionExpand, constants.ControlActionChange, constants.ControlActionChange, constants.ControlActionExpand, constants.ControlActionSubmit}, getControlActions(5), "Expanding nodes with the keyboard did not work!")
+	assert.Equalf(test, []interface{}{"photos", []string{"photos"}, 0}, []interface{}{GetTreeViewSelection("Form", "Files"), loadedNodes, memory.GetTreeView("Form", "Files").ViewportPosition}, "The children of a node were not loaded!")
+	assert.Equalf(test, []string{"├─- Documents  █", "│ ├── report.tx█", "│ └─- Photos   ░", "│   ├── img1.pn░"}, getScreenRows(4), "The expanded tree view was not drawn correctly!")
+	injectKey(tcell.KeyDown, 0, tcell.ModNone)
+	injectKey(tcell.KeyLeft, 0, tcell.ModNone)
+	injectKey(tcell.KeyLeft, 0, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange, constants.ControlActionChange, constants.ControlActionCollapse}, getControlActions(3), "Moving to the parent node and collapsing it did not work!")
+	injectKey(tcell.KeyEnd, 0, tcell.ModNone)
+	injectKey(tcell.KeyUp, 0, tcell.ModNone)
+	injectKey(tcell.KeyRight, 0, tcell.ModNone)
+	injectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange, constants.ControlActionChange, constants.ControlActionCancel}, getControlActions(3), "Expanding a node without children should not report an event!")
+	assert.Equalf(test, []interface{}{[]string{"photos", "empty"}, false}, []interface{}{loadedNodes, memory.GetTreeView("Form", "Files").Nodes[3].IsExpandable}, "A node without children was left expandable!")
+	SetTreeViewNodeExpanded("Form", "Files", "documents", false)
+	assert.Equalf(test, []string{"├─+ Documents   ", "├── Empty       ", "└── notes.txt   ", "                "}, getScreenRows(4), "Collapsing a node did not hide its children!")
+	injectMouse(2, 0, tcell.Button1, tcell.ModNone)
+	injectMouse(2, 0, tcell.ButtonNone, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange, constants.ControlActionExpand}, getControlActions(2), "Clicking the marker of a node did not expand it!")
+	injectMouse(6, 1, tcell.Button1, tcell.ModNone)
+	injectMouse(6, 1, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(6, 1, tcell.Button1, tcell.ModNone)
+	injectMouse(6, 1, tcell.ButtonNone, tcell.ModNone)
+	assert.Equalf(test, []int{constants.ControlActionChange, constants.ControlActionSubmit}, getControlActions(2), "Double clicking a node did not submit the tree view!")
+	assert.Equalf(test, []interface{}{"report", false}, []interface{}{GetTreeViewSelection("Form", "Files"), IsTreeViewNodeExpanded("Form", "Files", "photos")}, "Clicking a node did not highlight it, or a collapsed node was expanded!")
+	SetTreeViewSelection("Form", "Files", "photo3")
+	assert.Truef(test, IsTreeViewNodeExpanded("Form", "Files", "photos"), "Selecting a hidden node did not expand its parent!")
+	DeleteTreeViewNode("Form", "Files", "photos")
+	assert.Equalf(test, []interface{}{"report", 4}, []interface{}{GetTreeViewSelection("Form", "Files"), len(memory.GetTreeView("Form", "Files").Nodes)}, "Deleting a node did not remove its children or move the highlight!")
+	assert.Panicsf(test, func() { AddTreeViewNode("Form", "Files", "missing", "child", "Child", false) }, "Adding a node to a parent which does not exist did not panic!")
+	DeleteTreeView("Form", "Files")
+	assert.Falsef(test, memory.IsControlExists("Form", "Files"), "The tree view was not deleted!")
+}
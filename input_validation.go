@@ -0,0 +1,594 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"time"
+	"unicode"
+)
+
+/*
+These constants represent the characters of an input mask which accept
+input, and the formats used by date validators.
+*/
+const (
+	maskDigitCharacter  = '9'
+	maskLetterCharacter = 'A'
+	maskAnyCharacter    = '*'
+	dateInputMask       = "9999-99-99"
+	dateInputFormat     = "2006-01-02"
+	dateInputTemplate   = "2000-01-01"
+)
+
+/*
+numericInputExpression matches a number which is still being typed, with an
+optional leading '-' sign and a single decimal point.
+*/
+var numericInputExpression = regexp.MustCompile(`^-?[0-9]*\.?[0-9]*$`)
+
+/*
+integerInputExpression matches a whole number which is still being typed,
+with an optional leading '-' sign.
+*/
+var integerInputExpression = regexp.MustCompile(`^-?[0-9]*$`)
+
+/*
+NewNumericValidator allows you to create an input validator which only
+accepts numbers. An optional leading '-' sign and a single decimal point
+are allowed.
+*/
+func NewNumericValidator() memory.InputValidatorEntryType {
+	validatorEntry := memory.NewInputValidatorEntry()
+	validatorEntry.ValidatorType = constants.ValidatorTypeNumeric
+	return validatorEntry
+}
+
+/*
+NewIntegerRangeValidator allows you to create an input validator which only
+accepts whole numbers between the minimum and maximum values specified,
+inclusive. In addition, the following information should be noted:
+
+- Keystrokes are rejected as soon as the number entered can no longer fall
+inside the range. For example, with a maximum of 50, typing '6' is allowed
+but typing another digit after it is not.
+
+- If the minimum value is greater than the maximum value, a panic will be
+generated to fail as fast as possible.
+*/
+func NewIntegerRangeValidator(minimumValue int, maximumValue int) memory.InputValidatorEntryType {
+	if minimumValue > maximumValue {
+		panic(fmt.Sprintf("The specified integer range of '%d' to '%d' is invalid!", minimumValue, maximumValue))
+	}
+	validatorEntry := memory.NewInputValidatorEntry()
+	validatorEntry.ValidatorType = constants.ValidatorTypeIntegerRange
+	validatorEntry.MinimumValue = minimumValue
+	validatorEntry.MaximumValue = maximumValue
+	return validatorEntry
+}
+
+/*
+NewRegexValidator allows you to create an input validator which only
+accepts values matching the regular expression specified. In addition, the
+following information should be noted:
+
+- Keystrokes are rejected as soon as the value entered can no longer lead
+to a match, no matter what is typed after it. For example, with the
+pattern '^[a-z]+@[a-z]+$', typing a digit or a second '@' is rejected.
+
+- Like 'regexp.MatchString', a pattern which is not anchored with '^' may
+match anywhere in the value. Since such a match can always be typed later,
+keystrokes are never rejected for these patterns. Instead, the value is
+only checked when the user attempts to submit it.
+
+- If the regular expression cannot be compiled, a panic will be generated
+to fail as fast as possible.
+*/
+func NewRegexValidator(pattern string) memory.InputValidatorEntryType {
+	compiledPattern, err := regexp.Compile(pattern)
+	if err != nil {
+		panic(fmt.Sprintf("The specified regular expression '%s' is invalid: %s", pattern, err))
+	}
+	validatorEntry := memory.NewInputValidatorEntry()
+	validatorEntry.ValidatorType = constants.ValidatorTypeRegex
+	validatorEntry.Pattern = pattern
+	validatorEntry.CompiledPattern = compiledPattern
+	validatorEntry.PartialPattern = getPartialRegexPattern(pattern)
+	return validatorEntry
+}
+
+/*
+NewMaskValidator allows you to create an input validator which only accepts
+values matching a fixed pattern, such as a phone number. In addition, the
+following information should be noted:
+
+- In a mask, the character '9' accepts a digit, 'A' accepts a letter, and
+'*' accepts any character. All other characters are literals which are
+inserted automatically as the user types. For example, the mask
+'(999) 999-9999' accepts a phone number.
+
+- A value is only complete once every character of the mask is filled.
+
+- If the mask is empty, a panic will be generated to fail as fast as
+possible.
+*/
+func NewMaskValidator(mask string) memory.InputValidatorEntryType {
+	if mask == "" {
+		panic("The specified input mask is empty!")
+	}
+	validatorEntry := memory.NewInputValidatorEntry()
+	validatorEntry.ValidatorType = constants.ValidatorTypeMask
+	validatorEntry.Pattern = mask
+	return validatorEntry
+}
+
+/*
+NewDateValidator allows you to create an input validator which only accepts
+dates in the format 'YYYY-MM-DD'. The dashes are inserted automatically,
+and keystrokes which could not produce a real calendar date are rejected.
+*/
+func NewDateValidator() memory.InputValidatorEntryType {
+	validatorEntry := memory.NewInputValidatorEntry()
+	validatorEntry.ValidatorType = constants.ValidatorTypeDate
+	validatorEntry.Pattern = dateInputMask
+	return validatorEntry
+}
+
+/*
+NewCustomValidator allows you to create an input validator which uses your
+own validation function. In addition, the following information should be
+noted:
+
+- Your function is called with 'isValueComplete' set to 'false' every time
+the user types a character. Returning 'false' rejects the keystroke.
+
+- When the user attempts to submit the value, your function is called again
+with 'isValueComplete' set to 'true'. Returning 'false' prevents the value
+from being submitted.
+
+- If the validation function is 'nil', a panic will be generated to fail as
+fast as possible.
+*/
+func NewCustomValidator(validationFunction func(value string, isValueComplete bool) bool) memory.InputValidatorEntryType {
+	if validationFunction == nil {
+		panic("The specified validation function is nil!")
+	}
+	validatorEntry := memory.NewInputValidatorEntry()
+	validatorEntry.ValidatorType = constants.ValidatorTypeCustom
+	validatorEntry.ValidationFunction = validationFunction
+	return validatorEntry
+}
+
+/*
+GetValidatedInput allows you to obtain keyboard input from the user which
+is checked by an input validator. It behaves exactly like 'GetInput',
+except that in addition, the following information should be noted:
+
+- Keystrokes which would make the value invalid are rejected as they are
+typed, and the input field is drawn using the error colors of your style
+entry until the next accepted keystroke.
+
+- Pressing 'enter' only returns the value once it is complete and valid.
+Otherwise, the input field is drawn using the error colors and the user may
+continue editing.
+
+- The default value is not checked, so it should already be in the format
+expected by your validator.
+*/
+func GetValidatedInput(layerAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, maxLengthAllowed int, IsPasswordProtected bool, validatorEntry memory.InputValidatorEntryType, defaultValue string) string {
+	if maxLengthAllowed <= 0 {
+		panic(fmt.Sprintf("The specified maximum input length of '%d' is invalid!", maxLengthAllowed))
+	}
+	textFieldEntry := memory.NewTextFieldEntry()
+	textFieldEntry.StyleEntry = styleEntry
+	textFieldEntry.XLocation = xLocation
+	textFieldEntry.YLocation = yLocation
+	textFieldEntry.Width = width
+	textFieldEntry.MaxLengthAllowed = maxLengthAllowed
+	textFieldEntry.IsPasswordProtected = IsPasswordProtected
+	textFieldEntry.IsFocused = true
+	textFieldEntry.Validator = validatorEntry
+	setTextFieldValue(&textFieldEntry, defaultValue)
+	return getInput(layerAlias, &textFieldEntry)
+}
+
+/*
+getInput allows you to obtain keyboard input from the user with a text
+field entry which is drawn directly on a text layer. This method blocks
+until the user presses 'enter' and the value entered is valid. In
+addition, the following information should be noted:
+
+- Keystrokes used by the completion list or history of the text field are
+handled before any editing takes place.
+
+- If the text field has a completion provider, the area beneath it is
+saved before input starts and restored every time the completion list is
+redrawn, as well as once input is complete.
+
+- While no keystrokes are pending, this method waits for the next event to
+arrive instead of polling, and the event channel is paused until input is
+complete.
+*/
+func getInput(layerAlias string, textFieldEntry *memory.TextFieldEntryType) string {
+	layerEntry := memory.GetLayer(layerAlias)
+	var savedLayerEntry memory.LayerEntryType
+	if textFieldEntry.CompletionProvider != nil {
+		savedLayerEntry = memory.NewLayerEntry(0, 0, layerEntry)
+	}
+	isScreenUpdateRequired := true
+	pauseEventChannel()
+	defer resumeEventChannel()
+	notificationChannel := memory.EventMemory.GetNotificationChannel()
+	for {
+		mouseXLocation, mouseYLocation, mouseButtonPressed, _ := memory.MouseMemory.GetMouseStatus()
+		mouseCellIdentifier := getCellIdByLayerAlias(layerAlias, mouseXLocation, mouseYLocation)
+		if mouseCellIdentifier != constants.NullCellId {
+			if mouseButtonPressed == 1 {
+				itemSelected := getTextFieldCompletionAtLocation(textFieldEntry, mouseYLocation-layerEntry.ScreenYLocation)
+				if itemSelected != constants.NullSelectionIndex {
+					acceptTextFieldCompletion(textFieldEntry, itemSelected)
+				} else {
+					textFieldEntry.CursorPosition = getTextFieldCursorAtColumn(textFieldEntry, mouseCellIdentifier)
+					updateTextFieldViewport(textFieldEntry)
+				}
+				isScreenUpdateRequired = true
+			}
+		}
+		currentKeyPressed := Inkey()
+		previousValue := textFieldEntry.CurrentValue
+		if currentKeyPressed != "" && updateTextFieldCompletion(textFieldEntry, currentKeyPressed) {
+			isScreenUpdateRequired = true
+		} else if currentKeyPressed == "enter" {
+			if isInputValueValid(&textFieldEntry.Validator, textFieldEntry.CurrentValue) {
+				break
+			}
+			textFieldEntry.IsErrorDisplayed = true
+			isScreenUpdateRequired = true
+		} else if currentKeyPressed != "" && updateTextFieldValue(textFieldEntry, currentKeyPressed) {
+			if textFieldEntry.CurrentValue != previousValue {
+				updateTextFieldCompletionItems(textFieldEntry)
+			}
+			isScreenUpdateRequired = true
+		}
+		if isScreenUpdateRequired {
+			if textFieldEntry.CompletionProvider != nil {
+				restoreLayerArea(layerEntry, &savedLayerEntry, textFieldEntry.XLocation, textFieldEntry.YLocation+1, textFieldEntry.Width, textFieldCompletionListHeight)
+			}
+			drawTextField(layerEntry, textFieldEntry)
+			UpdateDisplay()
+			isScreenUpdateRequired = false
+		}
+		if currentKeyPressed == "" {
+			<-notificationChannel
+		}
+	}
+	if textFieldEntry.CompletionProvider != nil {
+		restoreLayerArea(layerEntry, &savedLayerEntry, textFieldEntry.XLocation, textFieldEntry.YLocation+1, textFieldEntry.Width, textFieldCompletionListHeight)
+		UpdateDisplay()
+	}
+	return textFieldEntry.CurrentValue
+}
+
+/*
+GetIntegerInput allows you to obtain a whole number from the user. Only
+numbers between the minimum and maximum values specified, inclusive, can
+be entered. The input field is pre-populated with the default value
+provided. If the minimum value is greater than the maximum value, a panic
+will be generated to fail as fast as possible.
+*/
+func GetIntegerInput(layerAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, minimumValue int, maximumValue int, defaultValue int) int {
+	maxLengthAllowed := len(strconv.Itoa(minimumValue))
+	if len(strconv.Itoa(maximumValue)) > maxLengthAllowed {
+		maxLengthAllowed = len(strconv.Itoa(maximumValue))
+	}
+	validatorEntry := NewIntegerRangeValidator(minimumValue, maximumValue)
+	obtainedValue, _ := strconv.Atoi(GetValidatedInput(layerAlias, styleEntry, xLocation, yLocation, width, maxLengthAllowed, false, validatorEntry, strconv.Itoa(defaultValue)))
+	return obtainedValue
+}
+
+/*
+GetNumericInput allows you to obtain a number from the user, which may
+include a decimal point. The input field is pre-populated with the default
+value provided. If the max length of your input field is less than or equal
+to 0, a panic will be generated to fail as fast as possible.
+*/
+func GetNumericInput(layerAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, maxLengthAllowed int, defaultValue float64) float64 {
+	obtainedValue, _ := strconv.ParseFloat(GetValidatedInput(layerAlias, styleEntry, xLocation, yLocation, width, maxLengthAllowed, false, NewNumericValidator(), strconv.FormatFloat(defaultValue, 'f', -1, 64)), 64)
+	return obtainedValue
+}
+
+/*
+GetDateInput allows you to obtain a date from the user in the format
+'YYYY-MM-DD'. In addition, the following information should be noted:
+
+- The input field is always wide enough to show the entire date and the
+cursor.
+
+- If the default value provided is the zero time, then the input field
+starts empty. Otherwise, it is pre-populated with the default date.
+
+- The date returned is in UTC.
+*/
+func GetDateInput(layerAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, defaultValue time.Time) time.Time {
+	defaultText := ""
+	if !defaultValue.IsZero() {
+		defaultText = defaultValue.Format(dateInputFormat)
+	}
+	obtainedValue, _ := time.Parse(dateInputFormat, GetValidatedInput(layerAlias, styleEntry, xLocation, yLocation, len(dateInputMask)+1, len(dateInputMask), false, NewDateValidator(), defaultText))
+	return obtainedValue
+}
+
+/*
+getValidatedInputValue allows you to replace the grapheme clusters between
+the start and end index provided with new text, while respecting an input
+validator. The updated value and cursor position are returned, along with
+'false' if the edit was rejected. In addition, the following information
+should be noted:
+
+- Edits which only remove text are never rejected for being incomplete.
+
+- For masks, the literal characters of the mask are removed before the
+edit takes place and inserted again afterwards. When typing at the end of
+the value, any literals which follow are inserted automatically.
+*/
+func getValidatedInputValue(validatorEntry *memory.InputValidatorEntryType, graphemeClusters [][]rune, startIndex int, endIndex int, text string) (string, int, bool) {
+	isInsertion := text != ""
+	if validatorEntry.ValidatorType == constants.ValidatorTypeMask || validatorEntry.ValidatorType == constants.ValidatorTypeDate {
+		leadingText := getUnmaskedInputValue(validatorEntry.Pattern, graphemeClusters[:startIndex], 0) + text
+		trailingText := getUnmaskedInputValue(validatorEntry.Pattern, graphemeClusters[endIndex:], endIndex)
+		maskedLeadingText, isLeadingTextValid := getMaskedInputValue(validatorEntry.Pattern, leadingText, isInsertion)
+		maskedValue, isValueValid := getMaskedInputValue(validatorEntry.Pattern, leadingText+trailingText, isInsertion && trailingText == "")
+		if !isLeadingTextValid || !isValueValid || (isInsertion && !isInputValueAllowed(validatorEntry, maskedValue)) {
+			return "", 0, false
+		}
+		return maskedValue, len(stringformat.GetGraphemeClusters([]rune(maskedLeadingText))), true
+	}
+	leadingText := getStringFromGraphemeClusters(graphemeClusters[:startIndex]) + text
+	updatedValue := leadingText + getStringFromGraphemeClusters(graphemeClusters[endIndex:])
+	if isInsertion && !isInputValueAllowed(validatorEntry, updatedValue) {
+		return "", 0, false
+	}
+	return updatedValue, len(stringformat.GetGraphemeClusters([]rune(leadingText))), true
+}
+
+/*
+isInputValueAllowed allows you to determine if a value which is still being
+typed could become valid for an input validator. This is used to reject
+keystrokes as soon as they are typed.
+*/
+func isInputValueAllowed(validatorEntry *memory.InputValidatorEntryType, value string) bool {
+	switch validatorEntry.ValidatorType {
+	case constants.ValidatorTypeNumeric:
+		return numericInputExpression.MatchString(value)
+	case constants.ValidatorTypeIntegerRange:
+		if !integerInputExpression.MatchString(value) {
+			return false
+		}
+		if value == "-" {
+			return validatorEntry.MinimumValue < 0
+		}
+		number, err := strconv.Atoi(value)
+		if value == "" || err != nil {
+			return value == ""
+		}
+		if number < 0 {
+			return number >= validatorEntry.MinimumValue
+		}
+		return number <= validatorEntry.MaximumValue
+	case constants.ValidatorTypeRegex:
+		return validatorEntry.PartialPattern == nil || validatorEntry.CompiledPattern.MatchString(value) || validatorEntry.PartialPattern.MatchString(value)
+	case constants.ValidatorTypeMask:
+		_, isValid := getMaskedInputValue(validatorEntry.Pattern, value, false)
+		return isValid
+	case constants.ValidatorTypeDate:
+		return isPartialDateAllowed(value)
+	case constants.ValidatorTypeCustom:
+		return validatorEntry.ValidationFunction(value, false)
+	}
+	return true
+}
+
+/*
+isInputValueValid allows you to determine if a value is complete and valid
+for an input validator, so that it can be submitted.
+*/
+func isInputValueValid(validatorEntry *memory.InputValidatorEntryType, value string) bool {
+	switch validatorEntry.ValidatorType {
+	case constants.ValidatorTypeNumeric:
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	case constants.ValidatorTypeIntegerRange:
+		number, err := strconv.Atoi(value)
+		return err == nil && number >= validatorEntry.MinimumValue && number <= validatorEntry.MaximumValue
+	case constants.ValidatorTypeRegex:
+		return validatorEntry.CompiledPattern.MatchString(value)
+	case constants.ValidatorTypeMask:
+		maskedValue, isValid := getMaskedInputValue(validatorEntry.Pattern, value, false)
+		return isValid && len(stringformat.GetGraphemeClusters([]rune(maskedValue))) == len([]rune(validatorEntry.Pattern))
+	case constants.ValidatorTypeDate:
+		_, err := time.Parse(dateInputFormat, value)
+		return err == nil
+	case constants.ValidatorTypeCustom:
+		return validatorEntry.ValidationFunction(value, true)
+	}
+	return true
+}
+
+/*
+getPartialRegexPattern allows you to obtain a regular expression which
+matches every value that could still be completed into a match of the
+pattern provided, as it is typed. In addition, the following information
+should be noted:
+
+- The expression returned matches the entire value against a non-empty
+prefix of some string accepted by the pattern. Values which already match
+the pattern should be checked separately.
+
+- If the pattern is not anchored to the start of the value, then 'nil' is
+returned, since any value could still be followed by a match.
+*/
+func getPartialRegexPattern(pattern string) *regexp.Regexp {
+	regexEntry, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		panic(fmt.Sprintf("The specified regular expression '%s' is invalid: %s", pattern, err))
+	}
+	regexEntry = regexEntry.Simplify()
+	program, err := syntax.Compile(regexEntry)
+	if err != nil {
+		panic(fmt.Sprintf("The specified regular expression '%s' is invalid: %s", pattern, err))
+	}
+	if program.StartCond()&syntax.EmptyBeginText == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`\A(?:` + getRegexPrefixEntry(regexEntry).String() + `)\z`)
+}
+
+/*
+getRegexPrefixEntry allows you to obtain a parsed regular expression which
+matches every non-empty prefix of the strings matched by the one provided.
+In addition, the following information should be noted:
+
+- Empty-width assertions such as '^' or '\b' can never start a non-empty
+prefix on their own. When they appear between other expressions, they are
+kept, so that they are only checked once the character which follows them
+has been typed.
+
+- The regular expression provided must already be simplified, so that it
+contains no counted repetitions.
+*/
+func getRegexPrefixEntry(regexEntry *syntax.Regexp) *syntax.Regexp {
+	var alternatives []*syntax.Regexp
+	switch regexEntry.Op {
+	case syntax.OpLiteral:
+		for currentLength := 1; currentLength <= len(regexEntry.Rune); currentLength++ {
+			alternatives = append(alternatives, &syntax.Regexp{Op: syntax.OpLiteral, Flags: regexEntry.Flags, Rune: regexEntry.Rune[:currentLength]})
+		}
+	case syntax.OpCharClass, syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		return regexEntry
+	case syntax.OpCapture, syntax.OpQuest:
+		return getRegexPrefixEntry(regexEntry.Sub[0])
+	case syntax.OpStar, syntax.OpPlus:
+		repeatedEntry := &syntax.Regexp{Op: syntax.OpStar, Flags: regexEntry.Flags, Sub: []*syntax.Regexp{regexEntry.Sub[0]}}
+		return &syntax.Regexp{Op: syntax.OpConcat, Sub: []*syntax.Regexp{repeatedEntry, getRegexPrefixEntry(regexEntry.Sub[0])}}
+	case syntax.OpConcat:
+		for currentIndex, currentSubEntry := range regexEntry.Sub {
+			completedEntries := append([]*syntax.Regexp{}, regexEntry.Sub[:currentIndex]...)
+			alternatives = append(alternatives, &syntax.Regexp{Op: syntax.OpConcat, Sub: append(completedEntries, getRegexPrefixEntry(currentSubEntry))})
+		}
+	case syntax.OpAlternate:
+		for _, currentSubEntry := range regexEntry.Sub {
+			alternatives = append(alternatives, getRegexPrefixEntry(currentSubEntry))
+		}
+	}
+	if len(alternatives) == 0 {
+		return &syntax.Regexp{Op: syntax.OpNoMatch}
+	}
+	return &syntax.Regexp{Op: syntax.OpAlternate, Sub: alternatives}
+}
+
+/*
+isPartialDateAllowed allows you to determine if a date which is still being
+typed could become a real calendar date. If the month or day is half
+typed, every possible next digit is tried. Any fields which have not been
+typed yet are completed using a template date.
+*/
+func isPartialDateAllowed(value string) bool {
+	candidateValues := []string{value}
+	if len(value) == 6 || len(value) == 9 {
+		candidateValues = nil
+		for currentDigit := '0'; currentDigit <= '9'; currentDigit++ {
+			candidateValues = append(candidateValues, value+string(currentDigit))
+		}
+	}
+	for _, currentCandidateValue := range candidateValues {
+		if len(currentCandidateValue) > len(dateInputTemplate) {
+			return false
+		}
+		if _, err := time.Parse(dateInputFormat, currentCandidateValue+dateInputTemplate[len(currentCandidateValue):]); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+getMaskedInputValue allows you to apply an input mask to a value. The
+masked value is returned, along with 'false' if the value does not fit the
+mask. In addition, the following information should be noted:
+
+- Literal characters of the mask which are missing from the value are
+inserted automatically. If the value already contains the literal, it is
+kept as is.
+
+- If requested, literal characters which follow the last character of the
+value are also appended, so that the cursor skips over them while typing.
+*/
+func getMaskedInputValue(mask string, value string, isTrailingLiteralAppended bool) (string, bool) {
+	maskRunes := []rune(mask)
+	var maskedGraphemeClusters [][]rune
+	maskIndex := 0
+	for _, currentGraphemeCluster := range stringformat.GetGraphemeClusters([]rune(value)) {
+		for maskIndex < len(maskRunes) && !isMaskPlaceholder(maskRunes[maskIndex]) && string(currentGraphemeCluster) != string(maskRunes[maskIndex]) {
+			maskedGraphemeClusters = append(maskedGraphemeClusters, []rune{maskRunes[maskIndex]})
+			maskIndex++
+		}
+		if maskIndex >= len(maskRunes) {
+			return value, false
+		}
+		if isMaskPlaceholder(maskRunes[maskIndex]) && !isRuneMatchingMask(maskRunes[maskIndex], currentGraphemeCluster[0]) {
+			return value, false
+		}
+		maskedGraphemeClusters = append(maskedGraphemeClusters, currentGraphemeCluster)
+		maskIndex++
+	}
+	for isTrailingLiteralAppended && maskIndex < len(maskRunes) && !isMaskPlaceholder(maskRunes[maskIndex]) {
+		maskedGraphemeClusters = append(maskedGraphemeClusters, []rune{maskRunes[maskIndex]})
+		maskIndex++
+	}
+	return getStringFromGraphemeClusters(maskedGraphemeClusters), true
+}
+
+/*
+getUnmaskedInputValue allows you to remove the literal characters of an
+input mask from a masked value. Since the value may be only part of a
+larger masked value, the mask position of its first grapheme cluster must
+be provided.
+*/
+func getUnmaskedInputValue(mask string, graphemeClusters [][]rune, startIndex int) string {
+	maskRunes := []rune(mask)
+	var unmaskedGraphemeClusters [][]rune
+	for currentIndex, currentGraphemeCluster := range graphemeClusters {
+		maskIndex := startIndex + currentIndex
+		if maskIndex < len(maskRunes) && !isMaskPlaceholder(maskRunes[maskIndex]) {
+			continue
+		}
+		unmaskedGraphemeClusters = append(unmaskedGraphemeClusters, currentGraphemeCluster)
+	}
+	return getStringFromGraphemeClusters(unmaskedGraphemeClusters)
+}
+
+/*
+isMaskPlaceholder allows you to determine if a character of an input mask
+accepts input, rather than being a literal.
+*/
+func isMaskPlaceholder(maskCharacter rune) bool {
+	return maskCharacter == maskDigitCharacter || maskCharacter == maskLetterCharacter || maskCharacter == maskAnyCharacter
+}
+
+/*
+isRuneMatchingMask allows you to determine if a character is accepted by
+the specified placeholder of an input mask.
+*/
+func isRuneMatchingMask(maskCharacter rune, character rune) bool {
+	switch maskCharacter {
+	case maskDigitCharacter:
+		return unicode.IsDigit(character)
+	case maskLetterCharacter:
+		return unicode.IsLetter(character)
+	}
+	return true
+}
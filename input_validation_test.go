@@ -0,0 +1,53 @@
+package dosktop
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/internal/memory"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidatedInput(test *testing.T) {
+	commonResource.isDebugEnabled = true
+	InitializeTerminal(20, 3)
+	AddLayer("Layer1", 0, 0, 20, 3, 1, "")
+	styleEntry := memory.NewTuiStyleEntry()
+	memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer("backspace", "a", "enter", "6", "0", "backspace", "4", "2", "enter")
+	assert.Equalf(test, 42, GetIntegerInput("Layer1", styleEntry, 2, 1, 6, -5, 50, 0), "Integers outside of the range allowed were not rejected!")
+	memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer("backspace", "-", "1", ".", "5", ".", "enter")
+	assert.Equalf(test, -1.5, GetNumericInput("Layer1", styleEntry, 2, 1, 6, 6, 0), "A number with more than one decimal point was not rejected!")
+	memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer("2", "0", "2", "4", "1", "3", "2", "3", "2", "1", "enter")
+	assert.Equalf(test, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), GetDateInput("Layer1", styleEntry, 2, 1, time.Time{}), "Dates which do not exist were not rejected!")
+	memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer("5", "5", "x", "5", "1", "2", "backspace", "2", "3", "enter", "4", "5", "6", "7", "enter")
+	obtainedValue := GetValidatedInput("Layer1", styleEntry, 2, 1, 15, 14, false, NewMaskValidator("(999) 999-9999"), "")
+	assert.Equalf(test, "(555) 123-4567", obtainedValue, "The input mask did not insert its literals or reject invalid characters!")
+	memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer("1", "a", "@", "@", "enter", "b", "enter")
+	obtainedValue = GetValidatedInput("Layer1", styleEntry, 2, 1, 10, 10, false, NewRegexValidator("^[a-z]+@[a-z]+$"), "")
+	assert.Equalf(test, "a@b", obtainedValue, "A value not matching the regular expression was submitted!")
+	regexValidatorEntry := NewRegexValidator(`(?i)^(abc|x\d{2})\b`)
+	obtainedResult := []bool{isInputValueAllowed(&regexValidatorEntry, "AB"), isInputValueAllowed(&regexValidatorEntry, "x1"), isInputValueAllowed(&regexValidatorEntry, "abc d"), isInputValueAllowed(&regexValidatorEntry, "abd"), isInputValueAllowed(&regexValidatorEntry, "x1a"), isInputValueAllowed(&regexValidatorEntry, "abcd")}
+	assert.Equalf(test, []bool{true, true, true, false, false, false}, obtainedResult, "Keystrokes which could no longer lead to a match of the regular expression were not rejected!")
+	regexValidatorEntry = NewRegexValidator("[0-9]")
+	assert.Truef(test, isInputValueAllowed(&regexValidatorEntry, "abc"), "A keystroke was rejected even though the unanchored regular expression could still match later!")
+	isUpperCase := func(value string, isValueComplete bool) bool {
+		return value == strings.ToUpper(value) && (!isValueComplete || len(value) == 2)
+	}
+	memory.KeyboardMemory.AddKeystrokeToKeyboardBuffer("A", "b", "enter", "C", "enter")
+	obtainedValue = GetValidatedInput("Layer1", styleEntry, 2, 1, 10, 10, false, NewCustomValidator(isUpperCase), "")
+	assert.Equalf(test, "AC", obtainedValue, "The custom validator was not used!")
+	textFieldEntry := memory.NewTextFieldEntry()
+	textFieldEntry.StyleEntry = styleEntry
+	textFieldEntry.Width = 5
+	textFieldEntry.MaxLengthAllowed = 5
+	textFieldEntry.Validator = NewNumericValidator()
+	updateTextFieldValue(&textFieldEntry, "x")
+	drawTextField(memory.GetLayer("Layer1"), &textFieldEntry)
+	assert.Equalf(test, []interface{}{true, styleEntry.TextInputErrorBackgroundColor}, []interface{}{textFieldEntry.IsErrorDisplayed, memory.GetLayer("Layer1").CharacterMemory[0][1].AttributeEntry.BackgroundColor}, "A rejected keystroke was not drawn using the error colors!")
+	updateTextFieldValue(&textFieldEntry, "1")
+	assert.Falsef(test, textFieldEntry.IsErrorDisplayed, "An accepted keystroke did not clear the error colors!")
+	assert.Panicsf(test, func() { NewRegexValidator("(") }, "An invalid regular expression did not panic!")
+	assert.Panicsf(test, func() { NewIntegerRangeValidator(5, 1) }, "An invalid integer range did not panic!")
+	assert.Panicsf(test, func() { NewMaskValidator("") }, "An empty input mask did not panic!")
+	assert.Panicsf(test, func() { NewCustomValidator(nil) }, "A nil validation function did not panic!")
+}
@@ -54,3 +54,16 @@ func IsFloatEffectivelyEqual(firstNumber, secondNumber float64) bool {
 	secondNumberRounded := RoundToDecimal(secondNumber, 7)
 	return firstNumberRounded == secondNumberRounded
 }
+
+// GetClampedValueAsInt This method allows you to restrict a number so that it falls between a minimum and maximum
+// value. If the maximum value is less than the minimum value, the minimum value takes priority.
+func GetClampedValueAsInt(number interface{}, minimumValue int, maximumValue int) int {
+	numberAsInt := recast.GetNumberAsInt(number)
+	if numberAsInt > maximumValue {
+		numberAsInt = maximumValue
+	}
+	if numberAsInt < minimumValue {
+		numberAsInt = minimumValue
+	}
+	return numberAsInt
+}
@@ -101,4 +101,13 @@ func TestRoundToDecimal(test *testing.T) {
 			test.Errorf("The value of '5.1234567' given as type '" + reflect.TypeOf(currentValue).String() + "' should be rounded to '4' decimal places, but returned '" + stringformat.GetIntAsString(result) +"' instead.")
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestGetClampedValueAsInt(test *testing.T) {
+	if GetClampedValueAsInt(5, 1, 10) != 5 || GetClampedValueAsInt(-5, 1, 10) != 1 || GetClampedValueAsInt(int64(15), 1, 10) != 10 {
+		test.Errorf("A number was not clamped between the minimum and maximum values provided.")
+	}
+	if GetClampedValueAsInt(5, 3, 2) != 3 {
+		test.Errorf("The minimum value did not take priority over a maximum value that was less than it.")
+	}
+}
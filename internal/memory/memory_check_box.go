@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var CheckBoxMemory map[string]map[string]*CheckBoxEntryType
+
+func InitializeCheckBoxMemory() {
+	CheckBoxMemory = make(map[string]map[string]*CheckBoxEntryType)
+}
+
+func AddCheckBox(layerAlias string, checkBoxAlias string, label string, styleEntry TuiStyleEntryType, xLocation int, yLocation int, isChecked bool, isToggleSwitch bool) {
+	checkBoxEntry := NewCheckBoxEntry()
+	checkBoxEntry.StyleEntry = styleEntry
+	checkBoxEntry.CheckBoxAlias = checkBoxAlias
+	checkBoxEntry.Label = label
+	checkBoxEntry.XLocation = xLocation
+	checkBoxEntry.YLocation = yLocation
+	checkBoxEntry.IsChecked = isChecked
+	checkBoxEntry.IsToggleSwitch = isToggleSwitch
+	if CheckBoxMemory[layerAlias] == nil {
+		CheckBoxMemory[layerAlias] = make(map[string]*CheckBoxEntryType)
+	}
+	CheckBoxMemory[layerAlias][checkBoxAlias] = &checkBoxEntry
+}
+
+func GetCheckBox(layerAlias string, checkBoxAlias string) *CheckBoxEntryType {
+	if !IsCheckBoxExists(layerAlias, checkBoxAlias) {
+		panic(fmt.Sprintf("The requested check box with alias '%s' on layer '%s' could not be returned since it does not exist.", checkBoxAlias, layerAlias))
+	}
+	return CheckBoxMemory[layerAlias][checkBoxAlias]
+}
+
+func IsCheckBoxExists(layerAlias string, checkBoxAlias string) bool {
+	if _, isExist := CheckBoxMemory[layerAlias][checkBoxAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteCheckBox(layerAlias string, checkBoxAlias string) {
+	delete(CheckBoxMemory[layerAlias], checkBoxAlias)
+	if len(CheckBoxMemory[layerAlias]) == 0 {
+		delete(CheckBoxMemory, layerAlias)
+	}
+}
@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var ControlMemory map[string]map[string]*ControlEntryType
+
+var controlSequence int
+
+func InitializeControlMemory() {
+	ControlMemory = make(map[string]map[string]*ControlEntryType)
+	controlSequence = 0
+}
+
+func AddControl(layerAlias string, controlAlias string, controlType int) {
+	controlEntry := NewControlEntry()
+	controlEntry.LayerAlias = layerAlias
+	controlEntry.ControlAlias = controlAlias
+	controlEntry.ControlType = controlType
+	controlEntry.TabIndex = controlSequence
+	controlSequence++
+	if ControlMemory[layerAlias] == nil {
+		ControlMemory[layerAlias] = make(map[string]*ControlEntryType)
+	}
+	ControlMemory[layerAlias][controlAlias] = &controlEntry
+}
+
+func GetControl(layerAlias string, controlAlias string) *ControlEntryType {
+	if !IsControlExists(layerAlias, controlAlias) {
+		panic(fmt.Sprintf("The requested control with alias '%s' on layer '%s' could not be returned since it does not exist.", controlAlias, layerAlias))
+	}
+	return ControlMemory[layerAlias][controlAlias]
+}
+
+func IsControlExists(layerAlias string, controlAlias string) bool {
+	if _, isExist := ControlMemory[layerAlias][controlAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteControl(layerAlias string, controlAlias string) {
+	delete(ControlMemory[layerAlias], controlAlias)
+	if len(ControlMemory[layerAlias]) == 0 {
+		delete(ControlMemory, layerAlias)
+	}
+}
@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"fmt"
+	"github.com/supercom32/dosktop/constants"
+)
+
+var DataGridMemory map[string]map[string]*DataGridEntryType
+
+func InitializeDataGridMemory() {
+	DataGridMemory = make(map[string]map[string]*DataGridEntryType)
+}
+
+func AddDataGrid(layerAlias string, dataGridAlias string, styleEntry TuiStyleEntryType, xLocation int, yLocation int, width int, height int) {
+	dataGridEntry := NewDataGridEntry()
+	dataGridEntry.StyleEntry = styleEntry
+	dataGridEntry.DataGridAlias = dataGridAlias
+	dataGridEntry.XLocation = xLocation
+	dataGridEntry.YLocation = yLocation
+	dataGridEntry.Width = width
+	dataGridEntry.Height = height
+	dataGridEntry.SortColumn = constants.NullSelectionIndex
+	if DataGridMemory[layerAlias] == nil {
+		DataGridMemory[layerAlias] = make(map[string]*DataGridEntryType)
+	}
+	DataGridMemory[layerAlias][dataGridAlias] = &dataGridEntry
+}
+
+func GetDataGrid(layerAlias string, dataGridAlias string) *DataGridEntryType {
+	if !IsDataGridExists(layerAlias, dataGridAlias) {
+		panic(fmt.Sprintf("The requested data grid with alias '%s' on layer '%s' could not be returned since it does not exist.", dataGridAlias, layerAlias))
+	}
+	return DataGridMemory[layerAlias][dataGridAlias]
+}
+
+func IsDataGridExists(layerAlias string, dataGridAlias string) bool {
+	if _, isExist := DataGridMemory[layerAlias][dataGridAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteDataGrid(layerAlias string, dataGridAlias string) {
+	delete(DataGridMemory[layerAlias], dataGridAlias)
+	if len(DataGridMemory[layerAlias]) == 0 {
+		delete(DataGridMemory, layerAlias)
+	}
+}
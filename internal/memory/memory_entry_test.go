@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAddAndDeleteEntries(test *testing.T) {
+	testCases := []struct {
+		name             string
+		initializeMemory func()
+		addEntry         func()
+		isEntryExists    func() bool
+		getMissingEntry  func()
+		deleteEntry      func()
+		getMemorySize    func() int
+	}{
+		{"window", InitializeWindowMemory,
+			func() { AddWindow("windowAlias1", "My Window", NewTuiStyleEntry()) },
+			func() bool { return IsWindowExists("windowAlias1") },
+			func() { GetWindow("windowAlias2") },
+			func() { DeleteWindow("windowAlias1") },
+			func() int { return len(WindowMemory) }},
+		{"control", InitializeControlMemory,
+			func() { AddControl("layerAlias1", "controlAlias1", 1) },
+			func() bool { return IsControlExists("layerAlias1", "controlAlias1") },
+			func() { GetControl("layerAlias1", "controlAlias2") },
+			func() { DeleteControl("layerAlias1", "controlAlias1") },
+			func() int { return len(ControlMemory) }},
+		{"menu", InitializeMenuMemory,
+			func() { AddMenu("layerAlias1", "menuAlias1", NewTuiStyleEntry(), NewSelectionEntry(), 1, 2, 10, 5) },
+			func() bool { return IsMenuExists("layerAlias1", "menuAlias1") },
+			func() { GetMenu("layerAlias1", "menuAlias2") },
+			func() { DeleteMenu("layerAlias1", "menuAlias1") },
+			func() int { return len(MenuMemory) }},
+		{"text field", InitializeTextFieldMemory,
+			func() { AddTextField("layerAlias1", "textFieldAlias1", NewTuiStyleEntry(), 1, 2, 10, 20, false, "") },
+			func() bool { return IsTextFieldExists("layerAlias1", "textFieldAlias1") },
+			func() { GetTextField("layerAlias1", "textFieldAlias2") },
+			func() { DeleteTextField("layerAlias1", "textFieldAlias1") },
+			func() int { return len(TextFieldMemory) }},
+		{"list box", InitializeListBoxMemory,
+			func() { AddListBox("layerAlias1", "listBoxAlias1", NewTuiStyleEntry(), 1, 2, 10, 5, false) },
+			func() bool { return IsListBoxExists("layerAlias1", "listBoxAlias1") },
+			func() { GetListBox("layerAlias1", "listBoxAlias2") },
+			func() { DeleteListBox("layerAlias1", "listBoxAlias1") },
+			func() int { return len(ListBoxMemory) }},
+		{"data grid", InitializeDataGridMemory,
+			func() { AddDataGrid("layerAlias1", "dataGridAlias1", NewTuiStyleEntry(), 1, 2, 20, 10) },
+			func() bool { return IsDataGridExists("layerAlias1", "dataGridAlias1") },
+			func() { GetDataGrid("layerAlias1", "dataGridAlias2") },
+			func() { DeleteDataGrid("layerAlias1", "dataGridAlias1") },
+			func() int { return len(DataGridMemory) }},
+		{"check box", InitializeCheckBoxMemory,
+			func() { AddCheckBox("layerAlias1", "checkBoxAlias1", "Label", NewTuiStyleEntry(), 1, 2, true, false) },
+			func() bool { return IsCheckBoxExists("layerAlias1", "checkBoxAlias1") },
+			func() { GetCheckBox("layerAlias1", "checkBoxAlias2") },
+			func() { DeleteCheckBox("layerAlias1", "checkBoxAlias1") },
+			func() int { return len(CheckBoxMemory) }},
+		{"radio button group", InitializeRadioButtonGroupMemory,
+			func() { AddRadioButtonGroup("layerAlias1", "radioButtonGroupAlias1", NewTuiStyleEntry(), NewSelectionEntry(), 1, 2) },
+			func() bool { return IsRadioButtonGroupExists("layerAlias1", "radioButtonGroupAlias1") },
+			func() { GetRadioButtonGroup("layerAlias1", "radioButtonGroupAlias2") },
+			func() { DeleteRadioButtonGroup("layerAlias1", "radioButtonGroupAlias1") },
+			func() int { return len(RadioButtonGroupMemory) }},
+		{"viewport", InitializeViewportMemory,
+			func() { AddViewport("viewportAlias1", "layerAlias1", NewTuiStyleEntry(), true, true) },
+			func() bool { return IsViewportExists("viewportAlias1") },
+			func() { GetViewport("viewportAlias2") },
+			func() { DeleteViewport("viewportAlias1") },
+			func() int { return len(ViewportMemory) }},
+	}
+	for _, testCase := range testCases {
+		testCase.initializeMemory()
+		testCase.addEntry()
+		assert.Truef(test, testCase.isEntryExists(), "The added %s could not be found.", testCase.name)
+		assert.Panicsf(test, testCase.getMissingEntry, "Getting a %s which does not exist did not panic.", testCase.name)
+		testCase.deleteEntry()
+		assert.Falsef(test, testCase.isEntryExists(), "The deleted %s could still be found.", testCase.name)
+		assert.Equalf(test, 0, testCase.getMemorySize(), "An empty entry was left behind in %s memory.", testCase.name)
+	}
+}
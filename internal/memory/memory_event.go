@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"sync"
+)
+
+const maximumEventQueueSize = 1024
+
+type eventMemoryType struct {
+	eventQueue          []EventEntryType
+	notificationChannel chan bool
+	mutex               sync.Mutex
+}
+
+var EventMemory eventMemoryType
+
+func (shared *eventMemoryType) ClearEvents() {
+	shared.mutex.Lock()
+	shared.eventQueue = nil
+	shared.mutex.Unlock()
+}
+
+func (shared *eventMemoryType) AddEvent(eventEntry ...EventEntryType) {
+	shared.mutex.Lock()
+	for _, currentEventEntry := range eventEntry {
+		if len(shared.eventQueue) >= maximumEventQueueSize {
+			shared.eventQueue = shared.eventQueue[1:]
+		}
+		shared.eventQueue = append(shared.eventQueue, currentEventEntry)
+	}
+	notificationChannel := shared.getNotificationChannel()
+	shared.mutex.Unlock()
+	select {
+	case notificationChannel <- true:
+	default:
+	}
+}
+
+func (shared *eventMemoryType) AddEventToFront(eventEntry EventEntryType) {
+	shared.mutex.Lock()
+	shared.eventQueue = append([]EventEntryType{eventEntry}, shared.eventQueue...)
+	notificationChannel := shared.getNotificationChannel()
+	shared.mutex.Unlock()
+	select {
+	case notificationChannel <- true:
+	default:
+	}
+}
+
+func (shared *eventMemoryType) GetEvent() (EventEntryType, bool) {
+	shared.mutex.Lock()
+	defer shared.mutex.Unlock()
+	if len(shared.eventQueue) == 0 {
+		return NewEventEntry(), false
+	}
+	eventEntry := shared.eventQueue[0]
+	shared.eventQueue = shared.eventQueue[1:]
+	return eventEntry, true
+}
+
+func (shared *eventMemoryType) GetEventOfType(eventTypes ...int) (EventEntryType, bool) {
+	shared.mutex.Lock()
+	defer shared.mutex.Unlock()
+	for currentIndex, currentEventEntry := range shared.eventQueue {
+		for _, currentEventType := range eventTypes {
+			if currentEventEntry.EventType == currentEventType {
+				shared.eventQueue = append(shared.eventQueue[:currentIndex:currentIndex], shared.eventQueue[currentIndex+1:]...)
+				return currentEventEntry, true
+			}
+		}
+	}
+	return NewEventEntry(), false
+}
+
+func (shared *eventMemoryType) GetNumberOfEvents() int {
+	shared.mutex.Lock()
+	defer shared.mutex.Unlock()
+	return len(shared.eventQueue)
+}
+
+func (shared *eventMemoryType) GetNotificationChannel() chan bool {
+	shared.mutex.Lock()
+	defer shared.mutex.Unlock()
+	return shared.getNotificationChannel()
+}
+
+func (shared *eventMemoryType) getNotificationChannel() chan bool {
+	if shared.notificationChannel == nil {
+		shared.notificationChannel = make(chan bool, 1)
+	}
+	return shared.notificationChannel
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAddAndGetEvent(test *testing.T) {
+	EventMemory.ClearEvents()
+	firstEventEntry := NewEventEntry()
+	firstEventEntry.EventType = 1
+	secondEventEntry := NewEventEntry()
+	secondEventEntry.EventType = 2
+	EventMemory.AddEvent(firstEventEntry, secondEventEntry)
+	obtainedResult, isEventAvailable := EventMemory.GetEvent()
+	assert.Truef(test, isEventAvailable, "An event was not available even though one was added.")
+	assert.Equalf(test, firstEventEntry, obtainedResult, "The events were not returned in the order they were added.")
+	obtainedResult, _ = EventMemory.GetEvent()
+	assert.Equalf(test, secondEventEntry, obtainedResult, "The events were not returned in the order they were added.")
+	_, isEventAvailable = EventMemory.GetEvent()
+	assert.Falsef(test, isEventAvailable, "An event was available even though the queue should be empty.")
+	EventMemory.AddEvent(secondEventEntry)
+	EventMemory.AddEventToFront(firstEventEntry)
+	obtainedResult, _ = EventMemory.GetEvent()
+	assert.Equalf(test, firstEventEntry, obtainedResult, "An event returned to the queue was not placed in front of the others.")
+	EventMemory.ClearEvents()
+	EventMemory.AddEvent(firstEventEntry, secondEventEntry)
+	obtainedResult, isEventAvailable = EventMemory.GetEventOfType(2)
+	assert.Equalf(test, []interface{}{true, secondEventEntry, 1}, []interface{}{isEventAvailable, obtainedResult, EventMemory.GetNumberOfEvents()}, "An event of the requested type was not removed from the middle of the queue.")
+	_, isEventAvailable = EventMemory.GetEventOfType(2)
+	assert.Falsef(test, isEventAvailable, "An event was returned even though none of the requested type remained.")
+	EventMemory.ClearEvents()
+}
+
+func TestEventQueueOverflow(test *testing.T) {
+	EventMemory.ClearEvents()
+	for currentEvent := 0; currentEvent < maximumEventQueueSize+10; currentEvent++ {
+		eventEntry := NewEventEntry()
+		eventEntry.TimeStamp = int64(currentEvent)
+		EventMemory.AddEvent(eventEntry)
+	}
+	assert.Equalf(test, maximumEventQueueSize, EventMemory.GetNumberOfEvents(), "The event queue grew beyond its maximum size.")
+	obtainedResult, _ := EventMemory.GetEvent()
+	assert.Equalf(test, int64(10), obtainedResult.TimeStamp, "The oldest events were not discarded when the queue overflowed.")
+	EventMemory.ClearEvents()
+	select {
+	case <-EventMemory.GetNotificationChannel():
+	default:
+		test.Errorf("No notification was sent when events were added.")
+	}
+}
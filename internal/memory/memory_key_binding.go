@@ -0,0 +1,30 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var KeyBindingMemory map[string]*KeyBindingEntryType
+
+func InitializeKeyBindingMemory() {
+	KeyBindingMemory = make(map[string]*KeyBindingEntryType)
+}
+
+func AddKeyBinding(bindingAlias string, keySequence []string, callback func()) {
+	keyBindingEntry := NewKeyBindingEntry()
+	keyBindingEntry.BindingAlias = bindingAlias
+	keyBindingEntry.KeySequence = keySequence
+	keyBindingEntry.Callback = callback
+	KeyBindingMemory[bindingAlias] = &keyBindingEntry
+}
+
+func GetKeyBinding(bindingAlias string) KeyBindingEntryType {
+	if KeyBindingMemory[bindingAlias] == nil {
+		panic(fmt.Sprintf("The requested key binding with alias '%s' could not be returned since it does not exist.", bindingAlias))
+	}
+	return *KeyBindingMemory[bindingAlias]
+}
+
+func DeleteKeyBinding(bindingAlias string) {
+	delete(KeyBindingMemory, bindingAlias)
+}
@@ -0,0 +1,21 @@
+package memory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAddKeyBinding(test *testing.T) {
+	InitializeKeyBindingMemory()
+	AddKeyBinding("bindingAlias1", []string{"ctrl+x", "ctrl+s"}, nil)
+	keyBindingEntry := GetKeyBinding("bindingAlias1")
+	assert.Equalf(test, []string{"ctrl+x", "ctrl+s"}, keyBindingEntry.KeySequence, "The added key binding does not match what was expected.")
+	assert.Panicsf(test, func() { GetKeyBinding("bindingAlias2") }, "Getting a key binding which does not exist did not panic.")
+}
+
+func TestDeleteKeyBinding(test *testing.T) {
+	InitializeKeyBindingMemory()
+	AddKeyBinding("bindingAlias1", []string{"f1"}, nil)
+	DeleteKeyBinding("bindingAlias1")
+	assert.Equalf(test, 0, len(KeyBindingMemory), "The number of remaining key bindings does not match what was expected.")
+}
@@ -20,11 +20,12 @@ func (shared *keyboardMemoryType) AddKeystrokeToKeyboardBuffer(keystroke ...stri
 }
 
 func (shared *keyboardMemoryType) GetKeystrokeFromKeyboardBuffer() string {
-	if shared.keyboardMemory == nil || len(shared.keyboardMemory) == 0 {
-		return ""
-	}
 	keystroke := ""
 	shared.mutex.Lock()
+	if len(shared.keyboardMemory) == 0 {
+		shared.mutex.Unlock()
+		return keystroke
+	}
 	keystroke = shared.keyboardMemory[0]
 	shared.keyboardMemory = shared.keyboardMemory[1:]
 	shared.mutex.Unlock()
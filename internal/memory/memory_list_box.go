@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var ListBoxMemory map[string]map[string]*ListBoxEntryType
+
+func InitializeListBoxMemory() {
+	ListBoxMemory = make(map[string]map[string]*ListBoxEntryType)
+}
+
+func AddListBox(layerAlias string, listBoxAlias string, styleEntry TuiStyleEntryType, xLocation int, yLocation int, width int, height int, isMultiSelectEnabled bool) {
+	listBoxEntry := NewListBoxEntry()
+	listBoxEntry.StyleEntry = styleEntry
+	listBoxEntry.ListBoxAlias = listBoxAlias
+	listBoxEntry.XLocation = xLocation
+	listBoxEntry.YLocation = yLocation
+	listBoxEntry.Width = width
+	listBoxEntry.Height = height
+	listBoxEntry.IsMultiSelectEnabled = isMultiSelectEnabled
+	if ListBoxMemory[layerAlias] == nil {
+		ListBoxMemory[layerAlias] = make(map[string]*ListBoxEntryType)
+	}
+	ListBoxMemory[layerAlias][listBoxAlias] = &listBoxEntry
+}
+
+func GetListBox(layerAlias string, listBoxAlias string) *ListBoxEntryType {
+	if !IsListBoxExists(layerAlias, listBoxAlias) {
+		panic(fmt.Sprintf("The requested list box with alias '%s' on layer '%s' could not be returned since it does not exist.", listBoxAlias, layerAlias))
+	}
+	return ListBoxMemory[layerAlias][listBoxAlias]
+}
+
+func IsListBoxExists(layerAlias string, listBoxAlias string) bool {
+	if _, isExist := ListBoxMemory[layerAlias][listBoxAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteListBox(layerAlias string, listBoxAlias string) {
+	delete(ListBoxMemory[layerAlias], listBoxAlias)
+	if len(ListBoxMemory[layerAlias]) == 0 {
+		delete(ListBoxMemory, layerAlias)
+	}
+}
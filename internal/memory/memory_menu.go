@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var MenuMemory map[string]map[string]*MenuEntryType
+
+func InitializeMenuMemory() {
+	MenuMemory = make(map[string]map[string]*MenuEntryType)
+}
+
+func AddMenu(layerAlias string, menuAlias string, styleEntry TuiStyleEntryType, selectionEntry SelectionEntryType, xLocation int, yLocation int, width int, height int) {
+	menuEntry := NewMenuEntry()
+	menuEntry.StyleEntry = styleEntry
+	menuEntry.MenuAlias = menuAlias
+	menuEntry.SelectionEntry = selectionEntry
+	menuEntry.XLocation = xLocation
+	menuEntry.YLocation = yLocation
+	menuEntry.Width = width
+	menuEntry.Height = height
+	if MenuMemory[layerAlias] == nil {
+		MenuMemory[layerAlias] = make(map[string]*MenuEntryType)
+	}
+	MenuMemory[layerAlias][menuAlias] = &menuEntry
+}
+
+func GetMenu(layerAlias string, menuAlias string) *MenuEntryType {
+	if !IsMenuExists(layerAlias, menuAlias) {
+		panic(fmt.Sprintf("The requested menu with alias '%s' on layer '%s' could not be returned since it does not exist.", menuAlias, layerAlias))
+	}
+	return MenuMemory[layerAlias][menuAlias]
+}
+
+func IsMenuExists(layerAlias string, menuAlias string) bool {
+	if _, isExist := MenuMemory[layerAlias][menuAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteMenu(layerAlias string, menuAlias string) {
+	delete(MenuMemory[layerAlias], menuAlias)
+	if len(MenuMemory[layerAlias]) == 0 {
+		delete(MenuMemory, layerAlias)
+	}
+}
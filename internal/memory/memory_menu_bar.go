@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var MenuBarMemory map[string]map[string]*MenuBarEntryType
+
+func InitializeMenuBarMemory() {
+	MenuBarMemory = make(map[string]map[string]*MenuBarEntryType)
+}
+
+func AddMenuBar(layerAlias string, menuBarAlias string, styleEntry TuiStyleEntryType, xLocation int, yLocation int, width int) {
+	menuBarEntry := NewMenuBarEntry()
+	menuBarEntry.StyleEntry = styleEntry
+	menuBarEntry.MenuBarAlias = menuBarAlias
+	menuBarEntry.XLocation = xLocation
+	menuBarEntry.YLocation = yLocation
+	menuBarEntry.Width = width
+	if MenuBarMemory[layerAlias] == nil {
+		MenuBarMemory[layerAlias] = make(map[string]*MenuBarEntryType)
+	}
+	MenuBarMemory[layerAlias][menuBarAlias] = &menuBarEntry
+}
+
+func GetMenuBar(layerAlias string, menuBarAlias string) *MenuBarEntryType {
+	if !IsMenuBarExists(layerAlias, menuBarAlias) {
+		panic(fmt.Sprintf("The requested menu bar with alias '%s' on layer '%s' could not be returned since it does not exist.", menuBarAlias, layerAlias))
+	}
+	return MenuBarMemory[layerAlias][menuBarAlias]
+}
+
+func IsMenuBarExists(layerAlias string, menuBarAlias string) bool {
+	if _, isExist := MenuBarMemory[layerAlias][menuBarAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteMenuBar(layerAlias string, menuBarAlias string) {
+	delete(MenuBarMemory[layerAlias], menuBarAlias)
+	if len(MenuBarMemory[layerAlias]) == 0 {
+		delete(MenuBarMemory, layerAlias)
+	}
+}
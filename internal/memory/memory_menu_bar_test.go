@@ -0,0 +1,22 @@
+package memory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAddMenuBar(test *testing.T) {
+	InitializeMenuBarMemory()
+	AddMenuBar("layerAlias1", "menuBarAlias1", NewTuiStyleEntry(), 1, 2, 40)
+	assert.Truef(test, IsMenuBarExists("layerAlias1", "menuBarAlias1"), "The added menu bar could not be found.")
+	assert.Equalf(test, 40, GetMenuBar("layerAlias1", "menuBarAlias1").Width, "The menu bar settings do not match what was expected.")
+	assert.Panicsf(test, func() { GetMenuBar("layerAlias1", "menuBarAlias2") }, "Getting a menu bar which does not exist did not panic.")
+}
+
+func TestDeleteMenuBar(test *testing.T) {
+	InitializeMenuBarMemory()
+	AddMenuBar("layerAlias1", "menuBarAlias1", NewTuiStyleEntry(), 1, 2, 40)
+	DeleteMenuBar("layerAlias1", "menuBarAlias1")
+	assert.Falsef(test, IsMenuBarExists("layerAlias1", "menuBarAlias1"), "The deleted menu bar could still be found.")
+	assert.Equalf(test, 0, len(MenuBarMemory), "An empty layer was left behind in menu bar memory.")
+}
@@ -7,7 +7,9 @@ type mouseMemoryType struct {
 	yLocation     int
 	buttonPressed uint
 	wheelState    string
+	clickInterval int64
 	mutex         sync.Mutex
+	releaseSignal *sync.Cond
 }
 
 var MouseMemory mouseMemoryType
@@ -18,6 +20,7 @@ func (shared *mouseMemoryType) ClearMouseMemory() {
 	shared.yLocation = -1
 	shared.buttonPressed = 0
 	shared.wheelState = ""
+	shared.getReleaseSignal().Broadcast()
 	shared.mutex.Unlock()
 }
 
@@ -27,6 +30,9 @@ func (shared *mouseMemoryType) SetMouseStatus(xLocation int, yLocation int, butt
 	shared.yLocation = yLocation
 	shared.buttonPressed = buttonPressed
 	shared.wheelState = wheelState
+	if buttonPressed == 0 {
+		shared.getReleaseSignal().Broadcast()
+	}
 	shared.mutex.Unlock()
 }
 
@@ -41,8 +47,30 @@ func (shared *mouseMemoryType) GetMouseStatus() (int, int, uint, string) {
 }
 
 func (shared *mouseMemoryType) WaitForClickRelease() {
+	shared.mutex.Lock()
 	for shared.buttonPressed != 0 {
+		shared.getReleaseSignal().Wait()
 	}
+	shared.mutex.Unlock()
+}
+
+func (shared *mouseMemoryType) getReleaseSignal() *sync.Cond {
+	if shared.releaseSignal == nil {
+		shared.releaseSignal = sync.NewCond(&shared.mutex)
+	}
+	return shared.releaseSignal
+}
+
+func (shared *mouseMemoryType) SetClickInterval(clickInterval int64) {
+	shared.mutex.Lock()
+	shared.clickInterval = clickInterval
+	shared.mutex.Unlock()
+}
+
+func (shared *mouseMemoryType) GetClickInterval() int64 {
+	shared.mutex.Lock()
+	defer shared.mutex.Unlock()
+	return shared.clickInterval
 }
 
 func (shared *mouseMemoryType) IsMouseInBoundingBox(xLocation int, yLocation int, width int, height int) bool {
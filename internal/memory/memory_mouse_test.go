@@ -1,6 +1,9 @@
 package memory
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestMouseMemory(test *testing.T) {
 	MouseMemory.SetMouseStatus(1,2,3,"down")
@@ -28,4 +31,24 @@ func TestIsMouseInBoundingBox(test *testing.T) {
 	if MouseMemory.IsMouseInBoundingBox(1, 1, 10, 10) != false {
 		test.Errorf("Mouse was out of the bounding box, but was not detected as such.")
 	}
-}
\ No newline at end of file
+}
+
+func TestMouseMemoryWaitForClickRelease(test *testing.T) {
+	MouseMemory.SetMouseStatus(1, 1, 1, "")
+	isReleased := make(chan bool)
+	go func() {
+		MouseMemory.WaitForClickRelease()
+		close(isReleased)
+	}()
+	select {
+	case <-isReleased:
+		test.Errorf("Waiting for a click release returned while the button was still pressed.")
+	case <-time.After(50 * time.Millisecond):
+	}
+	MouseMemory.SetMouseStatus(1, 1, 0, "")
+	select {
+	case <-isReleased:
+	case <-time.After(time.Second):
+		test.Errorf("Waiting for a click release did not return after the button was released.")
+	}
+}
@@ -0,0 +1,49 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var ProgressBarMemory map[string]map[string]*ProgressBarEntryType
+
+func InitializeProgressBarMemory() {
+	ProgressBarMemory = make(map[string]map[string]*ProgressBarEntryType)
+}
+
+func AddProgressBar(layerAlias string, progressBarAlias string, styleEntry TuiStyleEntryType, xLocation int, yLocation int, width int, height int, isVertical bool) {
+	progressBarEntry := NewProgressBarEntry()
+	progressBarEntry.StyleEntry = styleEntry
+	progressBarEntry.ProgressBarAlias = progressBarAlias
+	progressBarEntry.XLocation = xLocation
+	progressBarEntry.YLocation = yLocation
+	progressBarEntry.Width = width
+	progressBarEntry.Height = height
+	progressBarEntry.IsVertical = isVertical
+	progressBarEntry.MaximumValue = 100
+	progressBarEntry.MarqueeDirection = 1
+	if ProgressBarMemory[layerAlias] == nil {
+		ProgressBarMemory[layerAlias] = make(map[string]*ProgressBarEntryType)
+	}
+	ProgressBarMemory[layerAlias][progressBarAlias] = &progressBarEntry
+}
+
+func GetProgressBar(layerAlias string, progressBarAlias string) *ProgressBarEntryType {
+	if !IsProgressBarExists(layerAlias, progressBarAlias) {
+		panic(fmt.Sprintf("The requested progress bar with alias '%s' on layer '%s' could not be returned since it does not exist.", progressBarAlias, layerAlias))
+	}
+	return ProgressBarMemory[layerAlias][progressBarAlias]
+}
+
+func IsProgressBarExists(layerAlias string, progressBarAlias string) bool {
+	if _, isExist := ProgressBarMemory[layerAlias][progressBarAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteProgressBar(layerAlias string, progressBarAlias string) {
+	delete(ProgressBarMemory[layerAlias], progressBarAlias)
+	if len(ProgressBarMemory[layerAlias]) == 0 {
+		delete(ProgressBarMemory, layerAlias)
+	}
+}
@@ -0,0 +1,22 @@
+package memory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAddProgressBar(test *testing.T) {
+	InitializeProgressBarMemory()
+	AddProgressBar("layerAlias1", "progressBarAlias1", NewTuiStyleEntry(), 1, 2, 10, 1, true)
+	assert.Truef(test, IsProgressBarExists("layerAlias1", "progressBarAlias1"), "The added progress bar could not be found.")
+	assert.Equalf(test, []interface{}{true, 100}, []interface{}{GetProgressBar("layerAlias1", "progressBarAlias1").IsVertical, GetProgressBar("layerAlias1", "progressBarAlias1").MaximumValue}, "The progress bar settings do not match what was expected.")
+	assert.Panicsf(test, func() { GetProgressBar("layerAlias1", "progressBarAlias2") }, "Getting a progress bar which does not exist did not panic.")
+}
+
+func TestDeleteProgressBar(test *testing.T) {
+	InitializeProgressBarMemory()
+	AddProgressBar("layerAlias1", "progressBarAlias1", NewTuiStyleEntry(), 1, 2, 10, 1, false)
+	DeleteProgressBar("layerAlias1", "progressBarAlias1")
+	assert.Falsef(test, IsProgressBarExists("layerAlias1", "progressBarAlias1"), "The deleted progress bar could still be found.")
+	assert.Equalf(test, 0, len(ProgressBarMemory), "An empty layer was left behind in progress bar memory.")
+}
@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var RadioButtonGroupMemory map[string]map[string]*RadioButtonGroupEntryType
+
+func InitializeRadioButtonGroupMemory() {
+	RadioButtonGroupMemory = make(map[string]map[string]*RadioButtonGroupEntryType)
+}
+
+func AddRadioButtonGroup(layerAlias string, radioButtonGroupAlias string, styleEntry TuiStyleEntryType, selectionEntry SelectionEntryType, xLocation int, yLocation int) {
+	radioButtonGroupEntry := NewRadioButtonGroupEntry()
+	radioButtonGroupEntry.StyleEntry = styleEntry
+	radioButtonGroupEntry.RadioButtonGroupAlias = radioButtonGroupAlias
+	radioButtonGroupEntry.SelectionEntry.SelectionAlias = append([]string(nil), selectionEntry.SelectionAlias...)
+	radioButtonGroupEntry.SelectionEntry.SelectionValue = append([]string(nil), selectionEntry.SelectionValue...)
+	radioButtonGroupEntry.XLocation = xLocation
+	radioButtonGroupEntry.YLocation = yLocation
+	if RadioButtonGroupMemory[layerAlias] == nil {
+		RadioButtonGroupMemory[layerAlias] = make(map[string]*RadioButtonGroupEntryType)
+	}
+	RadioButtonGroupMemory[layerAlias][radioButtonGroupAlias] = &radioButtonGroupEntry
+}
+
+func GetRadioButtonGroup(layerAlias string, radioButtonGroupAlias string) *RadioButtonGroupEntryType {
+	if !IsRadioButtonGroupExists(layerAlias, radioButtonGroupAlias) {
+		panic(fmt.Sprintf("The requested radio button group with alias '%s' on layer '%s' could not be returned since it does not exist.", radioButtonGroupAlias, layerAlias))
+	}
+	return RadioButtonGroupMemory[layerAlias][radioButtonGroupAlias]
+}
+
+func IsRadioButtonGroupExists(layerAlias string, radioButtonGroupAlias string) bool {
+	if _, isExist := RadioButtonGroupMemory[layerAlias][radioButtonGroupAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteRadioButtonGroup(layerAlias string, radioButtonGroupAlias string) {
+	delete(RadioButtonGroupMemory[layerAlias], radioButtonGroupAlias)
+	if len(RadioButtonGroupMemory[layerAlias]) == 0 {
+		delete(RadioButtonGroupMemory, layerAlias)
+	}
+}
@@ -0,0 +1,28 @@
+package memory
+
+import (
+	"sort"
+)
+
+var ResizeCallbackMemory map[string]func(width int, height int)
+
+func InitializeResizeCallbackMemory() {
+	ResizeCallbackMemory = make(map[string]func(width int, height int))
+}
+
+func AddResizeCallback(callbackAlias string, callback func(width int, height int)) {
+	ResizeCallbackMemory[callbackAlias] = callback
+}
+
+func DeleteResizeCallback(callbackAlias string) {
+	delete(ResizeCallbackMemory, callbackAlias)
+}
+
+func GetSortedResizeCallbackAliasSlice() []string {
+	aliasSlice := make([]string, 0, len(ResizeCallbackMemory))
+	for currentKey := range ResizeCallbackMemory {
+		aliasSlice = append(aliasSlice, currentKey)
+	}
+	sort.Strings(aliasSlice)
+	return aliasSlice
+}
@@ -0,0 +1,25 @@
+package memory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAddResizeCallback(test *testing.T) {
+	InitializeResizeCallbackMemory()
+	obtainedResult := 0
+	AddResizeCallback("callbackAlias1", func(width int, height int) {
+		obtainedResult = width + height
+	})
+	ResizeCallbackMemory["callbackAlias1"](3, 4)
+	assert.Equalf(test, 7, obtainedResult, "The added resize callback was not called correctly.")
+}
+
+func TestDeleteResizeCallback(test *testing.T) {
+	InitializeResizeCallbackMemory()
+	AddResizeCallback("callbackAlias2", func(width int, height int) {})
+	AddResizeCallback("callbackAlias1", func(width int, height int) {})
+	assert.Equalf(test, []string{"callbackAlias1", "callbackAlias2"}, GetSortedResizeCallbackAliasSlice(), "The resize callback aliases were not sorted correctly.")
+	DeleteResizeCallback("callbackAlias1")
+	assert.Equalf(test, []string{"callbackAlias2"}, GetSortedResizeCallbackAliasSlice(), "The remaining resize callbacks do not match what was expected.")
+}
@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var TabContainerMemory map[string]map[string]*TabContainerEntryType
+
+func InitializeTabContainerMemory() {
+	TabContainerMemory = make(map[string]map[string]*TabContainerEntryType)
+}
+
+func AddTabContainer(layerAlias string, tabContainerAlias string, styleEntry TuiStyleEntryType, xLocation int, yLocation int, width int, height int) {
+	tabContainerEntry := NewTabContainerEntry()
+	tabContainerEntry.StyleEntry = styleEntry
+	tabContainerEntry.TabContainerAlias = tabContainerAlias
+	tabContainerEntry.XLocation = xLocation
+	tabContainerEntry.YLocation = yLocation
+	tabContainerEntry.Width = width
+	tabContainerEntry.Height = height
+	if TabContainerMemory[layerAlias] == nil {
+		TabContainerMemory[layerAlias] = make(map[string]*TabContainerEntryType)
+	}
+	TabContainerMemory[layerAlias][tabContainerAlias] = &tabContainerEntry
+}
+
+func GetTabContainer(layerAlias string, tabContainerAlias string) *TabContainerEntryType {
+	if !IsTabContainerExists(layerAlias, tabContainerAlias) {
+		panic(fmt.Sprintf("The requested tab container with alias '%s' on layer '%s' could not be returned since it does not exist.", tabContainerAlias, layerAlias))
+	}
+	return TabContainerMemory[layerAlias][tabContainerAlias]
+}
+
+func IsTabContainerExists(layerAlias string, tabContainerAlias string) bool {
+	if _, isExist := TabContainerMemory[layerAlias][tabContainerAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteTabContainer(layerAlias string, tabContainerAlias string) {
+	delete(TabContainerMemory[layerAlias], tabContainerAlias)
+	if len(TabContainerMemory[layerAlias]) == 0 {
+		delete(TabContainerMemory, layerAlias)
+	}
+}
@@ -0,0 +1,22 @@
+package memory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAddTabContainer(test *testing.T) {
+	InitializeTabContainerMemory()
+	AddTabContainer("layerAlias1", "tabContainerAlias1", NewTuiStyleEntry(), 1, 2, 30, 10)
+	assert.Truef(test, IsTabContainerExists("layerAlias1", "tabContainerAlias1"), "The added tab container could not be found.")
+	assert.Equalf(test, []int{1, 2, 30, 10}, []int{GetTabContainer("layerAlias1", "tabContainerAlias1").XLocation, GetTabContainer("layerAlias1", "tabContainerAlias1").YLocation, GetTabContainer("layerAlias1", "tabContainerAlias1").Width, GetTabContainer("layerAlias1", "tabContainerAlias1").Height}, "The tab container settings do not match what was expected.")
+	assert.Panicsf(test, func() { GetTabContainer("layerAlias1", "tabContainerAlias2") }, "Getting a tab container which does not exist did not panic.")
+}
+
+func TestDeleteTabContainer(test *testing.T) {
+	InitializeTabContainerMemory()
+	AddTabContainer("layerAlias1", "tabContainerAlias1", NewTuiStyleEntry(), 1, 2, 30, 10)
+	DeleteTabContainer("layerAlias1", "tabContainerAlias1")
+	assert.Falsef(test, IsTabContainerExists("layerAlias1", "tabContainerAlias1"), "The deleted tab container could still be found.")
+	assert.Equalf(test, 0, len(TabContainerMemory), "An empty layer was left behind in tab container memory.")
+}
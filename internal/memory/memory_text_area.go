@@ -0,0 +1,49 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var TextAreaMemory map[string]map[string]*TextAreaEntryType
+
+func InitializeTextAreaMemory() {
+	TextAreaMemory = make(map[string]map[string]*TextAreaEntryType)
+}
+
+func AddTextArea(layerAlias string, textAreaAlias string, styleEntry TuiStyleEntryType, xLocation int, yLocation int, width int, height int, maxLengthAllowed int, isWordWrapEnabled bool, isLineNumbersEnabled bool) {
+	textAreaEntry := NewTextAreaEntry()
+	textAreaEntry.StyleEntry = styleEntry
+	textAreaEntry.TextAreaAlias = textAreaAlias
+	textAreaEntry.XLocation = xLocation
+	textAreaEntry.YLocation = yLocation
+	textAreaEntry.Width = width
+	textAreaEntry.Height = height
+	textAreaEntry.MaxLengthAllowed = maxLengthAllowed
+	textAreaEntry.IsWordWrapEnabled = isWordWrapEnabled
+	textAreaEntry.IsLineNumbersEnabled = isLineNumbersEnabled
+	if TextAreaMemory[layerAlias] == nil {
+		TextAreaMemory[layerAlias] = make(map[string]*TextAreaEntryType)
+	}
+	TextAreaMemory[layerAlias][textAreaAlias] = &textAreaEntry
+}
+
+func GetTextArea(layerAlias string, textAreaAlias string) *TextAreaEntryType {
+	if !IsTextAreaExists(layerAlias, textAreaAlias) {
+		panic(fmt.Sprintf("The requested text area with alias '%s' on layer '%s' could not be returned since it does not exist.", textAreaAlias, layerAlias))
+	}
+	return TextAreaMemory[layerAlias][textAreaAlias]
+}
+
+func IsTextAreaExists(layerAlias string, textAreaAlias string) bool {
+	if _, isExist := TextAreaMemory[layerAlias][textAreaAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteTextArea(layerAlias string, textAreaAlias string) {
+	delete(TextAreaMemory[layerAlias], textAreaAlias)
+	if len(TextAreaMemory[layerAlias]) == 0 {
+		delete(TextAreaMemory, layerAlias)
+	}
+}
@@ -0,0 +1,22 @@
+package memory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAddTextArea(test *testing.T) {
+	InitializeTextAreaMemory()
+	AddTextArea("layerAlias1", "textAreaAlias1", NewTuiStyleEntry(), 1, 2, 10, 5, 100, true, false)
+	assert.Truef(test, IsTextAreaExists("layerAlias1", "textAreaAlias1"), "The added text area could not be found.")
+	assert.Truef(test, GetTextArea("layerAlias1", "textAreaAlias1").IsWordWrapEnabled, "The text area settings do not match what was expected.")
+	assert.Panicsf(test, func() { GetTextArea("layerAlias1", "textAreaAlias2") }, "Getting a text area which does not exist did not panic.")
+}
+
+func TestDeleteTextArea(test *testing.T) {
+	InitializeTextAreaMemory()
+	AddTextArea("layerAlias1", "textAreaAlias1", NewTuiStyleEntry(), 1, 2, 10, 5, 100, true, false)
+	DeleteTextArea("layerAlias1", "textAreaAlias1")
+	assert.Falsef(test, IsTextAreaExists("layerAlias1", "textAreaAlias1"), "The deleted text area could still be found.")
+	assert.Equalf(test, 0, len(TextAreaMemory), "An empty layer was left behind in text area memory.")
+}
@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var TextFieldMemory map[string]map[string]*TextFieldEntryType
+
+func InitializeTextFieldMemory() {
+	TextFieldMemory = make(map[string]map[string]*TextFieldEntryType)
+}
+
+func AddTextField(layerAlias string, textFieldAlias string, styleEntry TuiStyleEntryType, xLocation int, yLocation int, width int, maxLengthAllowed int, isPasswordProtected bool, defaultValue string) {
+	textFieldEntry := NewTextFieldEntry()
+	textFieldEntry.StyleEntry = styleEntry
+	textFieldEntry.TextFieldAlias = textFieldAlias
+	textFieldEntry.XLocation = xLocation
+	textFieldEntry.YLocation = yLocation
+	textFieldEntry.Width = width
+	textFieldEntry.MaxLengthAllowed = maxLengthAllowed
+	textFieldEntry.IsPasswordProtected = isPasswordProtected
+	textFieldEntry.CurrentValue = defaultValue
+	if TextFieldMemory[layerAlias] == nil {
+		TextFieldMemory[layerAlias] = make(map[string]*TextFieldEntryType)
+	}
+	TextFieldMemory[layerAlias][textFieldAlias] = &textFieldEntry
+}
+
+func GetTextField(layerAlias string, textFieldAlias string) *TextFieldEntryType {
+	if !IsTextFieldExists(layerAlias, textFieldAlias) {
+		panic(fmt.Sprintf("The requested text field with alias '%s' on layer '%s' could not be returned since it does not exist.", textFieldAlias, layerAlias))
+	}
+	return TextFieldMemory[layerAlias][textFieldAlias]
+}
+
+func IsTextFieldExists(layerAlias string, textFieldAlias string) bool {
+	if _, isExist := TextFieldMemory[layerAlias][textFieldAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteTextField(layerAlias string, textFieldAlias string) {
+	delete(TextFieldMemory[layerAlias], textFieldAlias)
+	if len(TextFieldMemory[layerAlias]) == 0 {
+		delete(TextFieldMemory, layerAlias)
+	}
+}
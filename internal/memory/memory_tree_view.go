@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var TreeViewMemory map[string]map[string]*TreeViewEntryType
+
+func InitializeTreeViewMemory() {
+	TreeViewMemory = make(map[string]map[string]*TreeViewEntryType)
+}
+
+func AddTreeView(layerAlias string, treeViewAlias string, styleEntry TuiStyleEntryType, xLocation int, yLocation int, width int, height int, loadChildrenCallback func(nodeAlias string)) {
+	treeViewEntry := NewTreeViewEntry()
+	treeViewEntry.StyleEntry = styleEntry
+	treeViewEntry.TreeViewAlias = treeViewAlias
+	treeViewEntry.XLocation = xLocation
+	treeViewEntry.YLocation = yLocation
+	treeViewEntry.Width = width
+	treeViewEntry.Height = height
+	treeViewEntry.LoadChildrenCallback = loadChildrenCallback
+	if TreeViewMemory[layerAlias] == nil {
+		TreeViewMemory[layerAlias] = make(map[string]*TreeViewEntryType)
+	}
+	TreeViewMemory[layerAlias][treeViewAlias] = &treeViewEntry
+}
+
+func GetTreeView(layerAlias string, treeViewAlias string) *TreeViewEntryType {
+	if !IsTreeViewExists(layerAlias, treeViewAlias) {
+		panic(fmt.Sprintf("The requested tree view with alias '%s' on layer '%s' could not be returned since it does not exist.", treeViewAlias, layerAlias))
+	}
+	return TreeViewMemory[layerAlias][treeViewAlias]
+}
+
+func IsTreeViewExists(layerAlias string, treeViewAlias string) bool {
+	if _, isExist := TreeViewMemory[layerAlias][treeViewAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteTreeView(layerAlias string, treeViewAlias string) {
+	delete(TreeViewMemory[layerAlias], treeViewAlias)
+	if len(TreeViewMemory[layerAlias]) == 0 {
+		delete(TreeViewMemory, layerAlias)
+	}
+}
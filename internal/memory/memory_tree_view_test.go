@@ -0,0 +1,22 @@
+package memory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAddTreeView(test *testing.T) {
+	InitializeTreeViewMemory()
+	AddTreeView("layerAlias1", "treeViewAlias1", NewTuiStyleEntry(), 1, 2, 20, 10, nil)
+	assert.Truef(test, IsTreeViewExists("layerAlias1", "treeViewAlias1"), "The added tree view could not be found.")
+	assert.Equalf(test, 20, GetTreeView("layerAlias1", "treeViewAlias1").Width, "The tree view settings do not match what was expected.")
+	assert.Panicsf(test, func() { GetTreeView("layerAlias1", "treeViewAlias2") }, "Getting a tree view which does not exist did not panic.")
+}
+
+func TestDeleteTreeView(test *testing.T) {
+	InitializeTreeViewMemory()
+	AddTreeView("layerAlias1", "treeViewAlias1", NewTuiStyleEntry(), 1, 2, 20, 10, nil)
+	DeleteTreeView("layerAlias1", "treeViewAlias1")
+	assert.Falsef(test, IsTreeViewExists("layerAlias1", "treeViewAlias1"), "The deleted tree view could still be found.")
+	assert.Equalf(test, 0, len(TreeViewMemory), "An empty layer was left behind in tree view memory.")
+}
@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var ViewportMemory map[string]*ViewportEntryType
+
+func InitializeViewportMemory() {
+	ViewportMemory = make(map[string]*ViewportEntryType)
+}
+
+func AddViewport(viewportAlias string, layerAlias string, styleEntry TuiStyleEntryType, isVerticalScrollBarShown bool, isHorizontalScrollBarShown bool) {
+	viewportEntry := NewViewportEntry()
+	viewportEntry.StyleEntry = styleEntry
+	viewportEntry.ViewportAlias = viewportAlias
+	viewportEntry.LayerAlias = layerAlias
+	viewportEntry.IsVerticalScrollBarShown = isVerticalScrollBarShown
+	viewportEntry.IsHorizontalScrollBarShown = isHorizontalScrollBarShown
+	ViewportMemory[viewportAlias] = &viewportEntry
+}
+
+func GetViewport(viewportAlias string) *ViewportEntryType {
+	if !IsViewportExists(viewportAlias) {
+		panic(fmt.Sprintf("The requested viewport with alias '%s' could not be returned since it does not exist.", viewportAlias))
+	}
+	return ViewportMemory[viewportAlias]
+}
+
+func IsViewportExists(viewportAlias string) bool {
+	if _, isExist := ViewportMemory[viewportAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteViewport(viewportAlias string) {
+	delete(ViewportMemory, viewportAlias)
+}
@@ -0,0 +1,37 @@
+package memory
+
+import (
+	"fmt"
+)
+
+var WindowMemory map[string]*WindowEntryType
+
+func InitializeWindowMemory() {
+	WindowMemory = make(map[string]*WindowEntryType)
+}
+
+func AddWindow(windowAlias string, windowTitle string, styleEntry TuiStyleEntryType) {
+	windowEntry := NewWindowEntry()
+	windowEntry.StyleEntry = styleEntry
+	windowEntry.WindowAlias = windowAlias
+	windowEntry.WindowTitle = windowTitle
+	WindowMemory[windowAlias] = &windowEntry
+}
+
+func GetWindow(windowAlias string) *WindowEntryType {
+	if !IsWindowExists(windowAlias) {
+		panic(fmt.Sprintf("The requested window with alias '%s' could not be returned since it does not exist.", windowAlias))
+	}
+	return WindowMemory[windowAlias]
+}
+
+func IsWindowExists(windowAlias string) bool {
+	if _, isExist := WindowMemory[windowAlias]; isExist {
+		return true
+	}
+	return false
+}
+
+func DeleteWindow(windowAlias string) {
+	delete(WindowMemory, windowAlias)
+}
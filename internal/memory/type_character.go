@@ -5,18 +5,21 @@ import (
 )
 
 type CharacterEntryType struct {
-	Character      rune
-	AttributeEntry AttributeEntryType
-	LayerAlias     string
+	Character           rune
+	CombiningCharacters string
+	AttributeEntry      AttributeEntryType
+	LayerAlias          string
 }
 
 func (shared CharacterEntryType) MarshalJSON() ([]byte, error) {
 	j, err := json.Marshal(struct {
 		Character      rune
+		CombiningCharacters string
 		AttributeEntry AttributeEntryType
 		LayerAlias     string
 	}{
 		Character: shared.Character,
+		CombiningCharacters: shared.CombiningCharacters,
 		AttributeEntry: shared.AttributeEntry,
 		LayerAlias: shared.LayerAlias,
 	})
@@ -38,6 +41,7 @@ func NewCharacterEntry(existingCharacterEntry ...*CharacterEntryType) CharacterE
 	var characterEntry CharacterEntryType
 	if existingCharacterEntry != nil {
 		characterEntry.Character = existingCharacterEntry[0].Character
+		characterEntry.CombiningCharacters = existingCharacterEntry[0].CombiningCharacters
 		characterEntry.AttributeEntry = NewAttributeEntry(&existingCharacterEntry[0].AttributeEntry)
 		characterEntry.LayerAlias = existingCharacterEntry[0].LayerAlias
 	} else {
@@ -15,6 +15,7 @@ func TestCharacterTypeCreation(test *testing.T) {
 	firstColorObject.IsReversed = false
 	firstColorObject.IsUnderlined = true
 	firstCharacterObject.Character = rune('A')
+	firstCharacterObject.CombiningCharacters = "\u0301"
 	firstCharacterObject.AttributeEntry = firstColorObject
 	secondCharacterObject := NewCharacterEntry(&firstCharacterObject)
 	assert.Equalf(test, secondCharacterObject, firstCharacterObject, "The second Character object should be the same as the first, as it was created as a copy")
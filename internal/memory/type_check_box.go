@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type CheckBoxEntryType struct {
+	StyleEntry     TuiStyleEntryType
+	CheckBoxAlias  string
+	Label          string
+	XLocation      int
+	YLocation      int
+	IsChecked      bool
+	IsToggleSwitch bool
+	IsFocused      bool
+}
+
+func (shared CheckBoxEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		CheckBoxAlias string
+		Label string
+		XLocation int
+		YLocation int
+		IsChecked bool
+		IsToggleSwitch bool
+		IsFocused bool
+	}{
+		StyleEntry: shared.StyleEntry,
+		CheckBoxAlias: shared.CheckBoxAlias,
+		Label: shared.Label,
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		IsChecked: shared.IsChecked,
+		IsToggleSwitch: shared.IsToggleSwitch,
+		IsFocused: shared.IsFocused,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared CheckBoxEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewCheckBoxEntry(existingCheckBoxEntry ...*CheckBoxEntryType) CheckBoxEntryType {
+	var checkBoxEntry CheckBoxEntryType
+	if existingCheckBoxEntry != nil {
+		checkBoxEntry.StyleEntry = NewTuiStyleEntry(&existingCheckBoxEntry[0].StyleEntry)
+		checkBoxEntry.CheckBoxAlias = existingCheckBoxEntry[0].CheckBoxAlias
+		checkBoxEntry.Label = existingCheckBoxEntry[0].Label
+		checkBoxEntry.XLocation = existingCheckBoxEntry[0].XLocation
+		checkBoxEntry.YLocation = existingCheckBoxEntry[0].YLocation
+		checkBoxEntry.IsChecked = existingCheckBoxEntry[0].IsChecked
+		checkBoxEntry.IsToggleSwitch = existingCheckBoxEntry[0].IsToggleSwitch
+		checkBoxEntry.IsFocused = existingCheckBoxEntry[0].IsFocused
+	}
+	return checkBoxEntry
+}
@@ -0,0 +1,23 @@
+package memory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCheckBoxTypeCreation(test *testing.T) {
+	firstCheckBoxEntry := NewCheckBoxEntry()
+	firstCheckBoxEntry.StyleEntry = NewTuiStyleEntry()
+	firstCheckBoxEntry.CheckBoxAlias = "MyCheckBox"
+	firstCheckBoxEntry.Label = "Label"
+	firstCheckBoxEntry.XLocation = 1
+	firstCheckBoxEntry.YLocation = 2
+	firstCheckBoxEntry.IsChecked = true
+	firstCheckBoxEntry.IsToggleSwitch = true
+	firstCheckBoxEntry.IsFocused = true
+	secondCheckBoxEntry := NewCheckBoxEntry()
+	assert.NotEqualf(test, secondCheckBoxEntry, firstCheckBoxEntry, "The second check box entry should not be the same as the first, as manipulating it should only effect itself.")
+
+	secondCheckBoxEntry = NewCheckBoxEntry(&firstCheckBoxEntry)
+	assert.Equalf(test, secondCheckBoxEntry, firstCheckBoxEntry, "The first check box entry is not the same as the second, even though it should be an identical clone.")
+}
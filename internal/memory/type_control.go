@@ -0,0 +1,49 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type ControlEntryType struct {
+	LayerAlias   string
+	ControlAlias string
+	ControlType  int
+	TabIndex     int
+}
+
+func (shared ControlEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		LayerAlias string
+		ControlAlias string
+		ControlType int
+		TabIndex int
+	}{
+		LayerAlias: shared.LayerAlias,
+		ControlAlias: shared.ControlAlias,
+		ControlType: shared.ControlType,
+		TabIndex: shared.TabIndex,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared ControlEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewControlEntry(existingControlEntry ...*ControlEntryType) ControlEntryType {
+	var controlEntry ControlEntryType
+	if existingControlEntry != nil {
+		controlEntry.LayerAlias = existingControlEntry[0].LayerAlias
+		controlEntry.ControlAlias = existingControlEntry[0].ControlAlias
+		controlEntry.ControlType = existingControlEntry[0].ControlType
+		controlEntry.TabIndex = existingControlEntry[0].TabIndex
+	}
+	return controlEntry
+}
@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetControlEntry(test *testing.T) {
+	firstControlEntry := NewControlEntry()
+	secondControlEntry := NewControlEntry()
+	secondControlEntry.LayerAlias = "MyLayer"
+	secondControlEntry.ControlAlias = "MyControl"
+	secondControlEntry.ControlType = 1
+	secondControlEntry.TabIndex = 2
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstControlEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondControlEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first control entry is the same as the second, even though it should be different.")
+
+	firstControlEntry = NewControlEntry(&secondControlEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstControlEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first control entry is not the same as the second, even though it should be an identical clone.")
+}
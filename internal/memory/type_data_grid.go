@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type DataGridColumnEntryType struct {
+	Header       string
+	WidthType    int
+	Width        int
+	Alignment    int
+	ContentWidth int
+}
+
+type DataGridEntryType struct {
+	StyleEntry       TuiStyleEntryType
+	DataGridAlias    string
+	XLocation        int
+	YLocation        int
+	Width            int
+	Height           int
+	IsFocused        bool
+	Columns          []DataGridColumnEntryType
+	Rows             [][]string
+	RowOrder         []int
+	RowHighlighted   int
+	ViewportPosition int
+	SortColumn       int
+	IsSortDescending bool
+}
+
+func (shared DataGridEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		DataGridAlias string
+		XLocation int
+		YLocation int
+		Width int
+		Height int
+		IsFocused bool
+		Columns []DataGridColumnEntryType
+		Rows [][]string
+		RowOrder []int
+		RowHighlighted int
+		ViewportPosition int
+		SortColumn int
+		IsSortDescending bool
+	}{
+		StyleEntry: shared.StyleEntry,
+		DataGridAlias: shared.DataGridAlias,
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		Width: shared.Width,
+		Height: shared.Height,
+		IsFocused: shared.IsFocused,
+		Columns: shared.Columns,
+		Rows: shared.Rows,
+		RowOrder: shared.RowOrder,
+		RowHighlighted: shared.RowHighlighted,
+		ViewportPosition: shared.ViewportPosition,
+		SortColumn: shared.SortColumn,
+		IsSortDescending: shared.IsSortDescending,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared DataGridEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewDataGridEntry(existingDataGridEntry ...*DataGridEntryType) DataGridEntryType {
+	var dataGridEntry DataGridEntryType
+	if existingDataGridEntry != nil {
+		dataGridEntry.StyleEntry = NewTuiStyleEntry(&existingDataGridEntry[0].StyleEntry)
+		dataGridEntry.DataGridAlias = existingDataGridEntry[0].DataGridAlias
+		dataGridEntry.XLocation = existingDataGridEntry[0].XLocation
+		dataGridEntry.YLocation = existingDataGridEntry[0].YLocation
+		dataGridEntry.Width = existingDataGridEntry[0].Width
+		dataGridEntry.Height = existingDataGridEntry[0].Height
+		dataGridEntry.IsFocused = existingDataGridEntry[0].IsFocused
+		dataGridEntry.Columns = append([]DataGridColumnEntryType(nil), existingDataGridEntry[0].Columns...)
+		for _, currentRow := range existingDataGridEntry[0].Rows {
+			dataGridEntry.Rows = append(dataGridEntry.Rows, append([]string(nil), currentRow...))
+		}
+		dataGridEntry.RowOrder = append([]int(nil), existingDataGridEntry[0].RowOrder...)
+		dataGridEntry.RowHighlighted = existingDataGridEntry[0].RowHighlighted
+		dataGridEntry.ViewportPosition = existingDataGridEntry[0].ViewportPosition
+		dataGridEntry.SortColumn = existingDataGridEntry[0].SortColumn
+		dataGridEntry.IsSortDescending = existingDataGridEntry[0].IsSortDescending
+	}
+	return dataGridEntry
+}
@@ -0,0 +1,36 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetDataGridEntry(test *testing.T) {
+	firstDataGridEntry := NewDataGridEntry()
+	secondDataGridEntry := NewDataGridEntry()
+	secondDataGridEntry.StyleEntry = NewTuiStyleEntry()
+	secondDataGridEntry.DataGridAlias = "MyDataGrid"
+	secondDataGridEntry.XLocation = 1
+	secondDataGridEntry.YLocation = 2
+	secondDataGridEntry.Width = 3
+	secondDataGridEntry.Height = 4
+	secondDataGridEntry.IsFocused = true
+	secondDataGridEntry.Columns = []DataGridColumnEntryType{{"Header", 1, 5, 2, 6}}
+	secondDataGridEntry.Rows = [][]string{{"Cell"}}
+	secondDataGridEntry.RowOrder = []int{0}
+	secondDataGridEntry.RowHighlighted = 7
+	secondDataGridEntry.ViewportPosition = 8
+	secondDataGridEntry.SortColumn = 9
+	secondDataGridEntry.IsSortDescending = true
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstDataGridEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondDataGridEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first data grid entry is the same as the second, even though it should be different.")
+
+	firstDataGridEntry = NewDataGridEntry(&secondDataGridEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstDataGridEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first data grid entry is not the same as the second, even though it should be an identical clone.")
+	secondDataGridEntry.Rows[0][0] = "Changed"
+	assert.Equalf(test, "Cell", firstDataGridEntry.Rows[0][0], "The rows of the cloned data grid entry were not copied.")
+}
@@ -0,0 +1,478 @@
+package memory
+
+import (
+	"encoding/json"
+	"github.com/gdamore/tcell"
+)
+
+type EventEntryType struct {
+	EventType       int
+	TimeStamp       int64
+	KeyEvent        KeyEventEntryType
+	MouseEvent      MouseEventEntryType
+	ResizeEvent     ResizeEventEntryType
+	FocusEvent      FocusEventEntryType
+	PasteEvent      PasteEventEntryType
+	TimerEvent      TimerEventEntryType
+	KeyBindingEvent KeyBindingEventEntryType
+	WindowEvent     WindowEventEntryType
+	ControlEvent    ControlEventEntryType
+}
+
+func (shared EventEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		EventType int
+		TimeStamp int64
+		KeyEvent KeyEventEntryType
+		MouseEvent MouseEventEntryType
+		ResizeEvent ResizeEventEntryType
+		FocusEvent FocusEventEntryType
+		PasteEvent PasteEventEntryType
+		TimerEvent TimerEventEntryType
+		KeyBindingEvent KeyBindingEventEntryType
+		WindowEvent WindowEventEntryType
+		ControlEvent ControlEventEntryType
+	}{
+		EventType: shared.EventType,
+		TimeStamp: shared.TimeStamp,
+		KeyEvent: shared.KeyEvent,
+		MouseEvent: shared.MouseEvent,
+		ResizeEvent: shared.ResizeEvent,
+		FocusEvent: shared.FocusEvent,
+		PasteEvent: shared.PasteEvent,
+		TimerEvent: shared.TimerEvent,
+		KeyBindingEvent: shared.KeyBindingEvent,
+		WindowEvent: shared.WindowEvent,
+		ControlEvent: shared.ControlEvent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared EventEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewEventEntry(existingEventEntry ...*EventEntryType) EventEntryType {
+	var eventEntry EventEntryType
+	if existingEventEntry != nil {
+		eventEntry.EventType = existingEventEntry[0].EventType
+		eventEntry.TimeStamp = existingEventEntry[0].TimeStamp
+		eventEntry.KeyEvent = NewKeyEventEntry(&existingEventEntry[0].KeyEvent)
+		eventEntry.MouseEvent = NewMouseEventEntry(&existingEventEntry[0].MouseEvent)
+		eventEntry.ResizeEvent = NewResizeEventEntry(&existingEventEntry[0].ResizeEvent)
+		eventEntry.FocusEvent = NewFocusEventEntry(&existingEventEntry[0].FocusEvent)
+		eventEntry.PasteEvent = NewPasteEventEntry(&existingEventEntry[0].PasteEvent)
+		eventEntry.TimerEvent = NewTimerEventEntry(&existingEventEntry[0].TimerEvent)
+		eventEntry.KeyBindingEvent = NewKeyBindingEventEntry(&existingEventEntry[0].KeyBindingEvent)
+		eventEntry.WindowEvent = NewWindowEventEntry(&existingEventEntry[0].WindowEvent)
+		eventEntry.ControlEvent = NewControlEventEntry(&existingEventEntry[0].ControlEvent)
+	}
+	return eventEntry
+}
+
+type KeyEventEntryType struct {
+	Keystroke    string
+	Chord        string
+	Key          tcell.Key
+	Character    rune
+	ModifierMask tcell.ModMask
+	LayerAlias   string
+	ControlAlias string
+}
+
+func (shared KeyEventEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		Keystroke string
+		Chord string
+		Key tcell.Key
+		Character rune
+		ModifierMask tcell.ModMask
+		LayerAlias string
+		ControlAlias string
+	}{
+		Keystroke: shared.Keystroke,
+		Chord: shared.Chord,
+		Key: shared.Key,
+		Character: shared.Character,
+		ModifierMask: shared.ModifierMask,
+		LayerAlias: shared.LayerAlias,
+		ControlAlias: shared.ControlAlias,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared KeyEventEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewKeyEventEntry(existingKeyEventEntry ...*KeyEventEntryType) KeyEventEntryType {
+	var keyEventEntry KeyEventEntryType
+	if existingKeyEventEntry != nil {
+		keyEventEntry.Keystroke = existingKeyEventEntry[0].Keystroke
+		keyEventEntry.Chord = existingKeyEventEntry[0].Chord
+		keyEventEntry.Key = existingKeyEventEntry[0].Key
+		keyEventEntry.Character = existingKeyEventEntry[0].Character
+		keyEventEntry.ModifierMask = existingKeyEventEntry[0].ModifierMask
+		keyEventEntry.LayerAlias = existingKeyEventEntry[0].LayerAlias
+		keyEventEntry.ControlAlias = existingKeyEventEntry[0].ControlAlias
+	}
+	return keyEventEntry
+}
+
+type MouseEventEntryType struct {
+	XLocation      int
+	YLocation      int
+	ButtonPressed  uint
+	WheelState     string
+	Action         int
+	StartXLocation int
+	StartYLocation int
+	ClickCount     int
+	ModifierMask   tcell.ModMask
+	LayerAlias     string
+}
+
+func (shared MouseEventEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		XLocation int
+		YLocation int
+		ButtonPressed uint
+		WheelState string
+		Action int
+		StartXLocation int
+		StartYLocation int
+		ClickCount int
+		ModifierMask tcell.ModMask
+		LayerAlias string
+	}{
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		ButtonPressed: shared.ButtonPressed,
+		WheelState: shared.WheelState,
+		Action: shared.Action,
+		StartXLocation: shared.StartXLocation,
+		StartYLocation: shared.StartYLocation,
+		ClickCount: shared.ClickCount,
+		ModifierMask: shared.ModifierMask,
+		LayerAlias: shared.LayerAlias,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared MouseEventEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewMouseEventEntry(existingMouseEventEntry ...*MouseEventEntryType) MouseEventEntryType {
+	var mouseEventEntry MouseEventEntryType
+	if existingMouseEventEntry != nil {
+		mouseEventEntry.XLocation = existingMouseEventEntry[0].XLocation
+		mouseEventEntry.YLocation = existingMouseEventEntry[0].YLocation
+		mouseEventEntry.ButtonPressed = existingMouseEventEntry[0].ButtonPressed
+		mouseEventEntry.WheelState = existingMouseEventEntry[0].WheelState
+		mouseEventEntry.Action = existingMouseEventEntry[0].Action
+		mouseEventEntry.StartXLocation = existingMouseEventEntry[0].StartXLocation
+		mouseEventEntry.StartYLocation = existingMouseEventEntry[0].StartYLocation
+		mouseEventEntry.ClickCount = existingMouseEventEntry[0].ClickCount
+		mouseEventEntry.ModifierMask = existingMouseEventEntry[0].ModifierMask
+		mouseEventEntry.LayerAlias = existingMouseEventEntry[0].LayerAlias
+	}
+	return mouseEventEntry
+}
+
+type ResizeEventEntryType struct {
+	Width  int
+	Height int
+}
+
+func (shared ResizeEventEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		Width int
+		Height int
+	}{
+		Width: shared.Width,
+		Height: shared.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared ResizeEventEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewResizeEventEntry(existingResizeEventEntry ...*ResizeEventEntryType) ResizeEventEntryType {
+	var resizeEventEntry ResizeEventEntryType
+	if existingResizeEventEntry != nil {
+		resizeEventEntry.Width = existingResizeEventEntry[0].Width
+		resizeEventEntry.Height = existingResizeEventEntry[0].Height
+	}
+	return resizeEventEntry
+}
+
+type FocusEventEntryType struct {
+	IsFocused    bool
+	LayerAlias   string
+	ControlAlias string
+}
+
+func (shared FocusEventEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		IsFocused bool
+		LayerAlias string
+		ControlAlias string
+	}{
+		IsFocused: shared.IsFocused,
+		LayerAlias: shared.LayerAlias,
+		ControlAlias: shared.ControlAlias,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared FocusEventEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewFocusEventEntry(existingFocusEventEntry ...*FocusEventEntryType) FocusEventEntryType {
+	var focusEventEntry FocusEventEntryType
+	if existingFocusEventEntry != nil {
+		focusEventEntry.IsFocused = existingFocusEventEntry[0].IsFocused
+		focusEventEntry.LayerAlias = existingFocusEventEntry[0].LayerAlias
+		focusEventEntry.ControlAlias = existingFocusEventEntry[0].ControlAlias
+	}
+	return focusEventEntry
+}
+
+type PasteEventEntryType struct {
+	Text string
+}
+
+func (shared PasteEventEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		Text string
+	}{
+		Text: shared.Text,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared PasteEventEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewPasteEventEntry(existingPasteEventEntry ...*PasteEventEntryType) PasteEventEntryType {
+	var pasteEventEntry PasteEventEntryType
+	if existingPasteEventEntry != nil {
+		pasteEventEntry.Text = existingPasteEventEntry[0].Text
+	}
+	return pasteEventEntry
+}
+
+type TimerEventEntryType struct {
+	TimerAlias string
+}
+
+func (shared TimerEventEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		TimerAlias string
+	}{
+		TimerAlias: shared.TimerAlias,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared TimerEventEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewTimerEventEntry(existingTimerEventEntry ...*TimerEventEntryType) TimerEventEntryType {
+	var timerEventEntry TimerEventEntryType
+	if existingTimerEventEntry != nil {
+		timerEventEntry.TimerAlias = existingTimerEventEntry[0].TimerAlias
+	}
+	return timerEventEntry
+}
+
+type KeyBindingEventEntryType struct {
+	BindingAlias string
+	KeySequence  string
+}
+
+func (shared KeyBindingEventEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		BindingAlias string
+		KeySequence string
+	}{
+		BindingAlias: shared.BindingAlias,
+		KeySequence: shared.KeySequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared KeyBindingEventEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewKeyBindingEventEntry(existingKeyBindingEventEntry ...*KeyBindingEventEntryType) KeyBindingEventEntryType {
+	var keyBindingEventEntry KeyBindingEventEntryType
+	if existingKeyBindingEventEntry != nil {
+		keyBindingEventEntry.BindingAlias = existingKeyBindingEventEntry[0].BindingAlias
+		keyBindingEventEntry.KeySequence = existingKeyBindingEventEntry[0].KeySequence
+	}
+	return keyBindingEventEntry
+}
+
+type WindowEventEntryType struct {
+	WindowAlias string
+	Action      int
+	XLocation   int
+	YLocation   int
+	Width       int
+	Height      int
+}
+
+func (shared WindowEventEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		WindowAlias string
+		Action int
+		XLocation int
+		YLocation int
+		Width int
+		Height int
+	}{
+		WindowAlias: shared.WindowAlias,
+		Action: shared.Action,
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		Width: shared.Width,
+		Height: shared.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared WindowEventEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewWindowEventEntry(existingWindowEventEntry ...*WindowEventEntryType) WindowEventEntryType {
+	var windowEventEntry WindowEventEntryType
+	if existingWindowEventEntry != nil {
+		windowEventEntry.WindowAlias = existingWindowEventEntry[0].WindowAlias
+		windowEventEntry.Action = existingWindowEventEntry[0].Action
+		windowEventEntry.XLocation = existingWindowEventEntry[0].XLocation
+		windowEventEntry.YLocation = existingWindowEventEntry[0].YLocation
+		windowEventEntry.Width = existingWindowEventEntry[0].Width
+		windowEventEntry.Height = existingWindowEventEntry[0].Height
+	}
+	return windowEventEntry
+}
+
+type ControlEventEntryType struct {
+	LayerAlias   string
+	ControlAlias string
+	ControlType  int
+	Action       int
+	ItemAlias    string
+}
+
+func (shared ControlEventEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		LayerAlias string
+		ControlAlias string
+		ControlType int
+		Action int
+		ItemAlias string
+	}{
+		LayerAlias: shared.LayerAlias,
+		ControlAlias: shared.ControlAlias,
+		ControlType: shared.ControlType,
+		Action: shared.Action,
+		ItemAlias: shared.ItemAlias,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared ControlEventEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewControlEventEntry(existingControlEventEntry ...*ControlEventEntryType) ControlEventEntryType {
+	var controlEventEntry ControlEventEntryType
+	if existingControlEventEntry != nil {
+		controlEventEntry.LayerAlias = existingControlEventEntry[0].LayerAlias
+		controlEventEntry.ControlAlias = existingControlEventEntry[0].ControlAlias
+		controlEventEntry.ControlType = existingControlEventEntry[0].ControlType
+		controlEventEntry.Action = existingControlEventEntry[0].Action
+		controlEventEntry.ItemAlias = existingControlEventEntry[0].ItemAlias
+	}
+	return controlEventEntry
+}
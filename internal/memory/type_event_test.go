@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetEventEntry(test *testing.T) {
+	firstEventEntry := NewEventEntry()
+	secondEventEntry := NewEventEntry()
+	secondEventEntry.EventType = 2
+	secondEventEntry.TimeStamp = 100
+	secondEventEntry.KeyEvent.Keystroke = "a"
+	secondEventEntry.KeyEvent.Chord = "ctrl+a"
+	secondEventEntry.KeyEvent.ModifierMask = 2
+	secondEventEntry.MouseEvent.XLocation = 5
+	secondEventEntry.MouseEvent.Action = 3
+	secondEventEntry.MouseEvent.StartXLocation = 2
+	secondEventEntry.MouseEvent.ClickCount = 2
+	secondEventEntry.MouseEvent.ModifierMask = tcell.ModCtrl
+	secondEventEntry.MouseEvent.LayerAlias = "MyLayer"
+	secondEventEntry.ResizeEvent.Width = 80
+	secondEventEntry.FocusEvent.IsFocused = true
+	secondEventEntry.FocusEvent.ControlAlias = "MyControl"
+	secondEventEntry.ControlEvent.ControlAlias = "MyControl"
+	secondEventEntry.ControlEvent.ItemAlias = "MyItem"
+	secondEventEntry.PasteEvent.Text = "Hello"
+	secondEventEntry.TimerEvent.TimerAlias = "MyTimer"
+	secondEventEntry.KeyBindingEvent.BindingAlias = "MyBinding"
+	secondEventEntry.WindowEvent.WindowAlias = "MyWindow"
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstEventEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondEventEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first event entry is the same as the second, even though it should be different.")
+
+	firstEventEntry = NewEventEntry(&secondEventEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstEventEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first event entry is not the same as the second, even though it should be an identical clone.")
+}
@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"encoding/json"
+	"regexp"
+)
+
+type InputValidatorEntryType struct {
+	ValidatorType      int
+	MinimumValue       int
+	MaximumValue       int
+	Pattern            string
+	CompiledPattern    *regexp.Regexp
+	PartialPattern     *regexp.Regexp
+	ValidationFunction func(value string, isValueComplete bool) bool
+}
+
+func (shared InputValidatorEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		ValidatorType int
+		MinimumValue int
+		MaximumValue int
+		Pattern string
+	}{
+		ValidatorType: shared.ValidatorType,
+		MinimumValue: shared.MinimumValue,
+		MaximumValue: shared.MaximumValue,
+		Pattern: shared.Pattern,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared InputValidatorEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewInputValidatorEntry(existingInputValidatorEntry ...*InputValidatorEntryType) InputValidatorEntryType {
+	var inputValidatorEntry InputValidatorEntryType
+	if existingInputValidatorEntry != nil {
+		inputValidatorEntry.ValidatorType = existingInputValidatorEntry[0].ValidatorType
+		inputValidatorEntry.MinimumValue = existingInputValidatorEntry[0].MinimumValue
+		inputValidatorEntry.MaximumValue = existingInputValidatorEntry[0].MaximumValue
+		inputValidatorEntry.Pattern = existingInputValidatorEntry[0].Pattern
+		inputValidatorEntry.CompiledPattern = existingInputValidatorEntry[0].CompiledPattern
+		inputValidatorEntry.PartialPattern = existingInputValidatorEntry[0].PartialPattern
+		inputValidatorEntry.ValidationFunction = existingInputValidatorEntry[0].ValidationFunction
+	}
+	return inputValidatorEntry
+}
@@ -0,0 +1,26 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"testing"
+)
+
+func TestGetInputValidatorEntry(test *testing.T) {
+	firstInputValidatorEntry := NewInputValidatorEntry()
+	secondInputValidatorEntry := NewInputValidatorEntry()
+	secondInputValidatorEntry.ValidatorType = constants.ValidatorTypeIntegerRange
+	secondInputValidatorEntry.MinimumValue = 1
+	secondInputValidatorEntry.MaximumValue = 2
+	secondInputValidatorEntry.Pattern = "Pattern"
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstInputValidatorEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondInputValidatorEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first input validator entry is the same as the second, even though it should be different.")
+
+	firstInputValidatorEntry = NewInputValidatorEntry(&secondInputValidatorEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstInputValidatorEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first input validator entry is not the same as the second, even though it should be an identical clone.")
+	assert.Equalf(test, `{"ValidatorType":2,"MinimumValue":1,"MaximumValue":2,"Pattern":"Pattern"}`, firstInputValidatorEntry.GetEntryAsJsonDump(), "The input validator entry was not converted to JSON correctly.")
+}
@@ -0,0 +1,43 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type KeyBindingEntryType struct {
+	BindingAlias string
+	KeySequence  []string
+	Callback     func()
+}
+
+func (shared KeyBindingEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		BindingAlias string
+		KeySequence []string
+	}{
+		BindingAlias: shared.BindingAlias,
+		KeySequence: shared.KeySequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared KeyBindingEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewKeyBindingEntry(existingKeyBindingEntry ...*KeyBindingEntryType) KeyBindingEntryType {
+	var keyBindingEntry KeyBindingEntryType
+	if existingKeyBindingEntry != nil {
+		keyBindingEntry.BindingAlias = existingKeyBindingEntry[0].BindingAlias
+		keyBindingEntry.KeySequence = append([]string(nil), existingKeyBindingEntry[0].KeySequence...)
+		keyBindingEntry.Callback = existingKeyBindingEntry[0].Callback
+	}
+	return keyBindingEntry
+}
@@ -21,6 +21,8 @@ type LayerEntryType struct {
 	IsParent         bool
 	DefaultAttribute AttributeEntryType
 	CharacterMemory  [][]CharacterEntryType
+	LayoutEntry      LayoutEntryType
+	DirtyRegion      RegionEntryType
 }
 
 func (shared LayerEntryType) MarshalJSON() ([]byte, error) {
@@ -128,6 +130,48 @@ func (shared LayerEntryType) GetEntryAsJsonDump() string {
 	return string(j)
 }
 
+/*
+MarkDirtyRegion allows you to flag an area of the layer as modified, so that
+it will be redrawn the next time the display is updated. Any part of the
+area which falls outside the layer is ignored.
+*/
+func (shared *LayerEntryType) MarkDirtyRegion(xLocation int, yLocation int, width int, height int) {
+	regionEntry := NewRegionEntry(xLocation, yLocation, width, height)
+	regionEntry = regionEntry.GetIntersection(NewRegionEntry(0, 0, shared.Width, shared.Height))
+	shared.DirtyRegion = shared.DirtyRegion.GetUnion(regionEntry)
+}
+
+/*
+MarkDirty allows you to flag the entire layer as modified, so that it will
+be redrawn the next time the display is updated.
+*/
+func (shared *LayerEntryType) MarkDirty() {
+	shared.MarkDirtyRegion(0, 0, shared.Width, shared.Height)
+}
+
+/*
+Resize allows you to change the dimensions of a layer. Any existing content
+which still fits inside the new dimensions is preserved, while newly exposed
+cells are left empty. If the cursor falls outside the new dimensions, it is
+moved to the nearest valid location.
+*/
+func (shared *LayerEntryType) Resize(width int, height int) {
+	resizedLayerEntry := NewLayerEntry(width, height)
+	for currentRow := 0; currentRow < height && currentRow < shared.Height; currentRow++ {
+		copy(resizedLayerEntry.CharacterMemory[currentRow], shared.CharacterMemory[currentRow])
+	}
+	shared.Width = width
+	shared.Height = height
+	shared.CharacterMemory = resizedLayerEntry.CharacterMemory
+	if shared.CursorXLocation >= width {
+		shared.CursorXLocation = width - 1
+	}
+	if shared.CursorYLocation >= height {
+		shared.CursorYLocation = height - 1
+	}
+	shared.MarkDirty()
+}
+
 func (shared LayerEntryType) GetRGBColorComponents(color int32) (int32, int32, int32) {
 	var redColorIndex int32
 	var greenColorIndex int32
@@ -152,6 +196,7 @@ func NewLayerEntry(width int, height int, existingLayerEntry ...*LayerEntryType)
 		layerEntry.ParentAlias = existingLayerEntry[0].ParentAlias
 		layerEntry.IsParent = existingLayerEntry[0].IsParent
 		layerEntry.DefaultAttribute = existingLayerEntry[0].DefaultAttribute
+		layerEntry.LayoutEntry = NewLayoutEntry(&existingLayerEntry[0].LayoutEntry)
 		for currentRow := 0; currentRow < existingLayerEntry[0].Height; currentRow++ {
 			var characterObjectArray = make([]CharacterEntryType, existingLayerEntry[0].Width)
 			for currentCharacter := 0; currentCharacter < existingLayerEntry[0].Width; currentCharacter++ {
@@ -22,3 +22,27 @@ func TestLayerTypeCreation(test *testing.T) {
 	secondLayerEntry = NewLayerEntry(0,0, &firstLayerEntry)
 	assert.Equalf(test, secondLayerEntry, firstLayerEntry, "The first layer is not the same as the second, even though it should be an identical clone.")
 }
+
+func TestLayerTypeDirtyRegion(test *testing.T) {
+	layerEntry := NewLayerEntry(20, 10)
+	assert.Truef(test, layerEntry.DirtyRegion.IsEmpty(), "A new layer entry should not have a dirty region.")
+	layerEntry.MarkDirtyRegion(2, 3, 4, 1)
+	layerEntry.MarkDirtyRegion(18, 8, 10, 10)
+	assert.Equalf(test, NewRegionEntry(2, 3, 18, 7), layerEntry.DirtyRegion, "The dirty region was not accumulated and clipped correctly.")
+	layerEntry.DirtyRegion = NewRegionEntry(0, 0, 0, 0)
+	layerEntry.MarkDirty()
+	assert.Equalf(test, NewRegionEntry(0, 0, 20, 10), layerEntry.DirtyRegion, "Marking a layer as dirty should cover the entire layer.")
+}
+
+func TestLayerTypeResize(test *testing.T) {
+	layerEntry := NewLayerEntry(4, 3)
+	layerEntry.CharacterMemory[1][2].Character = 'a'
+	layerEntry.CharacterMemory[2][3].Character = 'b'
+	layerEntry.CursorXLocation = 3
+	layerEntry.CursorYLocation = 2
+	layerEntry.Resize(3, 5)
+	assert.Equalf(test, []int{3, 5, 3, 5}, []int{layerEntry.Width, layerEntry.Height, len(layerEntry.CharacterMemory[0]), len(layerEntry.CharacterMemory)}, "The layer was not resized correctly.")
+	assert.Equalf(test, 'a', layerEntry.CharacterMemory[1][2].Character, "Content inside the new dimensions was not preserved.")
+	assert.Equalf(test, []int{2, 2}, []int{layerEntry.CursorXLocation, layerEntry.CursorYLocation}, "The cursor was not moved inside the new dimensions.")
+	assert.Equalf(test, NewRegionEntry(0, 0, 3, 5), layerEntry.DirtyRegion, "A resized layer should be entirely dirty.")
+}
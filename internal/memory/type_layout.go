@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type LayoutEntryType struct {
+	IsAnchored      bool
+	AnchorType      int
+	RightMargin     int
+	BottomMargin    int
+	IsPercentLayout bool
+	XPercent        float32
+	YPercent        float32
+	WidthPercent    float32
+	HeightPercent   float32
+}
+
+func (shared LayoutEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		IsAnchored bool
+		AnchorType int
+		RightMargin int
+		BottomMargin int
+		IsPercentLayout bool
+		XPercent float32
+		YPercent float32
+		WidthPercent float32
+		HeightPercent float32
+	}{
+		IsAnchored: shared.IsAnchored,
+		AnchorType: shared.AnchorType,
+		RightMargin: shared.RightMargin,
+		BottomMargin: shared.BottomMargin,
+		IsPercentLayout: shared.IsPercentLayout,
+		XPercent: shared.XPercent,
+		YPercent: shared.YPercent,
+		WidthPercent: shared.WidthPercent,
+		HeightPercent: shared.HeightPercent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared LayoutEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewLayoutEntry(existingLayoutEntry ...*LayoutEntryType) LayoutEntryType {
+	var layoutEntry LayoutEntryType
+	if existingLayoutEntry != nil {
+		layoutEntry.IsAnchored = existingLayoutEntry[0].IsAnchored
+		layoutEntry.AnchorType = existingLayoutEntry[0].AnchorType
+		layoutEntry.RightMargin = existingLayoutEntry[0].RightMargin
+		layoutEntry.BottomMargin = existingLayoutEntry[0].BottomMargin
+		layoutEntry.IsPercentLayout = existingLayoutEntry[0].IsPercentLayout
+		layoutEntry.XPercent = existingLayoutEntry[0].XPercent
+		layoutEntry.YPercent = existingLayoutEntry[0].YPercent
+		layoutEntry.WidthPercent = existingLayoutEntry[0].WidthPercent
+		layoutEntry.HeightPercent = existingLayoutEntry[0].HeightPercent
+	}
+	return layoutEntry
+}
@@ -0,0 +1,29 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetLayoutEntry(test *testing.T) {
+	firstLayoutEntry := NewLayoutEntry()
+	secondLayoutEntry := NewLayoutEntry()
+	secondLayoutEntry.IsAnchored = true
+	secondLayoutEntry.AnchorType = 3
+	secondLayoutEntry.RightMargin = 4
+	secondLayoutEntry.BottomMargin = 5
+	secondLayoutEntry.IsPercentLayout = true
+	secondLayoutEntry.XPercent = 10
+	secondLayoutEntry.YPercent = 20
+	secondLayoutEntry.WidthPercent = 30
+	secondLayoutEntry.HeightPercent = 40
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstLayoutEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondLayoutEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first layout entry is the same as the second, even though it should be different.")
+
+	firstLayoutEntry = NewLayoutEntry(&secondLayoutEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstLayoutEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first layout entry is not the same as the second, even though it should be an identical clone.")
+}
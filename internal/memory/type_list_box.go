@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ListBoxEntryType struct {
+	StyleEntry           TuiStyleEntryType
+	ListBoxAlias         string
+	SelectionEntry       SelectionEntryType
+	XLocation            int
+	YLocation            int
+	Width                int
+	Height               int
+	IsMultiSelectEnabled bool
+	IsFocused            bool
+	ItemHighlighted      int
+	ViewportPosition     int
+	IsItemSelected       []bool
+	SearchText           string
+	SearchTime           time.Time
+}
+
+func (shared ListBoxEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		ListBoxAlias string
+		SelectionEntry SelectionEntryType
+		XLocation int
+		YLocation int
+		Width int
+		Height int
+		IsMultiSelectEnabled bool
+		IsFocused bool
+		ItemHighlighted int
+		ViewportPosition int
+		IsItemSelected []bool
+		SearchText string
+		SearchTime time.Time
+	}{
+		StyleEntry: shared.StyleEntry,
+		ListBoxAlias: shared.ListBoxAlias,
+		SelectionEntry: shared.SelectionEntry,
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		Width: shared.Width,
+		Height: shared.Height,
+		IsMultiSelectEnabled: shared.IsMultiSelectEnabled,
+		IsFocused: shared.IsFocused,
+		ItemHighlighted: shared.ItemHighlighted,
+		ViewportPosition: shared.ViewportPosition,
+		IsItemSelected: shared.IsItemSelected,
+		SearchText: shared.SearchText,
+		SearchTime: shared.SearchTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared ListBoxEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewListBoxEntry(existingListBoxEntry ...*ListBoxEntryType) ListBoxEntryType {
+	var listBoxEntry ListBoxEntryType
+	if existingListBoxEntry != nil {
+		listBoxEntry.StyleEntry = NewTuiStyleEntry(&existingListBoxEntry[0].StyleEntry)
+		listBoxEntry.ListBoxAlias = existingListBoxEntry[0].ListBoxAlias
+		listBoxEntry.SelectionEntry.SelectionAlias = append([]string(nil), existingListBoxEntry[0].SelectionEntry.SelectionAlias...)
+		listBoxEntry.SelectionEntry.SelectionValue = append([]string(nil), existingListBoxEntry[0].SelectionEntry.SelectionValue...)
+		listBoxEntry.XLocation = existingListBoxEntry[0].XLocation
+		listBoxEntry.YLocation = existingListBoxEntry[0].YLocation
+		listBoxEntry.Width = existingListBoxEntry[0].Width
+		listBoxEntry.Height = existingListBoxEntry[0].Height
+		listBoxEntry.IsMultiSelectEnabled = existingListBoxEntry[0].IsMultiSelectEnabled
+		listBoxEntry.IsFocused = existingListBoxEntry[0].IsFocused
+		listBoxEntry.ItemHighlighted = existingListBoxEntry[0].ItemHighlighted
+		listBoxEntry.ViewportPosition = existingListBoxEntry[0].ViewportPosition
+		listBoxEntry.IsItemSelected = append([]bool(nil), existingListBoxEntry[0].IsItemSelected...)
+		listBoxEntry.SearchText = existingListBoxEntry[0].SearchText
+		listBoxEntry.SearchTime = existingListBoxEntry[0].SearchTime
+	}
+	return listBoxEntry
+}
@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestGetListBoxEntry(test *testing.T) {
+	firstListBoxEntry := NewListBoxEntry()
+	secondListBoxEntry := NewListBoxEntry()
+	secondListBoxEntry.StyleEntry = NewTuiStyleEntry()
+	secondListBoxEntry.ListBoxAlias = "MyListBox"
+	secondListBoxEntry.SelectionEntry.Add("Alias", "Value")
+	secondListBoxEntry.XLocation = 1
+	secondListBoxEntry.YLocation = 2
+	secondListBoxEntry.Width = 3
+	secondListBoxEntry.Height = 4
+	secondListBoxEntry.IsMultiSelectEnabled = true
+	secondListBoxEntry.IsFocused = true
+	secondListBoxEntry.ItemHighlighted = 5
+	secondListBoxEntry.ViewportPosition = 6
+	secondListBoxEntry.IsItemSelected = []bool{true}
+	secondListBoxEntry.SearchText = "Search"
+	secondListBoxEntry.SearchTime = time.Unix(7, 0)
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstListBoxEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondListBoxEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first list box entry is the same as the second, even though it should be different.")
+
+	firstListBoxEntry = NewListBoxEntry(&secondListBoxEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstListBoxEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first list box entry is not the same as the second, even though it should be an identical clone.")
+}
@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type MenuEntryType struct {
+	StyleEntry       TuiStyleEntryType
+	MenuAlias        string
+	SelectionEntry   SelectionEntryType
+	XLocation        int
+	YLocation        int
+	Width            int
+	Height           int
+	IsFocused        bool
+	ItemSelected     int
+	ViewportPosition int
+}
+
+func (shared MenuEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		MenuAlias string
+		SelectionEntry SelectionEntryType
+		XLocation int
+		YLocation int
+		Width int
+		Height int
+		IsFocused bool
+		ItemSelected int
+		ViewportPosition int
+	}{
+		StyleEntry: shared.StyleEntry,
+		MenuAlias: shared.MenuAlias,
+		SelectionEntry: shared.SelectionEntry,
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		Width: shared.Width,
+		Height: shared.Height,
+		IsFocused: shared.IsFocused,
+		ItemSelected: shared.ItemSelected,
+		ViewportPosition: shared.ViewportPosition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared MenuEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewMenuEntry(existingMenuEntry ...*MenuEntryType) MenuEntryType {
+	var menuEntry MenuEntryType
+	if existingMenuEntry != nil {
+		menuEntry.StyleEntry = NewTuiStyleEntry(&existingMenuEntry[0].StyleEntry)
+		menuEntry.MenuAlias = existingMenuEntry[0].MenuAlias
+		menuEntry.SelectionEntry.SelectionAlias = append([]string(nil), existingMenuEntry[0].SelectionEntry.SelectionAlias...)
+		menuEntry.SelectionEntry.SelectionValue = append([]string(nil), existingMenuEntry[0].SelectionEntry.SelectionValue...)
+		menuEntry.XLocation = existingMenuEntry[0].XLocation
+		menuEntry.YLocation = existingMenuEntry[0].YLocation
+		menuEntry.Width = existingMenuEntry[0].Width
+		menuEntry.Height = existingMenuEntry[0].Height
+		menuEntry.IsFocused = existingMenuEntry[0].IsFocused
+		menuEntry.ItemSelected = existingMenuEntry[0].ItemSelected
+		menuEntry.ViewportPosition = existingMenuEntry[0].ViewportPosition
+	}
+	return menuEntry
+}
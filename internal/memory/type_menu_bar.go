@@ -0,0 +1,138 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type MenuBarItemEntryType struct {
+	ItemAlias       string
+	ParentItemAlias string
+	Label           string
+	IsSeparator     bool
+	IsEnabled       bool
+	IsCheckable     bool
+	IsChecked       bool
+}
+
+func (shared MenuBarItemEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		ItemAlias string
+		ParentItemAlias string
+		Label string
+		IsSeparator bool
+		IsEnabled bool
+		IsCheckable bool
+		IsChecked bool
+	}{
+		ItemAlias: shared.ItemAlias,
+		ParentItemAlias: shared.ParentItemAlias,
+		Label: shared.Label,
+		IsSeparator: shared.IsSeparator,
+		IsEnabled: shared.IsEnabled,
+		IsCheckable: shared.IsCheckable,
+		IsChecked: shared.IsChecked,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared MenuBarItemEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewMenuBarItemEntry(existingMenuBarItemEntry ...*MenuBarItemEntryType) MenuBarItemEntryType {
+	var menuBarItemEntry MenuBarItemEntryType
+	if existingMenuBarItemEntry != nil {
+		menuBarItemEntry.ItemAlias = existingMenuBarItemEntry[0].ItemAlias
+		menuBarItemEntry.ParentItemAlias = existingMenuBarItemEntry[0].ParentItemAlias
+		menuBarItemEntry.Label = existingMenuBarItemEntry[0].Label
+		menuBarItemEntry.IsSeparator = existingMenuBarItemEntry[0].IsSeparator
+		menuBarItemEntry.IsEnabled = existingMenuBarItemEntry[0].IsEnabled
+		menuBarItemEntry.IsCheckable = existingMenuBarItemEntry[0].IsCheckable
+		menuBarItemEntry.IsChecked = existingMenuBarItemEntry[0].IsChecked
+	}
+	return menuBarItemEntry
+}
+
+type MenuBarEntryType struct {
+	StyleEntry      TuiStyleEntryType
+	MenuBarAlias    string
+	XLocation       int
+	YLocation       int
+	Width           int
+	Items           []MenuBarItemEntryType
+	OpenItemAliases []string
+}
+
+func (shared MenuBarEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		MenuBarAlias string
+		XLocation int
+		YLocation int
+		Width int
+		Items []MenuBarItemEntryType
+		OpenItemAliases []string
+	}{
+		StyleEntry: shared.StyleEntry,
+		MenuBarAlias: shared.MenuBarAlias,
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		Width: shared.Width,
+		Items: shared.Items,
+		OpenItemAliases: shared.OpenItemAliases,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared MenuBarEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewMenuBarEntry(existingMenuBarEntry ...*MenuBarEntryType) MenuBarEntryType {
+	var menuBarEntry MenuBarEntryType
+	if existingMenuBarEntry != nil {
+		menuBarEntry.StyleEntry = NewTuiStyleEntry(&existingMenuBarEntry[0].StyleEntry)
+		menuBarEntry.MenuBarAlias = existingMenuBarEntry[0].MenuBarAlias
+		menuBarEntry.XLocation = existingMenuBarEntry[0].XLocation
+		menuBarEntry.YLocation = existingMenuBarEntry[0].YLocation
+		menuBarEntry.Width = existingMenuBarEntry[0].Width
+		for currentIndex := range existingMenuBarEntry[0].Items {
+			menuBarEntry.Items = append(menuBarEntry.Items, NewMenuBarItemEntry(&existingMenuBarEntry[0].Items[currentIndex]))
+		}
+		menuBarEntry.OpenItemAliases = append([]string(nil), existingMenuBarEntry[0].OpenItemAliases...)
+	}
+	return menuBarEntry
+}
+
+func (shared *MenuBarEntryType) GetItem(itemAlias string) *MenuBarItemEntryType {
+	for currentIndex := range shared.Items {
+		if shared.Items[currentIndex].ItemAlias == itemAlias && !shared.Items[currentIndex].IsSeparator {
+			return &shared.Items[currentIndex]
+		}
+	}
+	return nil
+}
+
+func (shared *MenuBarEntryType) GetChildItems(parentItemAlias string) []*MenuBarItemEntryType {
+	var childItems []*MenuBarItemEntryType
+	for currentIndex := range shared.Items {
+		if shared.Items[currentIndex].ParentItemAlias == parentItemAlias {
+			childItems = append(childItems, &shared.Items[currentIndex])
+		}
+	}
+	return childItems
+}
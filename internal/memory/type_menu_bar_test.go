@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetMenuBarItemEntry(test *testing.T) {
+	firstMenuBarItemEntry := NewMenuBarItemEntry()
+	secondMenuBarItemEntry := NewMenuBarItemEntry()
+	secondMenuBarItemEntry.ItemAlias = "MyItem"
+	secondMenuBarItemEntry.ParentItemAlias = "MyParent"
+	secondMenuBarItemEntry.Label = "&Open"
+	secondMenuBarItemEntry.IsSeparator = true
+	secondMenuBarItemEntry.IsEnabled = true
+	secondMenuBarItemEntry.IsCheckable = true
+	secondMenuBarItemEntry.IsChecked = true
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstMenuBarItemEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondMenuBarItemEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first menu bar item entry is the same as the second, even though it should be different.")
+
+	firstMenuBarItemEntry = NewMenuBarItemEntry(&secondMenuBarItemEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstMenuBarItemEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first menu bar item entry is not the same as the second, even though it should be an identical clone.")
+}
+
+func TestGetMenuBarEntry(test *testing.T) {
+	firstMenuBarEntry := NewMenuBarEntry()
+	secondMenuBarEntry := NewMenuBarEntry()
+	secondMenuBarEntry.StyleEntry = NewTuiStyleEntry()
+	secondMenuBarEntry.MenuBarAlias = "MyMenuBar"
+	secondMenuBarEntry.XLocation = 1
+	secondMenuBarEntry.YLocation = 2
+	secondMenuBarEntry.Width = 3
+	menuBarItemEntry := NewMenuBarItemEntry()
+	menuBarItemEntry.ItemAlias = "File"
+	secondMenuBarEntry.Items = []MenuBarItemEntryType{menuBarItemEntry}
+	secondMenuBarEntry.OpenItemAliases = []string{"File"}
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstMenuBarEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondMenuBarEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first menu bar entry is the same as the second, even though it should be different.")
+
+	firstMenuBarEntry = NewMenuBarEntry(&secondMenuBarEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstMenuBarEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first menu bar entry is not the same as the second, even though it should be an identical clone.")
+	firstMenuBarEntry.Items[0].IsChecked = true
+	assert.Falsef(test, secondMenuBarEntry.Items[0].IsChecked, "Changing an item of a cloned menu bar entry changed the original.")
+}
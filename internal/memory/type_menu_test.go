@@ -0,0 +1,32 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetMenuEntry(test *testing.T) {
+	firstMenuEntry := NewMenuEntry()
+	secondMenuEntry := NewMenuEntry()
+	secondMenuEntry.StyleEntry = NewTuiStyleEntry()
+	secondMenuEntry.MenuAlias = "MyMenu"
+	secondMenuEntry.SelectionEntry.Add("MyAlias", "My Value")
+	secondMenuEntry.XLocation = 1
+	secondMenuEntry.YLocation = 2
+	secondMenuEntry.Width = 3
+	secondMenuEntry.Height = 4
+	secondMenuEntry.IsFocused = true
+	secondMenuEntry.ItemSelected = 5
+	secondMenuEntry.ViewportPosition = 6
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstMenuEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondMenuEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first menu entry is the same as the second, even though it should be different.")
+
+	firstMenuEntry = NewMenuEntry(&secondMenuEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstMenuEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first menu entry is not the same as the second, even though it should be an identical clone.")
+	secondMenuEntry.SelectionEntry.SelectionValue[0] = "Changed"
+	assert.Equalf(test, "My Value", firstMenuEntry.SelectionEntry.SelectionValue[0], "The cloned menu entry shares its selection entry with the original.")
+}
@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type ProgressBarEntryType struct {
+	StyleEntry        TuiStyleEntryType
+	ProgressBarAlias  string
+	XLocation         int
+	YLocation         int
+	Width             int
+	Height            int
+	IsVertical        bool
+	Value             int
+	MaximumValue      int
+	IsLabelDrawn      bool
+	IsIndeterminate   bool
+	MarqueeTimerAlias string
+	MarqueePosition   int
+	MarqueeDirection  int
+}
+
+func (shared ProgressBarEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		ProgressBarAlias string
+		XLocation int
+		YLocation int
+		Width int
+		Height int
+		IsVertical bool
+		Value int
+		MaximumValue int
+		IsLabelDrawn bool
+		IsIndeterminate bool
+		MarqueeTimerAlias string
+		MarqueePosition int
+		MarqueeDirection int
+	}{
+		StyleEntry: shared.StyleEntry,
+		ProgressBarAlias: shared.ProgressBarAlias,
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		Width: shared.Width,
+		Height: shared.Height,
+		IsVertical: shared.IsVertical,
+		Value: shared.Value,
+		MaximumValue: shared.MaximumValue,
+		IsLabelDrawn: shared.IsLabelDrawn,
+		IsIndeterminate: shared.IsIndeterminate,
+		MarqueeTimerAlias: shared.MarqueeTimerAlias,
+		MarqueePosition: shared.MarqueePosition,
+		MarqueeDirection: shared.MarqueeDirection,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared ProgressBarEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewProgressBarEntry(existingProgressBarEntry ...*ProgressBarEntryType) ProgressBarEntryType {
+	var progressBarEntry ProgressBarEntryType
+	if existingProgressBarEntry != nil {
+		progressBarEntry.StyleEntry = NewTuiStyleEntry(&existingProgressBarEntry[0].StyleEntry)
+		progressBarEntry.ProgressBarAlias = existingProgressBarEntry[0].ProgressBarAlias
+		progressBarEntry.XLocation = existingProgressBarEntry[0].XLocation
+		progressBarEntry.YLocation = existingProgressBarEntry[0].YLocation
+		progressBarEntry.Width = existingProgressBarEntry[0].Width
+		progressBarEntry.Height = existingProgressBarEntry[0].Height
+		progressBarEntry.IsVertical = existingProgressBarEntry[0].IsVertical
+		progressBarEntry.Value = existingProgressBarEntry[0].Value
+		progressBarEntry.MaximumValue = existingProgressBarEntry[0].MaximumValue
+		progressBarEntry.IsLabelDrawn = existingProgressBarEntry[0].IsLabelDrawn
+		progressBarEntry.IsIndeterminate = existingProgressBarEntry[0].IsIndeterminate
+		progressBarEntry.MarqueeTimerAlias = existingProgressBarEntry[0].MarqueeTimerAlias
+		progressBarEntry.MarqueePosition = existingProgressBarEntry[0].MarqueePosition
+		progressBarEntry.MarqueeDirection = existingProgressBarEntry[0].MarqueeDirection
+	}
+	return progressBarEntry
+}
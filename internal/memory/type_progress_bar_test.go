@@ -0,0 +1,34 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetProgressBarEntry(test *testing.T) {
+	firstProgressBarEntry := NewProgressBarEntry()
+	secondProgressBarEntry := NewProgressBarEntry()
+	secondProgressBarEntry.StyleEntry = NewTuiStyleEntry()
+	secondProgressBarEntry.ProgressBarAlias = "MyProgressBar"
+	secondProgressBarEntry.XLocation = 1
+	secondProgressBarEntry.YLocation = 2
+	secondProgressBarEntry.Width = 3
+	secondProgressBarEntry.Height = 4
+	secondProgressBarEntry.IsVertical = true
+	secondProgressBarEntry.Value = 5
+	secondProgressBarEntry.MaximumValue = 6
+	secondProgressBarEntry.IsLabelDrawn = true
+	secondProgressBarEntry.IsIndeterminate = true
+	secondProgressBarEntry.MarqueeTimerAlias = "MyTimer"
+	secondProgressBarEntry.MarqueePosition = 7
+	secondProgressBarEntry.MarqueeDirection = 1
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstProgressBarEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondProgressBarEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first progress bar entry is the same as the second, even though it should be different.")
+
+	firstProgressBarEntry = NewProgressBarEntry(&secondProgressBarEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstProgressBarEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first progress bar entry is not the same as the second, even though it should be an identical clone.")
+}
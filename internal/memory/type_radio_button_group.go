@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type RadioButtonGroupEntryType struct {
+	StyleEntry            TuiStyleEntryType
+	RadioButtonGroupAlias string
+	SelectionEntry        SelectionEntryType
+	XLocation             int
+	YLocation             int
+	ItemSelected          int
+	IsFocused             bool
+}
+
+func (shared RadioButtonGroupEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		RadioButtonGroupAlias string
+		SelectionEntry SelectionEntryType
+		XLocation int
+		YLocation int
+		ItemSelected int
+		IsFocused bool
+	}{
+		StyleEntry: shared.StyleEntry,
+		RadioButtonGroupAlias: shared.RadioButtonGroupAlias,
+		SelectionEntry: shared.SelectionEntry,
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		ItemSelected: shared.ItemSelected,
+		IsFocused: shared.IsFocused,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared RadioButtonGroupEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewRadioButtonGroupEntry(existingRadioButtonGroupEntry ...*RadioButtonGroupEntryType) RadioButtonGroupEntryType {
+	var radioButtonGroupEntry RadioButtonGroupEntryType
+	if existingRadioButtonGroupEntry != nil {
+		radioButtonGroupEntry.StyleEntry = NewTuiStyleEntry(&existingRadioButtonGroupEntry[0].StyleEntry)
+		radioButtonGroupEntry.RadioButtonGroupAlias = existingRadioButtonGroupEntry[0].RadioButtonGroupAlias
+		radioButtonGroupEntry.SelectionEntry.SelectionAlias = append([]string(nil), existingRadioButtonGroupEntry[0].SelectionEntry.SelectionAlias...)
+		radioButtonGroupEntry.SelectionEntry.SelectionValue = append([]string(nil), existingRadioButtonGroupEntry[0].SelectionEntry.SelectionValue...)
+		radioButtonGroupEntry.XLocation = existingRadioButtonGroupEntry[0].XLocation
+		radioButtonGroupEntry.YLocation = existingRadioButtonGroupEntry[0].YLocation
+		radioButtonGroupEntry.ItemSelected = existingRadioButtonGroupEntry[0].ItemSelected
+		radioButtonGroupEntry.IsFocused = existingRadioButtonGroupEntry[0].IsFocused
+	}
+	return radioButtonGroupEntry
+}
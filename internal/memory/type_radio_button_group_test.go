@@ -0,0 +1,27 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetRadioButtonGroupEntry(test *testing.T) {
+	firstRadioButtonGroupEntry := NewRadioButtonGroupEntry()
+	secondRadioButtonGroupEntry := NewRadioButtonGroupEntry()
+	secondRadioButtonGroupEntry.StyleEntry = NewTuiStyleEntry()
+	secondRadioButtonGroupEntry.RadioButtonGroupAlias = "MyRadioButtonGroup"
+	secondRadioButtonGroupEntry.SelectionEntry.Add("alias", "Value")
+	secondRadioButtonGroupEntry.XLocation = 1
+	secondRadioButtonGroupEntry.YLocation = 2
+	secondRadioButtonGroupEntry.ItemSelected = 3
+	secondRadioButtonGroupEntry.IsFocused = true
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstRadioButtonGroupEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondRadioButtonGroupEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first radio button group entry is the same as the second, even though it should be different.")
+
+	firstRadioButtonGroupEntry = NewRadioButtonGroupEntry(&secondRadioButtonGroupEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstRadioButtonGroupEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first radio button group entry is not the same as the second, even though it should be an identical clone.")
+}
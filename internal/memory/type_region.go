@@ -0,0 +1,117 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type RegionEntryType struct {
+	XLocation int
+	YLocation int
+	Width     int
+	Height    int
+}
+
+func (shared RegionEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		XLocation int
+		YLocation int
+		Width int
+		Height int
+	}{
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		Width: shared.Width,
+		Height: shared.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared RegionEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+/*
+IsEmpty allows you to determine if a region covers no cells at all.
+*/
+func (shared RegionEntryType) IsEmpty() bool {
+	return shared.Width <= 0 || shared.Height <= 0
+}
+
+/*
+GetUnion allows you to obtain the smallest region which covers both the
+current region and the one provided. Empty regions are ignored.
+*/
+func (shared RegionEntryType) GetUnion(regionEntry RegionEntryType) RegionEntryType {
+	if regionEntry.IsEmpty() {
+		return shared
+	}
+	if shared.IsEmpty() {
+		return regionEntry
+	}
+	startXLocation := minimumOf(shared.XLocation, regionEntry.XLocation)
+	startYLocation := minimumOf(shared.YLocation, regionEntry.YLocation)
+	endXLocation := maximumOf(shared.XLocation+shared.Width, regionEntry.XLocation+regionEntry.Width)
+	endYLocation := maximumOf(shared.YLocation+shared.Height, regionEntry.YLocation+regionEntry.Height)
+	return NewRegionEntry(startXLocation, startYLocation, endXLocation-startXLocation, endYLocation-startYLocation)
+}
+
+/*
+GetIntersection allows you to obtain the region which is covered by both the
+current region and the one provided. If the two regions do not overlap, an
+empty region is returned.
+*/
+func (shared RegionEntryType) GetIntersection(regionEntry RegionEntryType) RegionEntryType {
+	startXLocation := maximumOf(shared.XLocation, regionEntry.XLocation)
+	startYLocation := maximumOf(shared.YLocation, regionEntry.YLocation)
+	endXLocation := minimumOf(shared.XLocation+shared.Width, regionEntry.XLocation+regionEntry.Width)
+	endYLocation := minimumOf(shared.YLocation+shared.Height, regionEntry.YLocation+regionEntry.Height)
+	if endXLocation <= startXLocation || endYLocation <= startYLocation {
+		return NewRegionEntry(0, 0, 0, 0)
+	}
+	return NewRegionEntry(startXLocation, startYLocation, endXLocation-startXLocation, endYLocation-startYLocation)
+}
+
+/*
+GetOffset allows you to obtain a copy of the current region moved by the
+amount specified.
+*/
+func (shared RegionEntryType) GetOffset(xLocation int, yLocation int) RegionEntryType {
+	return NewRegionEntry(shared.XLocation+xLocation, shared.YLocation+yLocation, shared.Width, shared.Height)
+}
+
+func NewRegionEntry(xLocation int, yLocation int, width int, height int, existingRegionEntry ...*RegionEntryType) RegionEntryType {
+	var regionEntry RegionEntryType
+	if existingRegionEntry != nil {
+		regionEntry.XLocation = existingRegionEntry[0].XLocation
+		regionEntry.YLocation = existingRegionEntry[0].YLocation
+		regionEntry.Width = existingRegionEntry[0].Width
+		regionEntry.Height = existingRegionEntry[0].Height
+	} else {
+		regionEntry.XLocation = xLocation
+		regionEntry.YLocation = yLocation
+		regionEntry.Width = width
+		regionEntry.Height = height
+	}
+	return regionEntry
+}
+
+func minimumOf(firstValue int, secondValue int) int {
+	if firstValue < secondValue {
+		return firstValue
+	}
+	return secondValue
+}
+
+func maximumOf(firstValue int, secondValue int) int {
+	if firstValue > secondValue {
+		return firstValue
+	}
+	return secondValue
+}
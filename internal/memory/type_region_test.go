@@ -0,0 +1,25 @@
+package memory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRegionEntryCreation(test *testing.T) {
+	firstRegionEntry := NewRegionEntry(1, 2, 3, 4)
+	secondRegionEntry := NewRegionEntry(0, 0, 0, 0)
+	assert.NotEqualf(test, firstRegionEntry, secondRegionEntry, "The first region entry is the same as the second, even though it should be different.")
+
+	secondRegionEntry = NewRegionEntry(0, 0, 0, 0, &firstRegionEntry)
+	assert.Equalf(test, firstRegionEntry, secondRegionEntry, "The first region entry is not the same as the second, even though it should be an identical clone.")
+}
+
+func TestRegionEntryUnionAndIntersection(test *testing.T) {
+	firstRegionEntry := NewRegionEntry(0, 0, 10, 5)
+	secondRegionEntry := NewRegionEntry(5, 3, 10, 5)
+	assert.Equalf(test, NewRegionEntry(0, 0, 15, 8), firstRegionEntry.GetUnion(secondRegionEntry), "The union of two regions was not calculated correctly!")
+	assert.Equalf(test, NewRegionEntry(5, 3, 5, 2), firstRegionEntry.GetIntersection(secondRegionEntry), "The intersection of two regions was not calculated correctly!")
+	assert.Equalf(test, firstRegionEntry, firstRegionEntry.GetUnion(NewRegionEntry(0, 0, 0, 0)), "The union with an empty region should not change the original region!")
+	assert.Truef(test, firstRegionEntry.GetIntersection(NewRegionEntry(20, 20, 5, 5)).IsEmpty(), "Regions which do not overlap should have an empty intersection!")
+	assert.Equalf(test, NewRegionEntry(-1, 5, 10, 5), firstRegionEntry.GetOffset(-1, 5), "The region was not offset correctly!")
+}
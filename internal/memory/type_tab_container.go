@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type TabContainerEntryType struct {
+	StyleEntry        TuiStyleEntryType
+	TabContainerAlias string
+	Tabs              SelectionEntryType
+	XLocation         int
+	YLocation         int
+	Width             int
+	Height            int
+	TabSelected       int
+	ViewportPosition  int
+	IsFocused         bool
+}
+
+func (shared TabContainerEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		TabContainerAlias string
+		Tabs SelectionEntryType
+		XLocation int
+		YLocation int
+		Width int
+		Height int
+		TabSelected int
+		ViewportPosition int
+		IsFocused bool
+	}{
+		StyleEntry: shared.StyleEntry,
+		TabContainerAlias: shared.TabContainerAlias,
+		Tabs: shared.Tabs,
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		Width: shared.Width,
+		Height: shared.Height,
+		TabSelected: shared.TabSelected,
+		ViewportPosition: shared.ViewportPosition,
+		IsFocused: shared.IsFocused,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared TabContainerEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewTabContainerEntry(existingTabContainerEntry ...*TabContainerEntryType) TabContainerEntryType {
+	var tabContainerEntry TabContainerEntryType
+	if existingTabContainerEntry != nil {
+		tabContainerEntry.StyleEntry = NewTuiStyleEntry(&existingTabContainerEntry[0].StyleEntry)
+		tabContainerEntry.TabContainerAlias = existingTabContainerEntry[0].TabContainerAlias
+		tabContainerEntry.Tabs.SelectionAlias = append([]string(nil), existingTabContainerEntry[0].Tabs.SelectionAlias...)
+		tabContainerEntry.Tabs.SelectionValue = append([]string(nil), existingTabContainerEntry[0].Tabs.SelectionValue...)
+		tabContainerEntry.XLocation = existingTabContainerEntry[0].XLocation
+		tabContainerEntry.YLocation = existingTabContainerEntry[0].YLocation
+		tabContainerEntry.Width = existingTabContainerEntry[0].Width
+		tabContainerEntry.Height = existingTabContainerEntry[0].Height
+		tabContainerEntry.TabSelected = existingTabContainerEntry[0].TabSelected
+		tabContainerEntry.ViewportPosition = existingTabContainerEntry[0].ViewportPosition
+		tabContainerEntry.IsFocused = existingTabContainerEntry[0].IsFocused
+	}
+	return tabContainerEntry
+}
@@ -0,0 +1,30 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetTabContainerEntry(test *testing.T) {
+	firstTabContainerEntry := NewTabContainerEntry()
+	secondTabContainerEntry := NewTabContainerEntry()
+	secondTabContainerEntry.StyleEntry = NewTuiStyleEntry()
+	secondTabContainerEntry.TabContainerAlias = "MyTabContainer"
+	secondTabContainerEntry.Tabs.Add("general", "General")
+	secondTabContainerEntry.XLocation = 1
+	secondTabContainerEntry.YLocation = 2
+	secondTabContainerEntry.Width = 3
+	secondTabContainerEntry.Height = 4
+	secondTabContainerEntry.TabSelected = 5
+	secondTabContainerEntry.ViewportPosition = 6
+	secondTabContainerEntry.IsFocused = true
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstTabContainerEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondTabContainerEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first tab container entry is the same as the second, even though it should be different.")
+
+	firstTabContainerEntry = NewTabContainerEntry(&secondTabContainerEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstTabContainerEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first tab container entry is not the same as the second, even though it should be an identical clone.")
+}
@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type TerminalSettingsEntryType struct {
+	Width          int
+	Height         int
+	IsMouseEnabled bool
+	DebugDirectory string
+	DoubleClickInterval int64
+}
+
+func (shared TerminalSettingsEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		Width int
+		Height int
+		IsMouseEnabled bool
+		DebugDirectory string
+		DoubleClickInterval int64
+	}{
+		Width: shared.Width,
+		Height: shared.Height,
+		IsMouseEnabled: shared.IsMouseEnabled,
+		DebugDirectory: shared.DebugDirectory,
+		DoubleClickInterval: shared.DoubleClickInterval,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared TerminalSettingsEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewTerminalSettingsEntry(existingTerminalSettingsEntry ...*TerminalSettingsEntryType) TerminalSettingsEntryType {
+	var terminalSettingsEntry TerminalSettingsEntryType
+	terminalSettingsEntry.IsMouseEnabled = true
+	terminalSettingsEntry.DebugDirectory = "/tmp/"
+	terminalSettingsEntry.DoubleClickInterval = 500
+	if existingTerminalSettingsEntry != nil {
+		terminalSettingsEntry.Width = existingTerminalSettingsEntry[0].Width
+		terminalSettingsEntry.Height = existingTerminalSettingsEntry[0].Height
+		terminalSettingsEntry.IsMouseEnabled = existingTerminalSettingsEntry[0].IsMouseEnabled
+		terminalSettingsEntry.DebugDirectory = existingTerminalSettingsEntry[0].DebugDirectory
+		terminalSettingsEntry.DoubleClickInterval = existingTerminalSettingsEntry[0].DoubleClickInterval
+	}
+	return terminalSettingsEntry
+}
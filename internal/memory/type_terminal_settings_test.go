@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetTerminalSettingsEntry(test *testing.T) {
+	firstTerminalSettingsEntry := NewTerminalSettingsEntry()
+	secondTerminalSettingsEntry := NewTerminalSettingsEntry()
+	secondTerminalSettingsEntry.Width = 80
+	secondTerminalSettingsEntry.Height = 25
+	secondTerminalSettingsEntry.IsMouseEnabled = false
+	secondTerminalSettingsEntry.DebugDirectory = "/var/tmp/"
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstTerminalSettingsEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondTerminalSettingsEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first terminal settings entry is the same as the second, even though it should be different.")
+
+	firstTerminalSettingsEntry = NewTerminalSettingsEntry(&secondTerminalSettingsEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstTerminalSettingsEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first terminal settings entry is not the same as the second, even though it should be an identical clone.")
+}
@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type TextAreaEntryType struct {
+	StyleEntry           TuiStyleEntryType
+	TextAreaAlias        string
+	XLocation            int
+	YLocation            int
+	Width                int
+	Height               int
+	MaxLengthAllowed     int
+	IsWordWrapEnabled    bool
+	IsLineNumbersEnabled bool
+	IsFocused            bool
+	CurrentValue         string
+	CursorPosition       int
+	SelectionAnchor      int
+	ViewportXPosition    int
+	ViewportYPosition    int
+	UndoHistory          []string
+	RedoHistory          []string
+}
+
+func (shared TextAreaEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		TextAreaAlias string
+		XLocation int
+		YLocation int
+		Width int
+		Height int
+		MaxLengthAllowed int
+		IsWordWrapEnabled bool
+		IsLineNumbersEnabled bool
+		IsFocused bool
+		CurrentValue string
+		CursorPosition int
+		SelectionAnchor int
+		ViewportXPosition int
+		ViewportYPosition int
+		UndoHistory []string
+		RedoHistory []string
+	}{
+		StyleEntry: shared.StyleEntry,
+		TextAreaAlias: shared.TextAreaAlias,
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		Width: shared.Width,
+		Height: shared.Height,
+		MaxLengthAllowed: shared.MaxLengthAllowed,
+		IsWordWrapEnabled: shared.IsWordWrapEnabled,
+		IsLineNumbersEnabled: shared.IsLineNumbersEnabled,
+		IsFocused: shared.IsFocused,
+		CurrentValue: shared.CurrentValue,
+		CursorPosition: shared.CursorPosition,
+		SelectionAnchor: shared.SelectionAnchor,
+		ViewportXPosition: shared.ViewportXPosition,
+		ViewportYPosition: shared.ViewportYPosition,
+		UndoHistory: shared.UndoHistory,
+		RedoHistory: shared.RedoHistory,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared TextAreaEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewTextAreaEntry(existingTextAreaEntry ...*TextAreaEntryType) TextAreaEntryType {
+	var textAreaEntry TextAreaEntryType
+	if existingTextAreaEntry != nil {
+		textAreaEntry.StyleEntry = NewTuiStyleEntry(&existingTextAreaEntry[0].StyleEntry)
+		textAreaEntry.TextAreaAlias = existingTextAreaEntry[0].TextAreaAlias
+		textAreaEntry.XLocation = existingTextAreaEntry[0].XLocation
+		textAreaEntry.YLocation = existingTextAreaEntry[0].YLocation
+		textAreaEntry.Width = existingTextAreaEntry[0].Width
+		textAreaEntry.Height = existingTextAreaEntry[0].Height
+		textAreaEntry.MaxLengthAllowed = existingTextAreaEntry[0].MaxLengthAllowed
+		textAreaEntry.IsWordWrapEnabled = existingTextAreaEntry[0].IsWordWrapEnabled
+		textAreaEntry.IsLineNumbersEnabled = existingTextAreaEntry[0].IsLineNumbersEnabled
+		textAreaEntry.IsFocused = existingTextAreaEntry[0].IsFocused
+		textAreaEntry.CurrentValue = existingTextAreaEntry[0].CurrentValue
+		textAreaEntry.CursorPosition = existingTextAreaEntry[0].CursorPosition
+		textAreaEntry.SelectionAnchor = existingTextAreaEntry[0].SelectionAnchor
+		textAreaEntry.ViewportXPosition = existingTextAreaEntry[0].ViewportXPosition
+		textAreaEntry.ViewportYPosition = existingTextAreaEntry[0].ViewportYPosition
+		textAreaEntry.UndoHistory = append([]string(nil), existingTextAreaEntry[0].UndoHistory...)
+		textAreaEntry.RedoHistory = append([]string(nil), existingTextAreaEntry[0].RedoHistory...)
+	}
+	return textAreaEntry
+}
@@ -0,0 +1,37 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetTextAreaEntry(test *testing.T) {
+	firstTextAreaEntry := NewTextAreaEntry()
+	secondTextAreaEntry := NewTextAreaEntry()
+	secondTextAreaEntry.StyleEntry = NewTuiStyleEntry()
+	secondTextAreaEntry.TextAreaAlias = "MyTextArea"
+	secondTextAreaEntry.XLocation = 1
+	secondTextAreaEntry.YLocation = 2
+	secondTextAreaEntry.Width = 3
+	secondTextAreaEntry.Height = 4
+	secondTextAreaEntry.MaxLengthAllowed = 5
+	secondTextAreaEntry.IsWordWrapEnabled = true
+	secondTextAreaEntry.IsLineNumbersEnabled = true
+	secondTextAreaEntry.IsFocused = true
+	secondTextAreaEntry.CurrentValue = "Value"
+	secondTextAreaEntry.CursorPosition = 6
+	secondTextAreaEntry.SelectionAnchor = 7
+	secondTextAreaEntry.ViewportXPosition = 8
+	secondTextAreaEntry.ViewportYPosition = 9
+	secondTextAreaEntry.UndoHistory = []string{"Undo"}
+	secondTextAreaEntry.RedoHistory = []string{"Redo"}
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstTextAreaEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondTextAreaEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first text area entry is the same as the second, even though it should be different.")
+
+	firstTextAreaEntry = NewTextAreaEntry(&secondTextAreaEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstTextAreaEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first text area entry is not the same as the second, even though it should be an identical clone.")
+}
@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type TextFieldEntryType struct {
+	StyleEntry          TuiStyleEntryType
+	TextFieldAlias      string
+	XLocation           int
+	YLocation           int
+	Width               int
+	MaxLengthAllowed    int
+	IsPasswordProtected bool
+	IsFocused           bool
+	CurrentValue        string
+	CursorPosition      int
+	ViewportPosition    int
+	Validator           InputValidatorEntryType
+	IsErrorDisplayed    bool
+	IsHistoryEnabled    bool
+	History             []string
+	HistoryIndex        int
+	CompletionProvider  func(currentValue string) []string
+	CompletionItems     []string
+	CompletionItemSelected     int
+	CompletionViewportPosition int
+}
+
+func (shared TextFieldEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		TextFieldAlias string
+		XLocation int
+		YLocation int
+		Width int
+		MaxLengthAllowed int
+		IsPasswordProtected bool
+		IsFocused bool
+		CurrentValue string
+		CursorPosition int
+		ViewportPosition int
+		Validator InputValidatorEntryType
+		IsErrorDisplayed bool
+		IsHistoryEnabled bool
+		History []string
+		HistoryIndex int
+		CompletionItems []string
+		CompletionItemSelected int
+		CompletionViewportPosition int
+	}{
+		StyleEntry: shared.StyleEntry,
+		TextFieldAlias: shared.TextFieldAlias,
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		Width: shared.Width,
+		MaxLengthAllowed: shared.MaxLengthAllowed,
+		IsPasswordProtected: shared.IsPasswordProtected,
+		IsFocused: shared.IsFocused,
+		CurrentValue: shared.CurrentValue,
+		CursorPosition: shared.CursorPosition,
+		ViewportPosition: shared.ViewportPosition,
+		Validator: shared.Validator,
+		IsErrorDisplayed: shared.IsErrorDisplayed,
+		IsHistoryEnabled: shared.IsHistoryEnabled,
+		History: shared.History,
+		HistoryIndex: shared.HistoryIndex,
+		CompletionItems: shared.CompletionItems,
+		CompletionItemSelected: shared.CompletionItemSelected,
+		CompletionViewportPosition: shared.CompletionViewportPosition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared TextFieldEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewTextFieldEntry(existingTextFieldEntry ...*TextFieldEntryType) TextFieldEntryType {
+	var textFieldEntry TextFieldEntryType
+	if existingTextFieldEntry != nil {
+		textFieldEntry.StyleEntry = NewTuiStyleEntry(&existingTextFieldEntry[0].StyleEntry)
+		textFieldEntry.TextFieldAlias = existingTextFieldEntry[0].TextFieldAlias
+		textFieldEntry.XLocation = existingTextFieldEntry[0].XLocation
+		textFieldEntry.YLocation = existingTextFieldEntry[0].YLocation
+		textFieldEntry.Width = existingTextFieldEntry[0].Width
+		textFieldEntry.MaxLengthAllowed = existingTextFieldEntry[0].MaxLengthAllowed
+		textFieldEntry.IsPasswordProtected = existingTextFieldEntry[0].IsPasswordProtected
+		textFieldEntry.IsFocused = existingTextFieldEntry[0].IsFocused
+		textFieldEntry.CurrentValue = existingTextFieldEntry[0].CurrentValue
+		textFieldEntry.CursorPosition = existingTextFieldEntry[0].CursorPosition
+		textFieldEntry.ViewportPosition = existingTextFieldEntry[0].ViewportPosition
+		textFieldEntry.Validator = NewInputValidatorEntry(&existingTextFieldEntry[0].Validator)
+		textFieldEntry.IsErrorDisplayed = existingTextFieldEntry[0].IsErrorDisplayed
+		textFieldEntry.IsHistoryEnabled = existingTextFieldEntry[0].IsHistoryEnabled
+		textFieldEntry.History = append([]string(nil), existingTextFieldEntry[0].History...)
+		textFieldEntry.HistoryIndex = existingTextFieldEntry[0].HistoryIndex
+		textFieldEntry.CompletionProvider = existingTextFieldEntry[0].CompletionProvider
+		textFieldEntry.CompletionItems = append([]string(nil), existingTextFieldEntry[0].CompletionItems...)
+		textFieldEntry.CompletionItemSelected = existingTextFieldEntry[0].CompletionItemSelected
+		textFieldEntry.CompletionViewportPosition = existingTextFieldEntry[0].CompletionViewportPosition
+	}
+	return textFieldEntry
+}
@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetTextFieldEntry(test *testing.T) {
+	firstTextFieldEntry := NewTextFieldEntry()
+	secondTextFieldEntry := NewTextFieldEntry()
+	secondTextFieldEntry.StyleEntry = NewTuiStyleEntry()
+	secondTextFieldEntry.TextFieldAlias = "MyTextField"
+	secondTextFieldEntry.XLocation = 1
+	secondTextFieldEntry.YLocation = 2
+	secondTextFieldEntry.Width = 3
+	secondTextFieldEntry.MaxLengthAllowed = 4
+	secondTextFieldEntry.IsPasswordProtected = true
+	secondTextFieldEntry.IsFocused = true
+	secondTextFieldEntry.CurrentValue = "Value"
+	secondTextFieldEntry.CursorPosition = 5
+	secondTextFieldEntry.ViewportPosition = 6
+	secondTextFieldEntry.Validator.Pattern = "Pattern"
+	secondTextFieldEntry.IsErrorDisplayed = true
+	secondTextFieldEntry.IsHistoryEnabled = true
+	secondTextFieldEntry.History = []string{"First", "Second"}
+	secondTextFieldEntry.HistoryIndex = 7
+	secondTextFieldEntry.CompletionItems = []string{"Third"}
+	secondTextFieldEntry.CompletionItemSelected = 8
+	secondTextFieldEntry.CompletionViewportPosition = 9
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstTextFieldEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondTextFieldEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first text field entry is the same as the second, even though it should be different.")
+
+	firstTextFieldEntry = NewTextFieldEntry(&secondTextFieldEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstTextFieldEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first text field entry is not the same as the second, even though it should be an identical clone.")
+}
@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type TreeViewNodeEntryType struct {
+	NodeAlias        string
+	ParentAlias      string
+	Label            string
+	IsExpandable     bool
+	IsExpanded       bool
+	IsChildrenLoaded bool
+}
+
+type TreeViewEntryType struct {
+	StyleEntry           TuiStyleEntryType
+	TreeViewAlias        string
+	XLocation            int
+	YLocation            int
+	Width                int
+	Height               int
+	IsFocused            bool
+	Nodes                []TreeViewNodeEntryType
+	NodeHighlighted      string
+	ViewportPosition     int
+	LoadChildrenCallback func(nodeAlias string)
+}
+
+func (shared TreeViewEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		TreeViewAlias string
+		XLocation int
+		YLocation int
+		Width int
+		Height int
+		IsFocused bool
+		Nodes []TreeViewNodeEntryType
+		NodeHighlighted string
+		ViewportPosition int
+	}{
+		StyleEntry: shared.StyleEntry,
+		TreeViewAlias: shared.TreeViewAlias,
+		XLocation: shared.XLocation,
+		YLocation: shared.YLocation,
+		Width: shared.Width,
+		Height: shared.Height,
+		IsFocused: shared.IsFocused,
+		Nodes: shared.Nodes,
+		NodeHighlighted: shared.NodeHighlighted,
+		ViewportPosition: shared.ViewportPosition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared TreeViewEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewTreeViewEntry(existingTreeViewEntry ...*TreeViewEntryType) TreeViewEntryType {
+	var treeViewEntry TreeViewEntryType
+	if existingTreeViewEntry != nil {
+		treeViewEntry.StyleEntry = NewTuiStyleEntry(&existingTreeViewEntry[0].StyleEntry)
+		treeViewEntry.TreeViewAlias = existingTreeViewEntry[0].TreeViewAlias
+		treeViewEntry.XLocation = existingTreeViewEntry[0].XLocation
+		treeViewEntry.YLocation = existingTreeViewEntry[0].YLocation
+		treeViewEntry.Width = existingTreeViewEntry[0].Width
+		treeViewEntry.Height = existingTreeViewEntry[0].Height
+		treeViewEntry.IsFocused = existingTreeViewEntry[0].IsFocused
+		treeViewEntry.Nodes = append([]TreeViewNodeEntryType(nil), existingTreeViewEntry[0].Nodes...)
+		treeViewEntry.NodeHighlighted = existingTreeViewEntry[0].NodeHighlighted
+		treeViewEntry.ViewportPosition = existingTreeViewEntry[0].ViewportPosition
+		treeViewEntry.LoadChildrenCallback = existingTreeViewEntry[0].LoadChildrenCallback
+	}
+	return treeViewEntry
+}
@@ -0,0 +1,32 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetTreeViewEntry(test *testing.T) {
+	firstTreeViewEntry := NewTreeViewEntry()
+	secondTreeViewEntry := NewTreeViewEntry()
+	secondTreeViewEntry.StyleEntry = NewTuiStyleEntry()
+	secondTreeViewEntry.TreeViewAlias = "MyTreeView"
+	secondTreeViewEntry.XLocation = 1
+	secondTreeViewEntry.YLocation = 2
+	secondTreeViewEntry.Width = 3
+	secondTreeViewEntry.Height = 4
+	secondTreeViewEntry.IsFocused = true
+	secondTreeViewEntry.Nodes = []TreeViewNodeEntryType{{"node", "", "Node", true, true, true}}
+	secondTreeViewEntry.NodeHighlighted = "node"
+	secondTreeViewEntry.ViewportPosition = 5
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstTreeViewEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondTreeViewEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first tree view entry is the same as the second, even though it should be different.")
+
+	firstTreeViewEntry = NewTreeViewEntry(&secondTreeViewEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstTreeViewEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first tree view entry is not the same as the second, even though it should be an identical clone.")
+	secondTreeViewEntry.Nodes[0].Label = "Changed"
+	assert.Equalf(test, "Node", firstTreeViewEntry.Nodes[0].Label, "The nodes of the cloned tree view entry were not copied.")
+}
@@ -21,6 +21,8 @@ type TuiStyleEntryType struct {
 	DesktopPattern               rune
 	ProgressBarBackgroundPattern rune
 	ProgressBarForegroundPattern rune
+	ScrollBarTrackPattern        rune
+	ScrollBarHandlePattern       rune
 	IsSquareFont                 bool
 	IsWindowHeaderDrawn          bool
 	IsWindowFooterDrawn          bool
@@ -29,11 +31,14 @@ type TuiStyleEntryType struct {
 	TextLabelColor				 int32
 	TextInputForegroundColor     int32
 	TextInputBackgroundColor int32
+	TextInputErrorForegroundColor int32
+	TextInputErrorBackgroundColor int32
 	CursorCharacter    			rune
 	CursorForegroundColor    int32
 	CursorBackgroundColor    int32
 	MenuForegroundColor      int32
 	MenuBackgroundColor      int32
+	MenuDisabledForegroundColor int32
 	HighlightForegroundColor int32
 	HighlightBackgroundColor int32
 	ButtonRaisedColor        int32
@@ -68,16 +73,21 @@ func NewTuiStyleEntry(existingStyleEntry ...*TuiStyleEntryType) TuiStyleEntryTyp
 		styleEntry.DesktopPattern = existingStyleEntry[0].DesktopPattern
 		styleEntry.ProgressBarBackgroundPattern = existingStyleEntry[0].ProgressBarBackgroundPattern
 		styleEntry.ProgressBarForegroundPattern = existingStyleEntry[0].ProgressBarForegroundPattern
+		styleEntry.ScrollBarTrackPattern = existingStyleEntry[0].ScrollBarTrackPattern
+		styleEntry.ScrollBarHandlePattern = existingStyleEntry[0].ScrollBarHandlePattern
 		styleEntry.TextForegroundColor = existingStyleEntry[0].TextForegroundColor
 		styleEntry.TextBackgroundColor = existingStyleEntry[0].TextBackgroundColor
 		styleEntry.TextLabelColor = existingStyleEntry[0].TextLabelColor
 		styleEntry.TextInputForegroundColor = existingStyleEntry[0].TextInputForegroundColor
 		styleEntry.TextInputBackgroundColor = existingStyleEntry[0].TextInputBackgroundColor
+		styleEntry.TextInputErrorForegroundColor = existingStyleEntry[0].TextInputErrorForegroundColor
+		styleEntry.TextInputErrorBackgroundColor = existingStyleEntry[0].TextInputErrorBackgroundColor
 		styleEntry.CursorCharacter = existingStyleEntry[0].CursorCharacter
 		styleEntry.CursorForegroundColor = existingStyleEntry[0].CursorForegroundColor
 		styleEntry.CursorBackgroundColor = existingStyleEntry[0].CursorBackgroundColor
 		styleEntry.MenuForegroundColor = existingStyleEntry[0].MenuForegroundColor
 		styleEntry.MenuBackgroundColor = existingStyleEntry[0].MenuBackgroundColor
+		styleEntry.MenuDisabledForegroundColor = existingStyleEntry[0].MenuDisabledForegroundColor
 		styleEntry.HighlightForegroundColor = existingStyleEntry[0].HighlightForegroundColor
 		styleEntry.HighlightBackgroundColor = existingStyleEntry[0].HighlightBackgroundColor
 		styleEntry.ButtonRaisedColor = existingStyleEntry[0].ButtonRaisedColor
@@ -102,16 +112,21 @@ func NewTuiStyleEntry(existingStyleEntry ...*TuiStyleEntryType) TuiStyleEntryTyp
 		styleEntry.DesktopPattern = constants.CharBlockSparce
 		styleEntry.ProgressBarBackgroundPattern = constants.CharBlockSparce
 		styleEntry.ProgressBarForegroundPattern = constants.CharBlockSolid
+		styleEntry.ScrollBarTrackPattern = constants.CharBlockSparce
+		styleEntry.ScrollBarHandlePattern = constants.CharBlockSolid
 		styleEntry.TextForegroundColor = constants.AnsiColorByIndex[15]
 		styleEntry.TextBackgroundColor = constants.AnsiColorByIndex[0]
 		styleEntry.TextLabelColor = constants.AnsiColorByIndex[15]
 		styleEntry.TextInputForegroundColor = constants.AnsiColorByIndex[15]
 		styleEntry.TextInputBackgroundColor = constants.AnsiColorByIndex[0]
+		styleEntry.TextInputErrorForegroundColor = constants.AnsiColorByIndex[15]
+		styleEntry.TextInputErrorBackgroundColor = constants.AnsiColorByIndex[1]
 		styleEntry.CursorCharacter = constants.CharBlockSolid
 		styleEntry.CursorForegroundColor = constants.AnsiColorByIndex[15]
 		styleEntry.CursorBackgroundColor = constants.AnsiColorByIndex[0]
 		styleEntry.MenuForegroundColor = constants.AnsiColorByIndex[15]
 		styleEntry.MenuBackgroundColor = constants.AnsiColorByIndex[0]
+		styleEntry.MenuDisabledForegroundColor = constants.AnsiColorByIndex[8]
 		styleEntry.HighlightForegroundColor = constants.AnsiColorByIndex[0]
 		styleEntry.HighlightBackgroundColor = constants.AnsiColorByIndex[15]
 		styleEntry.ButtonRaisedColor = constants.AnsiColorByIndex[15]
@@ -23,14 +23,19 @@ func TestStyleTypeCreation(test *testing.T) {
 	firstStyleEntry.DesktopPattern = 'k'
 	firstStyleEntry.ProgressBarBackgroundPattern = 'l'
 	firstStyleEntry.ProgressBarForegroundPattern = 'm'
+	firstStyleEntry.ScrollBarTrackPattern = 'n'
+	firstStyleEntry.ScrollBarHandlePattern = 'o'
 	firstStyleEntry.TextForegroundColor = constants.AnsiColorByIndex[1]
 	firstStyleEntry.TextBackgroundColor = constants.AnsiColorByIndex[2]
 	firstStyleEntry.TextInputForegroundColor = constants.AnsiColorByIndex[3]
 	firstStyleEntry.TextInputBackgroundColor = constants.AnsiColorByIndex[4]
+	firstStyleEntry.TextInputErrorForegroundColor = constants.AnsiColorByIndex[14]
+	firstStyleEntry.TextInputErrorBackgroundColor = constants.AnsiColorByIndex[12]
 	firstStyleEntry.CursorForegroundColor = constants.AnsiColorByIndex[5]
 	firstStyleEntry.CursorBackgroundColor = constants.AnsiColorByIndex[6]
 	firstStyleEntry.MenuForegroundColor = constants.AnsiColorByIndex[7]
 	firstStyleEntry.MenuBackgroundColor = constants.AnsiColorByIndex[8]
+	firstStyleEntry.MenuDisabledForegroundColor = constants.AnsiColorByIndex[11]
 	firstStyleEntry.HighlightForegroundColor = constants.AnsiColorByIndex[9]
 	firstStyleEntry.HighlightBackgroundColor = constants.AnsiColorByIndex[10]
 	firstStyleEntry.ButtonRaisedColor = constants.AnsiColorByIndex[11]
@@ -0,0 +1,53 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type ViewportEntryType struct {
+	StyleEntry                 TuiStyleEntryType
+	ViewportAlias              string
+	LayerAlias                 string
+	IsVerticalScrollBarShown   bool
+	IsHorizontalScrollBarShown bool
+}
+
+func (shared ViewportEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		ViewportAlias string
+		LayerAlias string
+		IsVerticalScrollBarShown bool
+		IsHorizontalScrollBarShown bool
+	}{
+		StyleEntry: shared.StyleEntry,
+		ViewportAlias: shared.ViewportAlias,
+		LayerAlias: shared.LayerAlias,
+		IsVerticalScrollBarShown: shared.IsVerticalScrollBarShown,
+		IsHorizontalScrollBarShown: shared.IsHorizontalScrollBarShown,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared ViewportEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewViewportEntry(existingViewportEntry ...*ViewportEntryType) ViewportEntryType {
+	var viewportEntry ViewportEntryType
+	if existingViewportEntry != nil {
+		viewportEntry.StyleEntry = NewTuiStyleEntry(&existingViewportEntry[0].StyleEntry)
+		viewportEntry.ViewportAlias = existingViewportEntry[0].ViewportAlias
+		viewportEntry.LayerAlias = existingViewportEntry[0].LayerAlias
+		viewportEntry.IsVerticalScrollBarShown = existingViewportEntry[0].IsVerticalScrollBarShown
+		viewportEntry.IsHorizontalScrollBarShown = existingViewportEntry[0].IsHorizontalScrollBarShown
+	}
+	return viewportEntry
+}
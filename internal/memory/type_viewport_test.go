@@ -0,0 +1,25 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetViewportEntry(test *testing.T) {
+	firstViewportEntry := NewViewportEntry()
+	secondViewportEntry := NewViewportEntry()
+	secondViewportEntry.StyleEntry = NewTuiStyleEntry()
+	secondViewportEntry.ViewportAlias = "MyViewport"
+	secondViewportEntry.LayerAlias = "MyLayer"
+	secondViewportEntry.IsVerticalScrollBarShown = true
+	secondViewportEntry.IsHorizontalScrollBarShown = true
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstViewportEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondViewportEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first viewport entry is the same as the second, even though it should be different.")
+
+	firstViewportEntry = NewViewportEntry(&secondViewportEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstViewportEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first viewport entry is not the same as the second, even though it should be an identical clone.")
+}
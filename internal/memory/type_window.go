@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"encoding/json"
+)
+
+type WindowEntryType struct {
+	StyleEntry         TuiStyleEntryType
+	WindowAlias        string
+	WindowTitle        string
+	IsMaximized        bool
+	RestoreXLocation   int
+	RestoreYLocation   int
+	RestoreWidth       int
+	RestoreHeight      int
+	RestoreLayoutEntry LayoutEntryType
+}
+
+func (shared WindowEntryType) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(struct {
+		StyleEntry TuiStyleEntryType
+		WindowAlias string
+		WindowTitle string
+		IsMaximized bool
+		RestoreXLocation int
+		RestoreYLocation int
+		RestoreWidth int
+		RestoreHeight int
+		RestoreLayoutEntry LayoutEntryType
+	}{
+		StyleEntry: shared.StyleEntry,
+		WindowAlias: shared.WindowAlias,
+		WindowTitle: shared.WindowTitle,
+		IsMaximized: shared.IsMaximized,
+		RestoreXLocation: shared.RestoreXLocation,
+		RestoreYLocation: shared.RestoreYLocation,
+		RestoreWidth: shared.RestoreWidth,
+		RestoreHeight: shared.RestoreHeight,
+		RestoreLayoutEntry: shared.RestoreLayoutEntry,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (shared WindowEntryType) GetEntryAsJsonDump() string {
+	j, err := json.Marshal(shared)
+	if err != nil {
+		panic(err)
+	}
+	return string(j)
+}
+
+func NewWindowEntry(existingWindowEntry ...*WindowEntryType) WindowEntryType {
+	var windowEntry WindowEntryType
+	if existingWindowEntry != nil {
+		windowEntry.StyleEntry = NewTuiStyleEntry(&existingWindowEntry[0].StyleEntry)
+		windowEntry.WindowAlias = existingWindowEntry[0].WindowAlias
+		windowEntry.WindowTitle = existingWindowEntry[0].WindowTitle
+		windowEntry.IsMaximized = existingWindowEntry[0].IsMaximized
+		windowEntry.RestoreXLocation = existingWindowEntry[0].RestoreXLocation
+		windowEntry.RestoreYLocation = existingWindowEntry[0].RestoreYLocation
+		windowEntry.RestoreWidth = existingWindowEntry[0].RestoreWidth
+		windowEntry.RestoreHeight = existingWindowEntry[0].RestoreHeight
+		windowEntry.RestoreLayoutEntry = NewLayoutEntry(&existingWindowEntry[0].RestoreLayoutEntry)
+	}
+	return windowEntry
+}
@@ -0,0 +1,29 @@
+package memory
+
+import (
+	"github.com/supercom32/dosktop/internal/recast"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetWindowEntry(test *testing.T) {
+	firstWindowEntry := NewWindowEntry()
+	secondWindowEntry := NewWindowEntry()
+	secondWindowEntry.StyleEntry = NewTuiStyleEntry()
+	secondWindowEntry.WindowAlias = "MyWindow"
+	secondWindowEntry.WindowTitle = "My Window"
+	secondWindowEntry.IsMaximized = true
+	secondWindowEntry.RestoreXLocation = 1
+	secondWindowEntry.RestoreYLocation = 2
+	secondWindowEntry.RestoreWidth = 3
+	secondWindowEntry.RestoreHeight = 4
+	secondWindowEntry.RestoreLayoutEntry.IsAnchored = true
+
+	obtainedResult := recast.GetArrayOfInterfaces(firstWindowEntry)
+	expectedResult := recast.GetArrayOfInterfaces(secondWindowEntry)
+	assert.NotEqualf(test, expectedResult, obtainedResult, "The first window entry is the same as the second, even though it should be different.")
+
+	firstWindowEntry = NewWindowEntry(&secondWindowEntry)
+	obtainedResult = recast.GetArrayOfInterfaces(firstWindowEntry)
+	assert.Equalf(test, expectedResult, obtainedResult, "The first window entry is not the same as the second, even though it should be an identical clone.")
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"golang.org/x/text/width"
+	"unicode"
 )
 
 const maxLen = 4096
@@ -12,6 +13,7 @@ const nullRune = '\x00'
 const leftAligned = 0
 const rightAligned = 1
 const centerAligned = 2
+const zeroWidthJoiner = '\u200D'
 
 func IsRuneCharacterWide(character rune) bool {
 	isCharacterWide := false
@@ -22,6 +24,56 @@ func IsRuneCharacterWide(character rune) bool {
 	return isCharacterWide
 }
 
+func IsRuneCharacterCombining(character rune) bool {
+	if unicode.In(character, unicode.Mn, unicode.Me, unicode.Mc) || character == zeroWidthJoiner {
+		return true
+	}
+	// Emoji skin tone modifiers attach to the emoji before them.
+	if character >= 0x1F3FB && character <= 0x1F3FF {
+		return true
+	}
+	return false
+}
+
+func isRuneRegionalIndicator(character rune) bool {
+	return character >= 0x1F1E6 && character <= 0x1F1FF
+}
+
+func GetGraphemeClusters(arrayOfRunes []rune) [][]rune {
+	var graphemeClusters [][]rune
+	clusterStartIndex := 0
+	isJoinRequired := false
+	numberOfRegionalIndicators := 0
+	for currentIndex, currentRune := range arrayOfRunes {
+		// Combining marks, characters following a zero width joiner, and the second regional
+		// indicator of a flag all belong to the grapheme cluster before them.
+		isRegionalIndicatorPair := isRuneRegionalIndicator(currentRune) && numberOfRegionalIndicators%2 == 1
+		if len(graphemeClusters) > 0 && (isJoinRequired || IsRuneCharacterCombining(currentRune) || isRegionalIndicatorPair) {
+			graphemeClusters[len(graphemeClusters)-1] = arrayOfRunes[clusterStartIndex : currentIndex+1 : currentIndex+1]
+		} else {
+			clusterStartIndex = currentIndex
+			graphemeClusters = append(graphemeClusters, arrayOfRunes[currentIndex:currentIndex+1:currentIndex+1])
+		}
+		if isRuneRegionalIndicator(currentRune) {
+			numberOfRegionalIndicators++
+		} else {
+			numberOfRegionalIndicators = 0
+		}
+		isJoinRequired = currentRune == zeroWidthJoiner
+	}
+	return graphemeClusters
+}
+
+func GetGraphemeClusterWidth(graphemeCluster []rune) int {
+	if len(graphemeCluster) == 0 {
+		return 0
+	}
+	if IsRuneCharacterWide(graphemeCluster[0]) || isRuneRegionalIndicator(graphemeCluster[0]) {
+		return 2
+	}
+	return 1
+}
+
 func GetRunesFromString(stringToConvert string) []rune {
 	//narrow := width.Narrow.String(stringToConvert)
 	runes := []rune(stringToConvert)
@@ -21,6 +21,19 @@ func TestIsRuneCharacterWide(test *testing.T) {
 	assert.Equalf(test, false, obtainedResult, "The English character specified is not wide, but was not detected as such.")
 }
 
+func TestGetGraphemeClusters(test *testing.T) {
+	graphemeClusters := GetGraphemeClusters([]rune("e\u0301a👍🏽👨\u200D👩\u200D👧🇨🇦🇯🇵读"))
+	var obtainedResult []string
+	for _, currentGraphemeCluster := range graphemeClusters {
+		obtainedResult = append(obtainedResult, string(currentGraphemeCluster))
+	}
+	expectedResult := []string{"e\u0301", "a", "👍🏽", "👨\u200D👩\u200D👧", "🇨🇦", "🇯🇵", "读"}
+	assert.Equalf(test, expectedResult, obtainedResult, "The string specified was not split into the correct grapheme clusters!")
+	assert.Truef(test, IsRuneCharacterCombining('\u0301'), "A combining accent was not detected as combining!")
+	assert.Falsef(test, IsRuneCharacterCombining('e'), "A regular character was detected as combining!")
+	assert.Equalf(test, []int{1, 1, 2, 2}, []int{GetGraphemeClusterWidth(graphemeClusters[0]), GetGraphemeClusterWidth(graphemeClusters[1]), GetGraphemeClusterWidth(graphemeClusters[4]), GetGraphemeClusterWidth(graphemeClusters[6])}, "The width of the grapheme clusters did not match what was expected!")
+}
+
 func TestGetRunesFromString(test *testing.T) {
 	arrayOfRunes := GetRunesFromString("This is a test string to be converted into a rune array!")
 	obtainedResult := len(arrayOfRunes)
@@ -0,0 +1,281 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"sort"
+	"strings"
+)
+
+/*
+keyBindingHistory is a variable used to hold the chords of a key binding
+sequence which has been partially entered by the user.
+*/
+var keyBindingHistory []string
+
+/*
+keyNameAliases is a variable used to hold alternate names which may be used
+for keys when specifying a key binding.
+*/
+var keyNameAliases = map[string]string{
+	"escape":     "esc",
+	"return":     "enter",
+	"del":        "delete",
+	"ins":        "insert",
+	"pageup":     "pgup",
+	"pagedown":   "pgdn",
+	"backspace2": "backspace",
+	"backtab":    "shift+tab",
+	"control":    "ctrl",
+	"option":     "alt",
+}
+
+/*
+AddKeyBinding allows you to map a key chord, or a sequence of key chords, to
+an action in your application. For example:
+
+	// Save the document when the user presses 'ctrl+x' followed by 'ctrl+s'.
+	dosktop.AddKeyBinding("saveDocument", "ctrl+x ctrl+s", func() {
+		saveDocument()
+	})
+
+In addition, the following information should be noted:
+
+- A chord is made up of zero or more modifiers ('ctrl', 'alt', 'meta', and
+'shift') followed by a key name, all separated by '+'. Multiple chords are
+separated by spaces. Chords are not case sensitive, except for printable
+characters pressed without the 'ctrl' modifier. For example, 'A' and 'a'
+are different keys.
+
+- Key names are the same as those reported by 'Inkey', such as 'enter',
+'esc', 'tab', 'up', 'pgdn', 'f1', or a single printable character. The
+space bar is named 'space'.
+
+- When a binding is matched, its callback is run and a key binding event
+containing the binding alias is returned by 'PollEvent' and 'WaitForEvent'
+in place of the keystrokes which made it up. If you prefer to handle the
+action in your event loop, you can pass 'nil' as the callback.
+
+- While a sequence is only partially entered, the keystrokes entered so far
+are not reported. If the next keystroke does not continue any binding,
+those keystrokes are discarded.
+
+- Key bindings are not applied to keystrokes read with 'Inkey'.
+
+- If a binding with the same alias already exists, it is replaced.
+
+- If the key sequence provided is empty, a panic will be generated to fail
+as fast as possible.
+*/
+func AddKeyBinding(bindingAlias string, keySequence string, callback func()) {
+	chords := getKeyChordsFromString(keySequence)
+	if len(chords) == 0 {
+		panic(fmt.Sprintf("The key binding '%s' could not be added since the key sequence '%s' is empty.", bindingAlias, keySequence))
+	}
+	memory.AddKeyBinding(bindingAlias, chords, callback)
+	keyBindingHistory = nil
+}
+
+/*
+DeleteKeyBinding allows you to remove a key binding which was previously
+added. If the binding does not exist, then no operation takes place.
+*/
+func DeleteKeyBinding(bindingAlias string) {
+	memory.DeleteKeyBinding(bindingAlias)
+	keyBindingHistory = nil
+}
+
+/*
+getKeyChordsFromString allows you to convert a string of key chords, such
+as "Ctrl+X Ctrl+S", into a list of normalized chords.
+*/
+func getKeyChordsFromString(keySequence string) []string {
+	var chords []string
+	for _, chordText := range strings.Fields(keySequence) {
+		chords = append(chords, getNormalizedKeyChord(chordText))
+	}
+	return chords
+}
+
+/*
+getNormalizedKeyChord allows you to convert a single key chord into its
+normalized form. Modifiers are always listed in the order 'ctrl', 'alt',
+'meta', and 'shift'.
+*/
+func getNormalizedKeyChord(chordText string) string {
+	keyName := chordText
+	modifierText := ""
+	if strings.HasSuffix(chordText, "++") {
+		keyName = "+"
+		modifierText = strings.TrimSuffix(chordText, "++")
+	} else if index := strings.LastIndex(chordText, "+"); index > 0 {
+		keyName = chordText[index+1:]
+		modifierText = chordText[:index]
+	}
+	var modifierMask tcell.ModMask
+	if modifierText != "" {
+		for _, modifierName := range strings.Split(strings.ToLower(modifierText), "+") {
+			if alias, isAlias := keyNameAliases[modifierName]; isAlias {
+				modifierName = alias
+			}
+			switch modifierName {
+			case "ctrl":
+				modifierMask |= tcell.ModCtrl
+			case "alt":
+				modifierMask |= tcell.ModAlt
+			case "meta":
+				modifierMask |= tcell.ModMeta
+			case "shift":
+				modifierMask |= tcell.ModShift
+			default:
+				panic(fmt.Sprintf("The key chord '%s' could not be used since '%s' is not a valid modifier.", chordText, modifierName))
+			}
+		}
+	}
+	if len([]rune(keyName)) > 1 {
+		keyName = strings.ToLower(keyName)
+	} else if modifierMask&tcell.ModCtrl != 0 {
+		keyName = strings.ToLower(keyName)
+	}
+	if alias, isAlias := keyNameAliases[keyName]; isAlias {
+		keyName = alias
+	}
+	if strings.HasPrefix(keyName, "shift+") {
+		modifierMask |= tcell.ModShift
+		keyName = strings.TrimPrefix(keyName, "shift+")
+	}
+	return getModifierPrefix(modifierMask) + keyName
+}
+
+/*
+getKeyChord allows you to obtain the normalized chord for a key reported by
+the terminal. For example, "ctrl+s", "alt+x", "shift+up", or "a".
+*/
+func getKeyChord(key tcell.Key, character rune, modifierMask tcell.ModMask) string {
+	keyName := ""
+	switch {
+	case key == tcell.KeyRune:
+		keyName = string(character)
+		if character == ' ' {
+			keyName = "space"
+		}
+	case key == tcell.KeyBacktab:
+		keyName = "tab"
+		modifierMask |= tcell.ModShift
+	case key == tcell.KeyBackspace2:
+		keyName = "backspace"
+	default:
+		name, isNameFound := tcell.KeyNames[key]
+		if !isNameFound {
+			name = fmt.Sprintf("key[%d]", int(key))
+		}
+		keyName = strings.ToLower(name)
+		if strings.HasPrefix(keyName, "ctrl-") {
+			keyName = strings.TrimPrefix(keyName, "ctrl-")
+			modifierMask |= tcell.ModCtrl
+		}
+	}
+	if modifierMask&tcell.ModCtrl != 0 {
+		keyName = strings.ToLower(keyName)
+	}
+	return getModifierPrefix(modifierMask) + keyName
+}
+
+/*
+getModifierPrefix allows you to obtain the text which prefixes a chord for
+the modifiers provided.
+*/
+func getModifierPrefix(modifierMask tcell.ModMask) string {
+	prefix := ""
+	if modifierMask&tcell.ModCtrl != 0 {
+		prefix += "ctrl+"
+	}
+	if modifierMask&tcell.ModAlt != 0 {
+		prefix += "alt+"
+	}
+	if modifierMask&tcell.ModMeta != 0 {
+		prefix += "meta+"
+	}
+	if modifierMask&tcell.ModShift != 0 {
+		prefix += "shift+"
+	}
+	return prefix
+}
+
+/*
+processKeyBinding allows you to match a key event against all registered
+key bindings. In addition, the following information should be noted:
+
+- If the key event completes a binding, the binding callback is run and a
+key binding event is returned instead.
+
+- If the key event partially matches a binding, then 'false' is returned
+since the keystroke should not be reported yet.
+
+- If the key event does not match any binding, it is returned unchanged.
+*/
+func processKeyBinding(eventEntry memory.EventEntryType) (memory.EventEntryType, bool) {
+	if len(memory.KeyBindingMemory) == 0 || eventEntry.KeyEvent.Chord == "" {
+		return eventEntry, true
+	}
+	keySequence := append(keyBindingHistory, eventEntry.KeyEvent.Chord)
+	bindingEntry, isPartialMatch := getMatchingKeyBinding(keySequence)
+	if bindingEntry == nil && !isPartialMatch && len(keySequence) > 1 {
+		keySequence = []string{eventEntry.KeyEvent.Chord}
+		bindingEntry, isPartialMatch = getMatchingKeyBinding(keySequence)
+	}
+	if bindingEntry != nil {
+		keyBindingHistory = nil
+		if bindingEntry.Callback != nil {
+			bindingEntry.Callback()
+		}
+		bindingEventEntry := newEventEntry(constants.EventTypeKeyBinding)
+		bindingEventEntry.KeyBindingEvent.BindingAlias = bindingEntry.BindingAlias
+		bindingEventEntry.KeyBindingEvent.KeySequence = strings.Join(keySequence, " ")
+		return bindingEventEntry, true
+	}
+	if isPartialMatch {
+		keyBindingHistory = keySequence
+		return memory.NewEventEntry(), false
+	}
+	keyBindingHistory = nil
+	return eventEntry, true
+}
+
+/*
+getMatchingKeyBinding allows you to find the key binding which exactly
+matches the key sequence provided. If no binding matches exactly, then
+'true' is returned if the sequence is the start of a longer binding. If
+several bindings match, the one with the lowest alias is used.
+*/
+func getMatchingKeyBinding(keySequence []string) (*memory.KeyBindingEntryType, bool) {
+	isPartialMatch := false
+	var bindingAliases []string
+	for bindingAlias := range memory.KeyBindingMemory {
+		bindingAliases = append(bindingAliases, bindingAlias)
+	}
+	sort.Strings(bindingAliases)
+	for _, bindingAlias := range bindingAliases {
+		bindingEntry := memory.KeyBindingMemory[bindingAlias]
+		if len(bindingEntry.KeySequence) < len(keySequence) {
+			continue
+		}
+		isPrefix := true
+		for index := range keySequence {
+			if bindingEntry.KeySequence[index] != keySequence[index] {
+				isPrefix = false
+				break
+			}
+		}
+		if !isPrefix {
+			continue
+		}
+		if len(bindingEntry.KeySequence) == len(keySequence) {
+			return bindingEntry, false
+		}
+		isPartialMatch = true
+	}
+	return nil, isPartialMatch
+}
@@ -0,0 +1,49 @@
+package dosktop
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"testing"
+)
+
+func TestKeyBindingChords(test *testing.T) {
+	assert.Equalf(test, []string{"ctrl+x", "ctrl+s"}, getKeyChordsFromString("Ctrl+X  CTRL+s"), "The key sequence was not normalized correctly!")
+	assert.Equalf(test, []string{"ctrl+alt+shift+up", "A", "alt+a", "shift+tab", "ctrl++", "pgdn"}, getKeyChordsFromString("shift+alt+ctrl+Up A Alt+a backtab ctrl++ PageDown"), "The key chords were not normalized correctly!")
+	assert.Panicsf(test, func() { getKeyChordsFromString("hyper+x") }, "An invalid modifier did not panic!")
+	assert.Panicsf(test, func() { AddKeyBinding("MyBinding", " ", nil) }, "An empty key sequence did not panic!")
+	assert.Equalf(test, "ctrl+s", getKeyChord(tcell.KeyCtrlS, rune(tcell.KeyCtrlS), tcell.ModCtrl), "A control key was not named correctly!")
+	assert.Equalf(test, "alt+x", getKeyChord(tcell.KeyRune, 'x', tcell.ModAlt), "An alt key was not named correctly!")
+	assert.Equalf(test, "shift+up", getKeyChord(tcell.KeyUp, 0, tcell.ModShift), "A shifted key was not named correctly!")
+	assert.Equalf(test, "shift+tab", getKeyChord(tcell.KeyBacktab, 0, tcell.ModNone), "A back tab was not named correctly!")
+	assert.Equalf(test, "backspace", getKeyChord(tcell.KeyBackspace2, 0, tcell.ModNone), "A backspace was not named correctly!")
+	assert.Equalf(test, "space", getKeyChord(tcell.KeyRune, ' ', tcell.ModNone), "A space was not named correctly!")
+}
+
+func TestKeyBindingSequences(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	numberOfSaves := 0
+	AddKeyBinding("Save", "ctrl+x ctrl+s", func() { numberOfSaves++ })
+	AddKeyBinding("Quit", "ctrl+q", nil)
+	simulationScreen.InjectKey(tcell.KeyCtrlS, rune(tcell.KeyCtrlS), tcell.ModCtrl)
+	simulationScreen.InjectKey(tcell.KeyCtrlX, rune(tcell.KeyCtrlX), tcell.ModCtrl)
+	simulationScreen.InjectKey(tcell.KeyCtrlS, rune(tcell.KeyCtrlS), tcell.ModCtrl)
+	simulationScreen.InjectKey(tcell.KeyCtrlX, rune(tcell.KeyCtrlX), tcell.ModCtrl)
+	simulationScreen.InjectKey(tcell.KeyRune, 'a', tcell.ModAlt)
+	simulationScreen.InjectKey(tcell.KeyCtrlQ, rune(tcell.KeyCtrlQ), tcell.ModCtrl)
+	eventEntry := WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.EventTypeKey, "ctrl+s", tcell.KeyCtrlS, tcell.ModCtrl}, []interface{}{eventEntry.EventType, eventEntry.KeyEvent.Chord, eventEntry.KeyEvent.Key, eventEntry.KeyEvent.ModifierMask}, "An unbound key was not reported correctly!")
+	eventEntry = WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.EventTypeKeyBinding, "Save", "ctrl+x ctrl+s"}, []interface{}{eventEntry.EventType, eventEntry.KeyBindingEvent.BindingAlias, eventEntry.KeyBindingEvent.KeySequence}, "The key sequence was not matched correctly!")
+	assert.Equalf(test, 1, numberOfSaves, "The key binding callback was not run!")
+	eventEntry = WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.EventTypeKey, "alt+a", 'a'}, []interface{}{eventEntry.EventType, eventEntry.KeyEvent.Chord, eventEntry.KeyEvent.Character}, "A key which interrupts a key sequence was not reported!")
+	eventEntry = WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.EventTypeKeyBinding, "Quit"}, []interface{}{eventEntry.EventType, eventEntry.KeyBindingEvent.BindingAlias}, "A single chord binding was not matched!")
+	DeleteKeyBinding("Quit")
+	simulationScreen.InjectKey(tcell.KeyCtrlQ, rune(tcell.KeyCtrlQ), tcell.ModCtrl)
+	eventEntry = WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.EventTypeKey, "ctrl+q"}, []interface{}{eventEntry.EventType, eventEntry.KeyEvent.Chord}, "A deleted key binding was still matched!")
+}
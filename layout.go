@@ -0,0 +1,319 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+)
+
+/*
+GetTerminalSize allows you to obtain the current width and height of the
+terminal display. In addition, the following information should be noted:
+
+- When the user resizes their terminal, the size returned will only change
+once the resize has been processed. This happens automatically the next time
+'Inkey' or 'UpdateDisplay' is called.
+*/
+func GetTerminalSize() (int, int) {
+	return commonResource.terminalWidth, commonResource.terminalHeight
+}
+
+/*
+AddResizeCallback allows you to register a function which is called every
+time the terminal display is resized. This is useful for reflowing content
+or redrawing text layers that depend on the size of the terminal. For
+example:
+
+	// Register a callback that redraws our dashboard when the terminal is
+	// resized.
+	dosktop.AddResizeCallback("MyDashboard", func(width int, height int) {
+		redrawDashboard(width, height)
+	})
+
+In addition, the following information should be noted:
+
+- Callbacks are called after all text layer layouts have been applied, so
+any anchored or percentage based text layers will already be in their new
+positions.
+
+- Callbacks are called in alphabetical order of their callback alias, and
+always from the same goroutine that called 'Inkey', 'PollEvent',
+'WaitForEvent', 'ProcessEvent', or 'UpdateDisplay'.
+
+- If you add a callback with an alias that already exists, the existing
+callback will be replaced.
+*/
+func AddResizeCallback(callbackAlias string, callback func(width int, height int)) {
+	if callback == nil {
+		panic(fmt.Sprintf("The resize callback '%s' could not be added since no function was specified!", callbackAlias))
+	}
+	memory.AddResizeCallback(callbackAlias, callback)
+}
+
+/*
+DeleteResizeCallback allows you to remove a previously registered resize
+callback. If you attempt to delete a callback which does not exist, then the
+request will simply be ignored.
+*/
+func DeleteResizeCallback(callbackAlias string) {
+	memory.DeleteResizeCallback(callbackAlias)
+}
+
+/*
+ResizeLayer allows you to change the width and height of a text layer. In
+addition, the following information should be noted:
+
+- Any existing content which still fits inside the new dimensions is
+preserved, while newly exposed areas are left empty.
+
+- If you pass in a zero or negative value for ether width or height a panic
+will be generated to fail as fast as possible.
+
+- If the text layer is a parent, the layouts of all its child text layers
+are reapplied using the new dimensions.
+*/
+func ResizeLayer(layerAlias string, width int, height int) {
+	if width <= 0 || height <= 0 {
+		panic(fmt.Sprintf("The text layer '%s' could not be resized since the width and height of (%d, %d) is invalid.", layerAlias, width, height))
+	}
+	layerEntry := memory.GetLayer(layerAlias)
+	markLayerAsDirty(layerEntry)
+	layerEntry.Resize(width, height)
+	markLayerAsDirty(layerEntry)
+	updateLayerAnchorMargins(layerEntry)
+	applyLayerLayouts(layerAlias, width, height)
+}
+
+/*
+SetLayerAnchor allows you to anchor a text layer to the edges of its
+container, so that it automatically follows those edges when the container
+is resized. The container of a text layer is its parent, or the terminal
+display if it has no parent. For example:
+
+	// Keep the status bar attached to the bottom of the terminal, while
+	// stretching it across the full width of the display.
+	dosktop.SetLayerAnchor("StatusBar", constants.AnchorLeft | constants.AnchorRight | constants.AnchorBottom)
+
+In addition, the following information should be noted:
+
+- Anchors are specified by combining 'constants.AnchorLeft',
+'constants.AnchorRight', 'constants.AnchorTop', and 'constants.AnchorBottom'.
+
+- The distance between the text layer and each anchored edge is recorded at
+the time this method is called, and is preserved whenever the container is
+resized.
+
+- If a text layer is anchored to opposite edges, it will stretch so that the
+distance to both edges is preserved. If it is only anchored to the right or
+bottom edge, it will move instead.
+
+- Specifying 'constants.AnchorNone' removes any anchors or percentage
+layouts previously set on the text layer.
+*/
+func SetLayerAnchor(layerAlias string, anchorType int) {
+	layerEntry := memory.GetLayer(layerAlias)
+	layerEntry.LayoutEntry = memory.NewLayoutEntry()
+	if anchorType == constants.AnchorNone {
+		return
+	}
+	layerEntry.LayoutEntry.IsAnchored = true
+	layerEntry.LayoutEntry.AnchorType = anchorType
+	updateLayerAnchorMargins(layerEntry)
+}
+
+/*
+SetLayerLayoutByPercent allows you to size and position a text layer as a
+percentage of its container, so that it automatically stretches when the
+container is resized. The container of a text layer is its parent, or the
+terminal display if it has no parent. For example:
+
+	// Make the sidebar occupy the left quarter of the terminal display.
+	dosktop.SetLayerLayoutByPercent("Sidebar", 0, 0, 25, 100)
+
+In addition, the following information should be noted:
+
+- The layout is applied immediately, so the text layer will be moved and
+resized as soon as this method is called.
+
+- Text layers are never made smaller than a single character, regardless
+of how small the container becomes.
+
+- If you pass in a zero or negative percentage for ether width or height a
+panic will be generated to fail as fast as possible.
+*/
+func SetLayerLayoutByPercent(layerAlias string, xPercent float32, yPercent float32, widthPercent float32, heightPercent float32) {
+	if widthPercent <= 0 || heightPercent <= 0 {
+		panic(fmt.Sprintf("The layout for text layer '%s' could not be set since the width and height percentage of (%v, %v) is invalid.", layerAlias, widthPercent, heightPercent))
+	}
+	layerEntry := memory.GetLayer(layerAlias)
+	layerEntry.LayoutEntry = memory.NewLayoutEntry()
+	layerEntry.LayoutEntry.IsPercentLayout = true
+	layerEntry.LayoutEntry.XPercent = xPercent
+	layerEntry.LayoutEntry.YPercent = yPercent
+	layerEntry.LayoutEntry.WidthPercent = widthPercent
+	layerEntry.LayoutEntry.HeightPercent = heightPercent
+	containerWidth, containerHeight := getLayerContainerSize(layerEntry)
+	applyLayerLayout(layerEntry, containerWidth, containerHeight)
+}
+
+/*
+getLayerContainerSize allows you to obtain the size of the area a text
+layer is laid out in. This is the size of its parent text layer, or the
+terminal display if it has no parent.
+*/
+func getLayerContainerSize(layerEntry *memory.LayerEntryType) (int, int) {
+	if layerEntry.ParentAlias != "" && memory.IsLayerExists(layerEntry.ParentAlias) {
+		parentEntry := memory.GetLayer(layerEntry.ParentAlias)
+		return parentEntry.Width, parentEntry.Height
+	}
+	return commonResource.terminalWidth, commonResource.terminalHeight
+}
+
+/*
+updateLayerAnchorMargins allows you to record the current distance between
+an anchored text layer and the right and bottom edges of its container. This
+needs to be called whenever an anchored text layer is moved or resized
+directly, so that the new position is preserved on future resizes.
+*/
+func updateLayerAnchorMargins(layerEntry *memory.LayerEntryType) {
+	if !layerEntry.LayoutEntry.IsAnchored {
+		return
+	}
+	containerWidth, containerHeight := getLayerContainerSize(layerEntry)
+	layerEntry.LayoutEntry.RightMargin = containerWidth - (layerEntry.ScreenXLocation + layerEntry.Width)
+	layerEntry.LayoutEntry.BottomMargin = containerHeight - (layerEntry.ScreenYLocation + layerEntry.Height)
+}
+
+/*
+applyLayerLayouts allows you to apply the layouts of all text layers with
+the specified parent alias, using the container size provided. Top level
+text layers have a parent alias of "".
+*/
+func applyLayerLayouts(parentAlias string, containerWidth int, containerHeight int) {
+	for currentKey, currentLayerEntry := range memory.ScreenMemory {
+		if currentLayerEntry.ParentAlias == parentAlias && currentKey != parentAlias {
+			applyLayerLayout(currentLayerEntry, containerWidth, containerHeight)
+		}
+	}
+}
+
+/*
+applyLayerLayout allows you to move and resize a text layer according to its
+layout, using the container size provided. In addition, the following
+information should be noted:
+
+- If the text layer has no layout, it is left untouched.
+
+- If the text layer is a parent, the layouts of all its child text layers
+are applied as well.
+*/
+func applyLayerLayout(layerEntry *memory.LayerEntryType, containerWidth int, containerHeight int) {
+	layoutEntry := layerEntry.LayoutEntry
+	xLocation := layerEntry.ScreenXLocation
+	yLocation := layerEntry.ScreenYLocation
+	width := layerEntry.Width
+	height := layerEntry.Height
+	if layoutEntry.IsPercentLayout {
+		xLocation = int(float32(containerWidth) * layoutEntry.XPercent / 100)
+		yLocation = int(float32(containerHeight) * layoutEntry.YPercent / 100)
+		width = int(float32(containerWidth) * layoutEntry.WidthPercent / 100)
+		height = int(float32(containerHeight) * layoutEntry.HeightPercent / 100)
+	} else if layoutEntry.IsAnchored {
+		if layoutEntry.AnchorType&constants.AnchorRight != 0 {
+			if layoutEntry.AnchorType&constants.AnchorLeft != 0 {
+				width = containerWidth - xLocation - layoutEntry.RightMargin
+			} else {
+				xLocation = containerWidth - layoutEntry.RightMargin - width
+			}
+		}
+		if layoutEntry.AnchorType&constants.AnchorBottom != 0 {
+			if layoutEntry.AnchorType&constants.AnchorTop != 0 {
+				height = containerHeight - yLocation - layoutEntry.BottomMargin
+			} else {
+				yLocation = containerHeight - layoutEntry.BottomMargin - height
+			}
+		}
+	}
+	if width < 1 {
+		width = 1
+	}
+	if height < 1 {
+		height = 1
+	}
+	if xLocation != layerEntry.ScreenXLocation || yLocation != layerEntry.ScreenYLocation || width != layerEntry.Width || height != layerEntry.Height {
+		markLayerAsDirty(layerEntry)
+		layerEntry.ScreenXLocation = xLocation
+		layerEntry.ScreenYLocation = yLocation
+		if width != layerEntry.Width || height != layerEntry.Height {
+			layerEntry.Resize(width, height)
+		}
+		markLayerAsDirty(layerEntry)
+	}
+	if layerEntry.IsParent {
+		applyLayerLayouts(layerEntry.LayerAlias, layerEntry.Width, layerEntry.Height)
+	}
+}
+
+/*
+setPendingResize allows you to record that the terminal display has been
+resized. Since this is called from the background event monitor, the resize
+itself is not processed until 'applyPendingResize' is called. If the size
+reported is the same as the last size recorded, then it is ignored and
+'false' is returned.
+*/
+func setPendingResize(width int, height int) bool {
+	commonResource.resizeMutex.Lock()
+	defer commonResource.resizeMutex.Unlock()
+	if width == commonResource.pendingResizeWidth && height == commonResource.pendingResizeHeight {
+		return false
+	}
+	commonResource.isResizePending = true
+	commonResource.pendingResizeWidth = width
+	commonResource.pendingResizeHeight = height
+	return true
+}
+
+/*
+applyPendingResize allows you to process a terminal resize previously
+recorded by the background event monitor. If no resize is pending, then
+nothing happens.
+*/
+func applyPendingResize() {
+	commonResource.resizeMutex.Lock()
+	isResizePending := commonResource.isResizePending
+	width := commonResource.pendingResizeWidth
+	height := commonResource.pendingResizeHeight
+	commonResource.isResizePending = false
+	commonResource.resizeMutex.Unlock()
+	if isResizePending {
+		resizeTerminal(width, height)
+	}
+}
+
+/*
+resizeTerminal allows you to change the logical size of the terminal
+display. In addition, the following information should be noted:
+
+- If the size specified is the same as the current size, or is not valid,
+then nothing happens.
+
+- The entire display is redrawn on the next update, all text layer layouts
+are applied, and then all resize callbacks are called.
+*/
+func resizeTerminal(width int, height int) {
+	if width <= 0 || height <= 0 || (width == commonResource.terminalWidth && height == commonResource.terminalHeight) {
+		return
+	}
+	commonResource.displayMutex.Lock()
+	commonResource.terminalWidth = width
+	commonResource.terminalHeight = height
+	commonResource.screenLayer = memory.NewLayerEntry(width, height)
+	commonResource.renderLayer = memory.NewLayerEntry(width, height)
+	commonResource.dirtyRegion = memory.NewRegionEntry(0, 0, width, height)
+	commonResource.isScreenRefreshRequired = true
+	commonResource.displayMutex.Unlock()
+	applyLayerLayouts("", width, height)
+	for _, currentCallbackAlias := range memory.GetSortedResizeCallbackAliasSlice() {
+		memory.ResizeCallbackMemory[currentCallbackAlias](width, height)
+	}
+}
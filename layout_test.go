@@ -0,0 +1,57 @@
+package dosktop
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestLayoutTerminalResize(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(40, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("StatusBar", 0, 9, 40, 1, 2, "")
+	SetLayerAnchor("StatusBar", constants.AnchorLeft|constants.AnchorRight|constants.AnchorBottom)
+	AddLayer("Sidebar", 0, 0, 1, 1, 1, "")
+	SetLayerLayoutByPercent("Sidebar", 0, 0, 25, 100)
+	callbackWidth, callbackHeight := 0, 0
+	AddResizeCallback("MyCallback", func(width int, height int) {
+		callbackWidth = width
+		callbackHeight = height
+	})
+	UpdateDisplay()
+	simulationScreen.SetSize(60, 20)
+	simulationScreen.PostEvent(tcell.NewEventResize(60, 20))
+	assert.Equalf(test, "resize", waitForKeystroke(), "A resize keystroke was not received!")
+	width, height := GetTerminalSize()
+	assert.Equalf(test, []int{60, 20}, []int{width, height}, "The terminal size was not updated after a resize!")
+	assert.Equalf(test, []int{60, 20}, []int{callbackWidth, callbackHeight}, "The resize callback was not called with the new terminal size!")
+	statusBarEntry := memory.GetLayer("StatusBar")
+	assert.Equalf(test, []int{0, 19, 60, 1}, []int{statusBarEntry.ScreenXLocation, statusBarEntry.ScreenYLocation, statusBarEntry.Width, statusBarEntry.Height}, "The anchored layer was not moved and stretched correctly!")
+	sidebarEntry := memory.GetLayer("Sidebar")
+	assert.Equalf(test, []int{0, 0, 15, 20}, []int{sidebarEntry.ScreenXLocation, sidebarEntry.ScreenYLocation, sidebarEntry.Width, sidebarEntry.Height}, "The percentage layout was not applied correctly!")
+	UpdateDisplay()
+	assert.Equalf(test, []int{60, 20}, []int{commonResource.screenLayer.Width, commonResource.screenLayer.Height}, "The rendered display was not resized!")
+}
+
+func TestLayoutChildLayers(test *testing.T) {
+	commonResource.isDebugEnabled = true
+	InitializeTerminal(40, 20)
+	AddLayer("Parent", 0, 0, 20, 10, 1, "")
+	AddLayer("Child", 15, 2, 4, 3, 2, "Parent")
+	SetLayerAnchor("Child", constants.AnchorRight|constants.AnchorTop)
+	SetLayerLayoutByPercent("Parent", 0, 0, 100, 100)
+	childEntry := memory.GetLayer("Child")
+	assert.Equalf(test, []int{35, 2, 4, 3}, []int{childEntry.ScreenXLocation, childEntry.ScreenYLocation, childEntry.Width, childEntry.Height}, "The child layer did not follow the right edge of its parent!")
+	ResizeLayer("Parent", 30, 10)
+	assert.Equalf(test, 25, childEntry.ScreenXLocation, "The child layer did not follow its parent when resized directly!")
+	SetLayerAnchor("Child", constants.AnchorNone)
+	ResizeLayer("Parent", 10, 10)
+	assert.Equalf(test, 25, childEntry.ScreenXLocation, "A child layer without anchors should not move when its parent is resized!")
+	resizeTerminal(20, 5)
+	parentEntry := memory.GetLayer("Parent")
+	assert.Equalf(test, []int{20, 5}, []int{parentEntry.Width, parentEntry.Height}, "The percentage layout was not reapplied when the terminal was resized!")
+	assert.Panicsf(test, func() { ResizeLayer("Parent", 0, 5) }, "Resizing a layer to an invalid size did not panic!")
+}
@@ -0,0 +1,345 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"github.com/supercom32/dosktop/internal/stringformat"
+)
+
+/*
+These constants represent limits placed on progress bars.
+*/
+const (
+	progressBarMarqueeInterval = 100
+)
+
+/*
+progressBarHorizontalEighths holds the characters used to draw a partially
+filled cell of a horizontal progress bar, indexed by the number of eighths
+of the cell which are filled.
+*/
+var progressBarHorizontalEighths = []rune{' ', constants.CharBlockLeftOneEighth, constants.CharBlockLeftOneQuarter, constants.CharBlockLeftThreeEighths, constants.CharBlockLeftHalf, constants.CharBlockLeftFiveEighths, constants.CharBlockLeftThreeQuarters, constants.CharBlockLeftSevenEighths}
+
+/*
+progressBarVerticalEighths holds the characters used to draw a partially
+filled cell of a vertical progress bar, indexed by the number of eighths of
+the cell which are filled.
+*/
+var progressBarVerticalEighths = []rune{' ', constants.CharBlockLowerOneEighth, constants.CharBlockLowerOneQuarter, constants.CharBlockLowerThreeEighths, constants.CharBlockLowerHalf, constants.CharBlockLowerFiveEighths, constants.CharBlockLowerThreeQuarters, constants.CharBlockLowerSevenEighths}
+
+/*
+AddProgressBar allows you to add a progress bar to a text layer. Horizontal
+progress bars fill from left to right, while vertical progress bars fill
+from the bottom up. In addition, the following information should be
+noted:
+
+- Progress bars start with a value of 0 out of 100. Use
+'SetProgressBarValue' to change how much of the bar is filled.
+
+- Filled cells are drawn with the progress bar foreground pattern of your
+style, and empty cells with its background pattern. The cell where the
+filled area ends is drawn with an eighth block character, so that progress
+is shown with a precision of one eighth of a cell.
+
+- Progress bars do not receive focus, and are not part of the tab order.
+They are not drawn physically to the text layer provided. Instead they are
+rendered to the terminal at the same time when the text layer is rendered.
+
+- If the width or height of your progress bar is less than or equal to 0,
+a panic will be generated to fail as fast as possible.
+*/
+func AddProgressBar(layerAlias string, progressBarAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, height int, isVertical bool) {
+	if width <= 0 || height <= 0 {
+		panic(fmt.Sprintf("The specified progress bar size of '%dx%d' is invalid!", width, height))
+	}
+	DeleteProgressBar(layerAlias, progressBarAlias)
+	memory.AddProgressBar(layerAlias, progressBarAlias, styleEntry, xLocation, yLocation, width, height, isVertical)
+	markProgressBarAsDirty(layerAlias, progressBarAlias)
+}
+
+/*
+DeleteProgressBar allows you to remove a progress bar from a text layer. If
+the progress bar is indeterminate, its marquee timer is removed as well. In
+addition, the following information should be noted:
+
+- If you attempt to delete a progress bar which does not exist, then the
+request will simply be ignored.
+*/
+func DeleteProgressBar(layerAlias string, progressBarAlias string) {
+	if !memory.IsProgressBarExists(layerAlias, progressBarAlias) {
+		return
+	}
+	progressBarEntry := memory.GetProgressBar(layerAlias, progressBarAlias)
+	if progressBarEntry.MarqueeTimerAlias != "" {
+		DeleteTimer(progressBarEntry.MarqueeTimerAlias)
+	}
+	markProgressBarAsDirty(layerAlias, progressBarAlias)
+	memory.DeleteProgressBar(layerAlias, progressBarAlias)
+}
+
+/*
+SetProgressBarValue allows you to change how much of a progress bar is
+filled. In addition, the following information should be noted:
+
+- The fraction of the bar filled is the value provided divided by the
+maximum value provided. Values less than 0 or greater than the maximum
+value are treated as 0 or the maximum value respectively.
+
+- If the progress bar is indeterminate, the value is remembered but not
+shown until the progress bar is made determinate again.
+
+- If the progress bar does not exist, or the maximum value is less than or
+equal to 0, a panic will be generated to fail as fast as possible.
+*/
+func SetProgressBarValue(layerAlias string, progressBarAlias string, value int, maximumValue int) {
+	if maximumValue <= 0 {
+		panic(fmt.Sprintf("The specified progress bar maximum value of '%d' is invalid!", maximumValue))
+	}
+	progressBarEntry := memory.GetProgressBar(layerAlias, progressBarAlias)
+	if value < 0 {
+		value = 0
+	}
+	if value > maximumValue {
+		value = maximumValue
+	}
+	progressBarEntry.Value = value
+	progressBarEntry.MaximumValue = maximumValue
+	markProgressBarAsDirty(layerAlias, progressBarAlias)
+}
+
+/*
+GetProgressBarValue allows you to obtain the current value and maximum
+value of a progress bar. If the progress bar does not exist, then a panic
+will be generated to fail as fast as possible.
+*/
+func GetProgressBarValue(layerAlias string, progressBarAlias string) (int, int) {
+	progressBarEntry := memory.GetProgressBar(layerAlias, progressBarAlias)
+	return progressBarEntry.Value, progressBarEntry.MaximumValue
+}
+
+/*
+SetProgressBarLabel allows you to choose whether a percentage label, such
+as "42%", is drawn over the middle of a progress bar. The part of the label
+which overlaps the filled area is drawn in reversed colors so that it
+remains readable. Labels are not drawn on indeterminate progress bars, or
+on progress bars too narrow to fit them. If the progress bar does not
+exist, then a panic will be generated to fail as fast as possible.
+*/
+func SetProgressBarLabel(layerAlias string, progressBarAlias string, isLabelDrawn bool) {
+	progressBarEntry := memory.GetProgressBar(layerAlias, progressBarAlias)
+	progressBarEntry.IsLabelDrawn = isLabelDrawn
+	markProgressBarAsDirty(layerAlias, progressBarAlias)
+}
+
+/*
+SetProgressBarIndeterminate allows you to switch a progress bar between
+showing a value and showing a marquee, for when the amount of work
+remaining is not known. In addition, the following information should be
+noted:
+
+- The marquee is a filled segment which sweeps back and forth along the
+progress bar. It is animated with an internal timer whose alias is built
+from the reserved prefix "ProgressBarMarquee:", the text layer alias and
+the progress bar alias, so that it cannot clash with your own timers or
+with a progress bar of the same name on another text layer. The timer is
+removed when the progress bar is made determinate again.
+
+- Every time the marquee moves, its timer event is returned by 'PollEvent'
+and 'WaitForEvent' like any other timer, so that your event loop can call
+'UpdateDisplay' to show it. Progress bars are only animated while your
+application is reading events.
+
+- If the progress bar does not exist, then a panic will be generated to
+fail as fast as possible.
+*/
+func SetProgressBarIndeterminate(layerAlias string, progressBarAlias string, isIndeterminate bool) {
+	progressBarEntry := memory.GetProgressBar(layerAlias, progressBarAlias)
+	if progressBarEntry.MarqueeTimerAlias != "" {
+		DeleteTimer(progressBarEntry.MarqueeTimerAlias)
+		progressBarEntry.MarqueeTimerAlias = ""
+	}
+	progressBarEntry.IsIndeterminate = isIndeterminate
+	progressBarEntry.MarqueePosition = 0
+	progressBarEntry.MarqueeDirection = 1
+	if isIndeterminate {
+		progressBarEntry.MarqueeTimerAlias = getProgressBarMarqueeTimerAlias(layerAlias, progressBarAlias)
+		AddTimer(progressBarEntry.MarqueeTimerAlias, progressBarMarqueeInterval, true)
+	}
+	markProgressBarAsDirty(layerAlias, progressBarAlias)
+}
+
+/*
+getProgressBarMarqueeTimerAlias allows you to obtain the alias of the timer
+used to animate the marquee of a progress bar. The alias is unique to the
+text layer and progress bar provided.
+*/
+func getProgressBarMarqueeTimerAlias(layerAlias string, progressBarAlias string) string {
+	return fmt.Sprintf("ProgressBarMarquee:%s:%s", layerAlias, progressBarAlias)
+}
+
+/*
+updateProgressBarMarquee allows you to advance the marquee of the
+indeterminate progress bar animated by the timer provided, and restart its
+timer. Returns 'false' if the timer does not belong to a progress bar.
+*/
+func updateProgressBarMarquee(timerAlias string) bool {
+	for currentLayerAlias, currentProgressBarEntries := range memory.ProgressBarMemory {
+		for currentProgressBarAlias, currentProgressBarEntry := range currentProgressBarEntries {
+			if !currentProgressBarEntry.IsIndeterminate || currentProgressBarEntry.MarqueeTimerAlias != timerAlias {
+				continue
+			}
+			length, _ := getProgressBarLength(currentProgressBarEntry)
+			travel := length - getProgressBarMarqueeLength(currentProgressBarEntry)
+			marqueePosition := currentProgressBarEntry.MarqueePosition + currentProgressBarEntry.MarqueeDirection
+			if marqueePosition < 0 || marqueePosition > travel {
+				currentProgressBarEntry.MarqueeDirection = -currentProgressBarEntry.MarqueeDirection
+				marqueePosition = currentProgressBarEntry.MarqueePosition + currentProgressBarEntry.MarqueeDirection
+			}
+			if marqueePosition < 0 || marqueePosition > travel {
+				marqueePosition = 0
+			}
+			currentProgressBarEntry.MarqueePosition = marqueePosition
+			markProgressBarAsDirty(currentLayerAlias, currentProgressBarAlias)
+			StartTimer(timerAlias)
+			return true
+		}
+	}
+	return false
+}
+
+/*
+markProgressBarAsDirty allows you to flag the area of a text layer covered
+by a progress bar as modified, so that it is redrawn the next time the
+display is updated. If the progress bar or the text layer it belongs to
+does not exist, then the request will simply be ignored.
+*/
+func markProgressBarAsDirty(layerAlias string, progressBarAlias string) {
+	if !memory.IsLayerExists(layerAlias) || !memory.IsProgressBarExists(layerAlias, progressBarAlias) {
+		return
+	}
+	progressBarEntry := memory.GetProgressBar(layerAlias, progressBarAlias)
+	memory.GetLayer(layerAlias).MarkDirtyRegion(progressBarEntry.XLocation, progressBarEntry.YLocation, progressBarEntry.Width, progressBarEntry.Height)
+}
+
+/*
+getProgressBarLength allows you to obtain the length of a progress bar in
+cells along the direction it fills, and its thickness in cells across it.
+*/
+func getProgressBarLength(progressBarEntry *memory.ProgressBarEntryType) (int, int) {
+	if progressBarEntry.IsVertical {
+		return progressBarEntry.Height, progressBarEntry.Width
+	}
+	return progressBarEntry.Width, progressBarEntry.Height
+}
+
+/*
+getProgressBarMarqueeLength allows you to obtain the length in cells of the
+segment which sweeps along an indeterminate progress bar.
+*/
+func getProgressBarMarqueeLength(progressBarEntry *memory.ProgressBarEntryType) int {
+	length, _ := getProgressBarLength(progressBarEntry)
+	marqueeLength := length / 4
+	if marqueeLength < 1 {
+		marqueeLength = 1
+	}
+	return marqueeLength
+}
+
+/*
+getProgressBarCells allows you to obtain the character drawn in each cell
+along the length of a progress bar, starting from the cell where filling
+begins. Whether each cell is part of the filled area is also returned.
+*/
+func getProgressBarCells(progressBarEntry *memory.ProgressBarEntryType) ([]rune, []bool) {
+	styleEntry := progressBarEntry.StyleEntry
+	length, _ := getProgressBarLength(progressBarEntry)
+	cells := make([]rune, length)
+	isCellFilled := make([]bool, length)
+	for currentIndex := range cells {
+		cells[currentIndex] = styleEntry.ProgressBarBackgroundPattern
+	}
+	if progressBarEntry.IsIndeterminate {
+		for currentIndex := 0; currentIndex < getProgressBarMarqueeLength(progressBarEntry); currentIndex++ {
+			if progressBarEntry.MarqueePosition+currentIndex < length {
+				cells[progressBarEntry.MarqueePosition+currentIndex] = styleEntry.ProgressBarForegroundPattern
+				isCellFilled[progressBarEntry.MarqueePosition+currentIndex] = true
+			}
+		}
+		return cells, isCellFilled
+	}
+	filledEighths := progressBarEntry.Value * length * 8 / progressBarEntry.MaximumValue
+	for currentIndex := 0; currentIndex < filledEighths/8; currentIndex++ {
+		cells[currentIndex] = styleEntry.ProgressBarForegroundPattern
+		isCellFilled[currentIndex] = true
+	}
+	if filledEighths%8 != 0 {
+		if progressBarEntry.IsVertical {
+			cells[filledEighths/8] = progressBarVerticalEighths[filledEighths%8]
+		} else {
+			cells[filledEighths/8] = progressBarHorizontalEighths[filledEighths%8]
+		}
+	}
+	return cells, isCellFilled
+}
+
+/*
+drawProgressBarsOnLayer allows you to draw all progress bars on a given
+text layer entry.
+*/
+func drawProgressBarsOnLayer(layerEntry memory.LayerEntryType) {
+	for _, currentProgressBarEntry := range memory.ProgressBarMemory[layerEntry.LayerAlias] {
+		drawProgressBar(&layerEntry, currentProgressBarEntry)
+	}
+}
+
+/*
+drawProgressBar allows you to draw a progress bar on a given text layer. In
+addition, the following information should be noted:
+
+- Every row of a horizontal progress bar, or column of a vertical progress
+bar, is drawn the same way.
+
+- Progress bars use the text colors of their style. The percentage label
+is drawn in the middle row of the progress bar, with characters which
+overlap the filled area drawn in reversed colors.
+*/
+func drawProgressBar(layerEntry *memory.LayerEntryType, progressBarEntry *memory.ProgressBarEntryType) {
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = progressBarEntry.StyleEntry.TextForegroundColor
+	attributeEntry.BackgroundColor = progressBarEntry.StyleEntry.TextBackgroundColor
+	reversedAttributeEntry := memory.NewAttributeEntry()
+	reversedAttributeEntry.ForegroundColor = progressBarEntry.StyleEntry.TextBackgroundColor
+	reversedAttributeEntry.BackgroundColor = progressBarEntry.StyleEntry.TextForegroundColor
+	cells, isCellFilled := getProgressBarCells(progressBarEntry)
+	length, thickness := getProgressBarLength(progressBarEntry)
+	for currentIndex, currentCell := range cells {
+		for currentOffset := 0; currentOffset < thickness; currentOffset++ {
+			if progressBarEntry.IsVertical {
+				printLayer(layerEntry, attributeEntry, progressBarEntry.XLocation+currentOffset, progressBarEntry.YLocation+length-1-currentIndex, []rune{currentCell})
+			} else {
+				printLayer(layerEntry, attributeEntry, progressBarEntry.XLocation+currentIndex, progressBarEntry.YLocation+currentOffset, []rune{currentCell})
+			}
+		}
+	}
+	if !progressBarEntry.IsLabelDrawn || progressBarEntry.IsIndeterminate {
+		return
+	}
+	label := []rune(stringformat.GetIntAsString(progressBarEntry.Value*100/progressBarEntry.MaximumValue) + "%")
+	if len(label) > progressBarEntry.Width {
+		return
+	}
+	xLocation := progressBarEntry.XLocation + (progressBarEntry.Width-len(label))/2
+	yLocation := progressBarEntry.YLocation + progressBarEntry.Height/2
+	for currentIndex, currentCharacter := range label {
+		cellIndex := xLocation + currentIndex - progressBarEntry.XLocation
+		if progressBarEntry.IsVertical {
+			cellIndex = progressBarEntry.YLocation + length - 1 - yLocation
+		}
+		labelAttributeEntry := attributeEntry
+		if isCellFilled[cellIndex] {
+			labelAttributeEntry = reversedAttributeEntry
+		}
+		printLayer(layerEntry, labelAttributeEntry, xLocation+currentIndex, yLocation, []rune{currentCharacter})
+	}
+}
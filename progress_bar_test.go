@@ -0,0 +1,76 @@
+package dosktop
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestProgressBar(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 6)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Form", 0, 0, 20, 6, 1, "")
+	styleEntry := NewTuiStyleEntry()
+	styleEntry.ProgressBarBackgroundPattern = '-'
+	styleEntry.ProgressBarForegroundPattern = '#'
+	getScreenText := func(xLocation int, yLocation int, width int, height int) string {
+		UpdateDisplay()
+		cells, screenWidth, _ := simulationScreen.GetContents()
+		text := ""
+		for currentRow := yLocation; currentRow < yLocation+height; currentRow++ {
+			for currentColumn := xLocation; currentColumn < xLocation+width; currentColumn++ {
+				text += string(cells[currentRow*screenWidth+currentColumn].Runes[0])
+			}
+		}
+		return text
+	}
+	AddProgressBar("Form", "Download", styleEntry, 0, 0, 10, 1, false)
+	SetProgressBarValue("Form", "Download", 45, 100)
+	assert.Equalf(test, "####▌-----", getScreenText(0, 0, 10, 1), "A horizontal progress bar was not filled to the nearest eighth of a cell!")
+	SetProgressBarLabel("Form", "Download", true)
+	assert.Equalf(test, "###45%----", getScreenText(0, 0, 10, 1), "The percentage label was not drawn over the progress bar!")
+	assert.Equalf(test, styleEntry.TextBackgroundColor, memory.GetLayer("Form").CharacterMemory[0][0].AttributeEntry.BackgroundColor, "Drawing the progress bar modified the text layer underneath it!")
+	SetProgressBarValue("Form", "Download", 150, 100)
+	value, maximumValue := GetProgressBarValue("Form", "Download")
+	assert.Equalf(test, []int{100, 100}, []int{value, maximumValue}, "A value larger than the maximum value was not limited!")
+	AddProgressBar("Form", "Level", styleEntry, 12, 0, 1, 4, true)
+	SetProgressBarValue("Form", "Level", 3, 8)
+	assert.Equalf(test, "--▄#", getScreenText(12, 0, 1, 4), "A vertical progress bar was not filled from the bottom up!")
+	SetProgressBarIndeterminate("Form", "Level", true)
+	assert.Equalf(test, "---#", getScreenText(12, 0, 1, 4), "The marquee of an indeterminate progress bar was not drawn!")
+	eventEntry := WaitForEvent()
+	assert.Equalf(test, []interface{}{constants.EventTypeTimer, "ProgressBarMarquee:Form:Level"}, []interface{}{eventEntry.EventType, eventEntry.TimerEvent.TimerAlias}, "The marquee timer event was not returned!")
+	assert.Equalf(test, "--#-", getScreenText(12, 0, 1, 4), "The marquee did not move when its timer expired!")
+	SetProgressBarIndeterminate("Form", "Level", false)
+	assert.Equalf(test, []interface{}{false, "--▄#"}, []interface{}{memory.TimerMemory["ProgressBarMarquee:Form:Level"] != nil, getScreenText(12, 0, 1, 4)}, "The marquee timer was not removed, or the value was not shown again!")
+	DeleteProgressBar("Form", "Download")
+	assert.Falsef(test, memory.IsProgressBarExists("Form", "Download"), "The progress bar was not deleted!")
+	assert.Panicsf(test, func() { AddProgressBar("Form", "Invalid", styleEntry, 0, 0, 0, 1, false) }, "Adding a progress bar with no width did not panic!")
+}
+
+func TestProgressBarMarqueeOnSeveralLayers(test *testing.T) {
+	_, err := InitializeHeadlessTerminal(20, 6)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("First", 0, 0, 20, 3, 1, "")
+	AddLayer("Second", 0, 3, 20, 3, 1, "")
+	AddTimer("DownloadMarquee", 60000, true)
+	AddProgressBar("First", "Download", NewTuiStyleEntry(), 0, 0, 10, 1, false)
+	AddProgressBar("Second", "Download", NewTuiStyleEntry(), 0, 0, 10, 1, false)
+	SetProgressBarIndeterminate("First", "Download", true)
+	SetProgressBarIndeterminate("Second", "Download", true)
+	timerAliases := map[string]bool{}
+	for len(timerAliases) < 2 {
+		if eventEntry := WaitForEvent(); eventEntry.EventType == constants.EventTypeTimer {
+			timerAliases[eventEntry.TimerEvent.TimerAlias] = true
+		}
+	}
+	assert.Equalf(test, map[string]bool{"ProgressBarMarquee:First:Download": true, "ProgressBarMarquee:Second:Download": true}, timerAliases, "Progress bars with the same alias on different text layers did not get their own marquee timers!")
+	assert.Truef(test, memory.GetProgressBar("First", "Download").MarqueePosition > 0 && memory.GetProgressBar("Second", "Download").MarqueePosition > 0, "Both marquees were not animated!")
+	DeleteProgressBar("First", "Download")
+	assert.Equalf(test, []bool{false, true, true}, []bool{memory.TimerMemory["ProgressBarMarquee:First:Download"] != nil, memory.TimerMemory["ProgressBarMarquee:Second:Download"] != nil, memory.TimerMemory["DownloadMarquee"] != nil}, "Deleting a progress bar removed a timer which did not belong to it!")
+	SetProgressBarIndeterminate("Second", "Download", false)
+	assert.Truef(test, memory.TimerMemory["DownloadMarquee"] != nil, "Making a progress bar determinate removed a user timer!")
+}
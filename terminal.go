@@ -2,13 +2,13 @@ package dosktop
 
 import (
 	"github.com/supercom32/dosktop/constants"
-	"github.com/supercom32/dosktop/internal/math"
 	"github.com/supercom32/dosktop/internal/memory"
 	"github.com/supercom32/dosktop/internal/stringformat"
 	"fmt"
 	"github.com/gdamore/tcell"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 )
 
@@ -24,6 +24,23 @@ type defaultValueType struct {
 	screenLayer    memory.LayerEntryType
 	debugDirectory string
 	isDebugEnabled bool
+	eventUpdaterDone chan bool
+	renderLayer    memory.LayerEntryType
+	dirtyRegion    memory.RegionEntryType
+	isScreenRefreshRequired bool
+	displayMutex   sync.Mutex
+	isResizePending bool
+	pendingResizeWidth int
+	pendingResizeHeight int
+	resizeMutex    sync.Mutex
+	eventChannel   chan memory.EventEntryType
+	eventChannelTimer chan int64
+	eventChannelPause chan bool
+	eventChannelResume chan bool
+	eventChannelPauseCount int
+	eventChannelStop chan bool
+	eventChannelDone chan bool
+	eventChannelMutex sync.Mutex
 }
 
 /*
@@ -32,53 +49,258 @@ by this package.
 */
 var commonResource defaultValueType
 
+/*
+closeHandlerOnce ensures the close handler is only registered once, no
+matter how many times the terminal is initialized.
+*/
+var closeHandlerOnce sync.Once
+
 /*
 InitializeTerminal allows you to initialize Dosktop for the first time.
 This method must be called first before any operations take place. The
 parameters 'width' and 'height' represent the display size of the
-terminal instance you wish to create. In addition, the following
-information should be noted:
+terminal instance you wish to create. For example:
+
+	// Initialize a terminal with a display size of 80x25.
+	err := dosktop.InitializeTerminal(80, 25)
+	if err != nil {
+		// Fall back to a plain command line mode here.
+	}
+
+In addition, the following information should be noted:
+
+- If you pass in a negative value for ether width or height a panic will be
+generated to fail as fast as possible.
+
+- If the terminal could not be initialized, an error is returned instead
+of terminating your application. This allows you to decide how to recover,
+such as falling back to a plain command line mode.
+
+- This method is equivalent to calling 'InitializeTerminalWithSettings'
+using default settings with the specified width and height.
+*/
+func InitializeTerminal(width int, height int) error {
+	terminalSettings := memory.NewTerminalSettingsEntry()
+	terminalSettings.Width = width
+	terminalSettings.Height = height
+	return InitializeTerminalWithSettings(terminalSettings)
+}
+
+/*
+NewTerminalSettingsEntry allows you to obtain a new settings entry which can
+be used for specifying how a terminal session should be initialized. By
+default, mouse support is enabled and debug information is written to the
+'/tmp/' directory. For example:
+
+	// Create a new terminal settings entry to configure.
+	terminalSettings := dosktop.NewTerminalSettingsEntry()
+	// Configure a display size of 80x25 with mouse support disabled.
+	terminalSettings.Width = 80
+	terminalSettings.Height = 25
+	terminalSettings.IsMouseEnabled = false
+	err := dosktop.InitializeTerminalWithSettings(terminalSettings)
+*/
+func NewTerminalSettingsEntry() memory.TerminalSettingsEntryType {
+	return memory.NewTerminalSettingsEntry()
+}
+
+/*
+InitializeTerminalWithSettings allows you to initialize Dosktop using the
+settings provided. This method must be called first before any operations
+take place. In addition, the following information should be noted:
+
+- If the width or height specified is zero, the current size of the
+terminal will be used for that dimension instead.
+
+- If you pass in a negative value for ether width or height a panic will be
+generated to fail as fast as possible.
+
+- If the terminal could not be initialized, an error is returned and the
+terminal is left untouched.
+*/
+func InitializeTerminalWithSettings(terminalSettings memory.TerminalSettingsEntryType) error {
+	if terminalSettings.Width < 0 || terminalSettings.Height < 0 {
+		panic(fmt.Sprintf("The specified terminal width and height of '%d, %d' is invalid!", terminalSettings.Width, terminalSettings.Height))
+	}
+	if commonResource.isDebugEnabled {
+		if terminalSettings.Width == 0 || terminalSettings.Height == 0 {
+			panic(fmt.Sprintf("The specified terminal width and height of '%d, %d' is invalid!", terminalSettings.Width, terminalSettings.Height))
+		}
+		initializeCommonResources(terminalSettings)
+		return nil
+	}
+	shutdownScreen()
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		return fmt.Errorf("the terminal screen could not be created: %w", err)
+	}
+	if err := screen.Init(); err != nil {
+		return fmt.Errorf("the terminal screen could not be initialized: %w", err)
+	}
+	screenWidth, screenHeight := screen.Size()
+	if terminalSettings.Width == 0 {
+		terminalSettings.Width = screenWidth
+	}
+	if terminalSettings.Height == 0 {
+		terminalSettings.Height = screenHeight
+	}
+	if terminalSettings.Width == 0 || terminalSettings.Height == 0 {
+		screen.Fini()
+		return fmt.Errorf("the terminal size of '%d, %d' could not be determined", terminalSettings.Width, terminalSettings.Height)
+	}
+	initializeCommonResources(terminalSettings)
+	setupScreen(screen, terminalSettings.IsMouseEnabled)
+	setupCloseHandler()
+	return nil
+}
+
+/*
+InitializeHeadlessTerminal allows you to initialize Dosktop against an
+in-memory simulation screen instead of a real terminal. This is useful for
+automated testing or continuous integration environments where no TTY is
+attached, since your application can run exactly as it normally would. The
+simulation screen is returned so that you can inject keystrokes and mouse
+events, or inspect what was actually drawn. For example:
+
+	// Initialize a headless terminal with a display size of 80x25.
+	simulationScreen, err := dosktop.InitializeHeadlessTerminal(80, 25)
+	// Inject the 'enter' key as if the user had pressed it.
+	simulationScreen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+
+In addition, the following information should be noted:
 
 - If you pass in a zero or negative value for ether width or height a panic
 will be generated to fail as fast as possible.
+
+- Injected events are processed in the background, just like events from
+a real terminal. Methods like 'Inkey' will only return them once they have
+been recorded.
+
+- Calling this method again will shut down any previously initialized
+screen before creating a new one.
 */
-func InitializeTerminal(width int, height int) {
-	if width <=0 || height <= 0 {
+func InitializeHeadlessTerminal(width int, height int) (tcell.SimulationScreen, error) {
+	if width <= 0 || height <= 0 {
 		panic(fmt.Sprintf("The specified terminal width and height of '%d, %d' is invalid!", width, height))
 	}
+	shutdownScreen()
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		return nil, fmt.Errorf("the headless terminal screen could not be initialized: %w", err)
+	}
+	screen.SetSize(width, height)
+	terminalSettings := memory.NewTerminalSettingsEntry()
+	terminalSettings.Width = width
+	terminalSettings.Height = height
+	initializeCommonResources(terminalSettings)
+	commonResource.isDebugEnabled = false
+	setupScreen(screen, terminalSettings.IsMouseEnabled)
+	return screen, nil
+}
+
+/*
+initializeCommonResources allows you to reset all memory and shared
+settings used by a terminal session. If a screen from a previous session is
+still active, it will be shut down so that its event monitoring stops.
+*/
+func initializeCommonResources(terminalSettings memory.TerminalSettingsEntryType) {
+	stopEventChannel()
+	shutdownScreen()
 	memory.InitializeScreenMemory()
 	memory.InitializeButtonMemory()
 	memory.InitializeImageMemory()
 	memory.InitializeTextStyleMemory()
 	memory.InitializeTimerMemory()
-	commonResource.terminalWidth = width
-	commonResource.terminalHeight = height
-	commonResource.debugDirectory = "/tmp/"
-	if !commonResource.isDebugEnabled {
-		screen, err := tcell.NewScreen()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v\n", err)
-			os.Exit(1)
-		}
-		if err := screen.Init(); err != nil {
-			fmt.Fprintf(os.Stderr, "%v\n", err)
-			os.Exit(1)
-		}
-		commonResource.screen = screen
+	memory.InitializeResizeCallbackMemory()
+	memory.InitializeKeyBindingMemory()
+	memory.InitializeWindowMemory()
+	memory.InitializeViewportMemory()
+	memory.InitializeControlMemory()
+	memory.InitializeTextFieldMemory()
+	memory.InitializeMenuMemory()
+	memory.InitializeTextAreaMemory()
+	memory.InitializeListBoxMemory()
+	memory.InitializeDataGridMemory()
+	memory.InitializeTreeViewMemory()
+	memory.InitializeCheckBoxMemory()
+	memory.InitializeRadioButtonGroupMemory()
+	memory.InitializeTabContainerMemory()
+	memory.InitializeMenuBarMemory()
+	memory.InitializeProgressBarMemory()
+	clipboardText = ""
+	buttonHistory = buttonHistoryType{}
+	keyBindingHistory = nil
+	memory.EventMemory.ClearEvents()
+	mouseHistory = mouseHistoryType{}
+	hoverHistory = hoverHistoryType{}
+	windowHistory = windowHistoryType{}
+	focusHistory = focusHistoryType{}
+	pendingEvents = nil
+	memory.MouseMemory.ClearMouseMemory()
+	memory.MouseMemory.SetClickInterval(terminalSettings.DoubleClickInterval)
+	commonResource.terminalWidth = terminalSettings.Width
+	commonResource.terminalHeight = terminalSettings.Height
+	commonResource.screenLayer = memory.NewLayerEntry(terminalSettings.Width, terminalSettings.Height)
+	commonResource.renderLayer = memory.NewLayerEntry(terminalSettings.Width, terminalSettings.Height)
+	commonResource.dirtyRegion = memory.NewRegionEntry(0, 0, terminalSettings.Width, terminalSettings.Height)
+	commonResource.isScreenRefreshRequired = true
+	commonResource.debugDirectory = terminalSettings.DebugDirectory
+	commonResource.resizeMutex.Lock()
+	commonResource.isResizePending = false
+	commonResource.pendingResizeWidth = terminalSettings.Width
+	commonResource.pendingResizeHeight = terminalSettings.Height
+	commonResource.resizeMutex.Unlock()
+}
+
+/*
+setupScreen allows you to attach an initialized screen to the current
+terminal session. Once attached, all events coming from the screen will be
+monitored in the background.
+*/
+func setupScreen(screen tcell.Screen, isMouseEnabled bool) {
+	commonResource.screen = screen
+	if isMouseEnabled {
 		commonResource.screen.EnableMouse()
-		setupCloseHandler()
-		go setupEventUpdater()
 	}
+	commonResource.eventUpdaterDone = make(chan bool)
+	go setupEventUpdater(screen, commonResource.eventUpdaterDone)
+}
+
+/*
+shutdownScreen allows you to shut down the screen attached to the current
+terminal session, if any. This method will not return until background
+event monitoring for the screen has stopped, so that no further events from
+it can be recorded.
+*/
+func shutdownScreen() {
+	if commonResource.screen == nil {
+		return
+	}
+	commonResource.screen.Fini()
+	if commonResource.eventUpdaterDone != nil {
+		<-commonResource.eventUpdaterDone
+		commonResource.eventUpdaterDone = nil
+	}
+	commonResource.screen = nil
 }
 
 /*
 setupEventUpdater is a background method that monitors all events coming
 into the terminal session. When an event is detected, it is recorded and
-monitoring continues.
+monitoring continues. In addition, the following information should be
+noted:
+
+- Monitoring stops once the screen being monitored has been shut down,
+since no further events can be received from it.
 */
-func setupEventUpdater() {
+func setupEventUpdater(screen tcell.Screen, done chan bool) {
+	defer close(done)
 	for {
-		updateEventQueues()
+		event := screen.PollEvent()
+		if event == nil {
+			return
+		}
+		updateEventQueues(event)
 	}
 }
 
@@ -86,17 +308,22 @@ func setupEventUpdater() {
 setupCloseHandler enables the trapping of all unexpected system calls and shuts
 down the terminal gracefully. This means all terminal settings should be reset
 back to normal if anything unexpected happens to the user or if the process is
-killed.
+killed. In addition, the following information should be noted:
+
+- The handler is only registered the first time this method is called, so
+initializing the terminal more than once does not register additional
+signal handlers or goroutines.
 */
 func setupCloseHandler() {
-	channel := make(chan os.Signal)
-	signal.Notify(channel, os.Interrupt, syscall.SIGTERM, syscall.SIGKILL, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGHUP)
-	go func() {
-		<-channel
-		commonResource.screen.Fini()
-		os.Exit(1)
-	}()
-
+	closeHandlerOnce.Do(func() {
+		channel := make(chan os.Signal, 1)
+		signal.Notify(channel, os.Interrupt, syscall.SIGTERM, syscall.SIGKILL, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGHUP)
+		go func() {
+			<-channel
+			RestoreTerminalSettings()
+			os.Exit(1)
+		}()
+	})
 }
 
 /*
@@ -106,7 +333,8 @@ is finished using Dosktop so that the users terminal environment is not
 left in a bad state.
 */
 func RestoreTerminalSettings() {
-	commonResource.screen.Fini()
+	stopEventChannel()
+	shutdownScreen()
 }
 
 /*
@@ -118,9 +346,32 @@ In addition, the following information should be noted:
 - If more than one keystroke is recorded, it is stored sequentially
 in the input buffer and this method needs to be called repeatedly in
 order to read them.
+
+- When the user resizes their terminal, the keyword 'resize' is returned.
+By the time it is returned, the new terminal size has already been applied
+and can be obtained by calling 'GetTerminalSize'.
+
+- Keystrokes are read from the same event queue used by 'PollEvent' and
+'WaitForEvent'. Any mouse, timer, or other non-keyboard events are left in
+the event queue, so they can still be obtained by calling 'PollEvent'.
 */
 func Inkey() string {
-	return memory.KeyboardMemory.GetKeystrokeFromKeyboardBuffer()
+	pauseEventChannel()
+	defer resumeEventChannel()
+	applyPendingResize()
+	keystroke := memory.KeyboardMemory.GetKeystrokeFromKeyboardBuffer()
+	if keystroke != "" {
+		return keystroke
+	}
+	eventEntry, isEventAvailable := memory.EventMemory.GetEventOfType(constants.EventTypeKey, constants.EventTypeResize)
+	if !isEventAvailable {
+		return ""
+	}
+	if eventEntry.EventType == constants.EventTypeResize {
+		applyPendingResize()
+		return "resize"
+	}
+	return eventEntry.KeyEvent.Keystroke
 }
 
 /*
@@ -185,6 +436,7 @@ func AddLayer(layerAlias string, xLocation int, yLocation int, width int, height
 		panic(fmt.Sprintf("Could not create the text layer '%s' since the width and height of (%d, %d) is invalid.", layerAlias, width, height))
 	}
 	memory.AddLayer(layerAlias, xLocation, yLocation, width, height, zOrderPriority, parentAlias)
+	markLayerAsDirty(memory.GetLayer(layerAlias))
 	commonResource.layerAlias = layerAlias
 }
 
@@ -244,6 +496,7 @@ func deleteLayer(layerEntry memory.LayerEntryType, isChild bool) {
 	if layerEntry.LayerAlias == commonResource.layerAlias {
 		panic(fmt.Sprintf("The text layer '%s' could not be deleted since it is the default text layer!", layerEntry.LayerAlias))
 	}
+	markLayerAsDirty(&layerEntry)
 	memory.DeleteLayer(layerEntry.LayerAlias)
 }
 
@@ -378,6 +631,7 @@ func SetAlpha(layerAlias string, alphaValue float32) {
 	layerEntry := memory.GetLayer(layerAlias)
 	layerEntry.DefaultAttribute.ForegroundTransformValue = alphaValue
 	layerEntry.DefaultAttribute.BackgroundTransformValue = alphaValue
+	layerEntry.MarkDirty()
 }
 
 /*
@@ -487,8 +741,11 @@ parent.
 */
 func MoveLayerByAbsoluteValue(layerAlias string, xLocation int, yLocation int) {
 	layerEntry := memory.GetLayer(layerAlias)
+	markLayerAsDirty(layerEntry)
 	layerEntry.ScreenXLocation = xLocation
 	layerEntry.ScreenYLocation = yLocation
+	markLayerAsDirty(layerEntry)
+	updateLayerAnchorMargins(layerEntry)
 }
 
 /*
@@ -509,8 +766,11 @@ parent.
 */
 func MoveLayerByRelativeValue(layerAlias string, xLocation int, yLocation int) {
 	layerEntry := memory.GetLayer(layerAlias)
+	markLayerAsDirty(layerEntry)
 	layerEntry.ScreenXLocation += xLocation
 	layerEntry.ScreenYLocation += yLocation
+	markLayerAsDirty(layerEntry)
+	updateLayerAnchorMargins(layerEntry)
 }
 
 /*
@@ -589,6 +849,11 @@ then only the visible portion of your string will be printed.
 
 - If printing has not yet finished and there are no available lines left, then
 all remaining characters will be discarded and printing will stop.
+
+- Text is printed one grapheme cluster at a time. Combining marks, such as
+accents, share the cell of the character they modify, and wide characters
+occupy two cells. This applies to all text drawn by Dosktop, including
+frame labels and controls.
 */
 func Print(textToPrint string) {
 	PrintLayer(commonResource.layerAlias, textToPrint)
@@ -609,6 +874,11 @@ then only the visible portion of your string will be printed.
 
 - If printing has not yet finished and there are no available lines left, then
 all remaining characters will be discarded and printing will stop.
+
+- Text is printed one grapheme cluster at a time. Combining marks, such as
+accents, share the cell of the character they modify, and wide characters
+occupy two cells. This applies to all text drawn by Dosktop, including
+frame labels and controls.
 */
 func PrintLayer(layerAlias string, textToPrint string) {
 	layerEntry := memory.GetLayer(layerAlias)
@@ -630,6 +900,10 @@ the following information should be noted:
 
 - If the location to print falls outside of the range of the text layer,
 then only the visible portion of your text will be printed.
+
+- Text is printed one grapheme cluster at a time. Combining marks, such as
+accents, are stored in the same cell as the character they modify, and
+wide characters advance the cursor by two cells.
 */
 func printLayer(layerEntry *memory.LayerEntryType, attributeEntry memory.AttributeEntryType, xLocation int, yLocation int, textToPrint []rune) {
 	layerWidth := layerEntry.Width
@@ -637,21 +911,19 @@ func printLayer(layerEntry *memory.LayerEntryType, attributeEntry memory.Attribu
 	cursorXLocation := xLocation
 	cursorYLocation := yLocation
 	characterMemory := layerEntry.CharacterMemory
-	for _, currentCharacter := range textToPrint {
+	for _, currentGraphemeCluster := range stringformat.GetGraphemeClusters(textToPrint) {
 		if cursorXLocation >= 0 && cursorXLocation < layerWidth && cursorYLocation >= 0 && cursorYLocation < layerHeight {
 			characterMemory[cursorYLocation][cursorXLocation].AttributeEntry = memory.NewAttributeEntry(&attributeEntry)
-			characterMemory[cursorYLocation][cursorXLocation].Character = currentCharacter
+			characterMemory[cursorYLocation][cursorXLocation].Character = currentGraphemeCluster[0]
+			characterMemory[cursorYLocation][cursorXLocation].CombiningCharacters = string(currentGraphemeCluster[1:])
 			characterMemory[cursorYLocation][cursorXLocation].LayerAlias = layerEntry.LayerAlias
 		}
-		if stringformat.IsRuneCharacterWide(currentCharacter) {
-			cursorXLocation += 2
-		} else {
-			cursorXLocation++
-		}
+		cursorXLocation += stringformat.GetGraphemeClusterWidth(currentGraphemeCluster)
 		if cursorXLocation >= layerWidth {
-			return
+			break
 		}
 	}
+	layerEntry.MarkDirtyRegion(xLocation, yLocation, cursorXLocation-xLocation, 1)
 }
 
 /*
@@ -678,9 +950,17 @@ clearLayer allows you to empty the specified text layer of all its contents.
 This is useful for internal methods that want to clear a text layer directly.
 */
 func clearLayer(layerEntry *memory.LayerEntryType) {
-	zOrder := layerEntry.ZOrder
-	*layerEntry = memory.NewLayerEntry(layerEntry.Width, layerEntry.Height)
-	layerEntry.ZOrder = zOrder
+	clearedLayerEntry := memory.NewLayerEntry(layerEntry.Width, layerEntry.Height)
+	clearedLayerEntry.ScreenXLocation = layerEntry.ScreenXLocation
+	clearedLayerEntry.ScreenYLocation = layerEntry.ScreenYLocation
+	clearedLayerEntry.ZOrder = layerEntry.ZOrder
+	clearedLayerEntry.IsVisible = layerEntry.IsVisible
+	clearedLayerEntry.LayerAlias = layerEntry.LayerAlias
+	clearedLayerEntry.ParentAlias = layerEntry.ParentAlias
+	clearedLayerEntry.IsParent = layerEntry.IsParent
+	clearedLayerEntry.LayoutEntry = layerEntry.LayoutEntry
+	*layerEntry = clearedLayerEntry
+	layerEntry.MarkDirty()
 }
 
 /*
@@ -702,6 +982,7 @@ func scrollCharacterMemory(layerEntry *memory.LayerEntryType) [][]memory.Charact
 	}
 	characterMemory = append(characterMemory, characterObjectArray)
 	layerEntry.CharacterMemory = characterMemory
+	layerEntry.MarkDirty()
 	return characterMemory
 }
 
@@ -737,7 +1018,95 @@ call 'UpdateDisplay' to refresh the visible display area first.
  */
 func GetCellIdUnderMouseLocation(layerAlias string) int {
 	mouseXLocation, mouseYLocation, _, _ := memory.MouseMemory.GetMouseStatus()
-	return getCellIdByLayerEntry(&commonResource.screenLayer, mouseXLocation, mouseYLocation)
+	return getScreenCellId(mouseXLocation, mouseYLocation)
+}
+
+/*
+getScreenCellId allows you to obtain the cell ID of the text cell currently
+displayed at the specified terminal location. If the location is outside
+the terminal, then a value of '-1' is returned instead.
+*/
+func getScreenCellId(xLocation int, yLocation int) int {
+	characterEntry, isCharacterAvailable := getScreenCharacterEntry(xLocation, yLocation)
+	if !isCharacterAvailable {
+		return -1
+	}
+	return characterEntry.AttributeEntry.CellId
+}
+
+/*
+getScreenCharacterEntry allows you to obtain a copy of the text cell
+currently displayed at the specified terminal location, and whether the
+location is inside the terminal. Since the screen layer is written to
+whenever the display is updated, it is only read while holding the display
+mutex.
+*/
+func getScreenCharacterEntry(xLocation int, yLocation int) (memory.CharacterEntryType, bool) {
+	commonResource.displayMutex.Lock()
+	defer commonResource.displayMutex.Unlock()
+	layerEntry := &commonResource.screenLayer
+	yLocationOnLayer := yLocation - layerEntry.ScreenYLocation
+	xLocationOnLayer := xLocation - layerEntry.ScreenXLocation
+	if yLocationOnLayer < 0 || xLocationOnLayer < 0 ||
+		yLocationOnLayer >= len(layerEntry.CharacterMemory) || xLocationOnLayer >= len(layerEntry.CharacterMemory[0]) {
+		return memory.NewCharacterEntry(), false
+	}
+	return layerEntry.CharacterMemory[yLocationOnLayer][xLocationOnLayer], true
+}
+
+/*
+GetLayerAliasUnderMouseLocation allows you to obtain the alias of the
+top-most visible text layer directly under your mouse cursor. If the mouse
+is not over any text layer, then an empty string is returned instead. In
+addition, the following information should be noted:
+
+- If the mouse is over a child layer, the alias of the child layer is
+returned rather than its parent.
+*/
+func GetLayerAliasUnderMouseLocation() string {
+	mouseXLocation, mouseYLocation, _, _ := memory.MouseMemory.GetMouseStatus()
+	return getLayerAliasAtLocation(mouseXLocation, mouseYLocation)
+}
+
+/*
+getLayerAliasAtLocation allows you to obtain the alias of the top-most
+visible text layer at the specified terminal location. If no text layer
+is at that location, then an empty string is returned instead.
+*/
+func getLayerAliasAtLocation(xLocation int, yLocation int) string {
+	if xLocation < 0 || xLocation >= commonResource.terminalWidth || yLocation < 0 || yLocation >= commonResource.terminalHeight {
+		return ""
+	}
+	return getChildLayerAliasAtLocation("", xLocation, yLocation, memory.GetSortedLayerMemoryAliasSlice())
+}
+
+/*
+getChildLayerAliasAtLocation allows you to obtain the alias of the top-most
+visible text layer at the specified location, searching only layers which
+belong to the given parent. The location provided is relative to the parent
+layer. Child layers are searched recursively, so that the deepest layer at
+the location is returned.
+*/
+func getChildLayerAliasAtLocation(parentAlias string, xLocation int, yLocation int, sortedLayerAliasSlice memory.LayerAliasZOrderPairList) string {
+	for currentListIndex := len(sortedLayerAliasSlice) - 1; currentListIndex >= 0; currentListIndex-- {
+		currentLayerEntry := memory.GetLayer(sortedLayerAliasSlice[currentListIndex].Key)
+		if !currentLayerEntry.IsVisible || currentLayerEntry.ParentAlias != parentAlias || currentLayerEntry.LayerAlias == parentAlias {
+			continue
+		}
+		layerXLocation := xLocation - currentLayerEntry.ScreenXLocation
+		layerYLocation := yLocation - currentLayerEntry.ScreenYLocation
+		if layerXLocation < 0 || layerXLocation >= currentLayerEntry.Width || layerYLocation < 0 || layerYLocation >= currentLayerEntry.Height {
+			continue
+		}
+		if currentLayerEntry.IsParent {
+			childLayerAlias := getChildLayerAliasAtLocation(currentLayerEntry.LayerAlias, layerXLocation, layerYLocation, sortedLayerAliasSlice)
+			if childLayerAlias != "" {
+				return childLayerAlias
+			}
+		}
+		return currentLayerEntry.LayerAlias
+	}
+	return ""
 }
 
 /*
@@ -779,21 +1148,169 @@ your current changes. In addition, the following information should be noted:
 - Layers with the same z order priority will appear in random display order.
 This is to ensure that programmers do not attempt to rely on any specific
 behavior that might be a coincidental side effect.
+
+- Only areas of the display which have changed since the last update are
+redrawn. Printing, clearing, moving, deleting, or changing the alpha value
+of a text layer automatically flags the affected area as changed. Likewise,
+only cells which actually differ from what is currently displayed are sent
+to the terminal.
 */
 func UpdateDisplay() {
+	applyPendingResize()
+	updateDisplay()
+}
+
+/*
+updateDisplay allows you to redraw all areas of the terminal display which
+have changed since the last update. Unlike 'UpdateDisplay', this method does
+not process pending terminal resizes. This makes it safe to call from the
+background event monitor.
+*/
+func updateDisplay() {
+	commonResource.displayMutex.Lock()
+	defer commonResource.displayMutex.Unlock()
+	dirtyRegion := getDirtyRegion()
+	if dirtyRegion.IsEmpty() {
+		return
+	}
 	sortedLayerAliasSlice := memory.GetSortedLayerMemoryAliasSlice()
-	baseLayerEntry := memory.NewLayerEntry(commonResource.terminalWidth, commonResource.terminalHeight)
-	baseLayerEntry = renderLayers(&baseLayerEntry, sortedLayerAliasSlice)
-	DrawLayerToScreen(&baseLayerEntry, false)
-	commonResource.screenLayer = baseLayerEntry
+	resetLayerRegion(&commonResource.renderLayer, dirtyRegion)
+	renderLayers(&commonResource.renderLayer, "", sortedLayerAliasSlice, dirtyRegion)
+	drawRegionToScreen(&commonResource.renderLayer, &commonResource.screenLayer, dirtyRegion, commonResource.isScreenRefreshRequired)
+	commonResource.isScreenRefreshRequired = false
+}
+
+/*
+markLayerAsDirty allows you to flag the area of the terminal display which
+a text layer currently occupies as modified. This is useful for when a text
+layer is about to be moved, hidden, or deleted, since the area it used to
+cover must be redrawn even though the layer itself may no longer be there.
+*/
+func markLayerAsDirty(layerEntry *memory.LayerEntryType) {
+	screenRegion := getLayerScreenRegion(layerEntry, memory.NewRegionEntry(0, 0, layerEntry.Width, layerEntry.Height))
+	commonResource.dirtyRegion = commonResource.dirtyRegion.GetUnion(screenRegion)
+}
+
+/*
+getLayerScreenRegion allows you to convert a region on a text layer into the
+region it occupies on the terminal display. In addition, the following
+information should be noted:
+
+- If the text layer is a child, the region is clipped by each of its parents
+in turn, since child layers can never be drawn outside of them.
+
+- If the text layer references a parent which does not exist, it can never
+be rendered and so an empty region is returned.
+*/
+func getLayerScreenRegion(layerEntry *memory.LayerEntryType, regionEntry memory.RegionEntryType) memory.RegionEntryType {
+	currentLayerEntry := layerEntry
+	screenRegion := regionEntry.GetIntersection(memory.NewRegionEntry(0, 0, layerEntry.Width, layerEntry.Height))
+	for {
+		screenRegion = screenRegion.GetOffset(currentLayerEntry.ScreenXLocation, currentLayerEntry.ScreenYLocation)
+		if currentLayerEntry.ParentAlias == "" {
+			break
+		}
+		if !memory.IsLayerExists(currentLayerEntry.ParentAlias) {
+			return memory.NewRegionEntry(0, 0, 0, 0)
+		}
+		currentLayerEntry = memory.GetLayer(currentLayerEntry.ParentAlias)
+		screenRegion = screenRegion.GetIntersection(memory.NewRegionEntry(0, 0, currentLayerEntry.Width, currentLayerEntry.Height))
+	}
+	return screenRegion.GetIntersection(memory.NewRegionEntry(0, 0, commonResource.terminalWidth, commonResource.terminalHeight))
+}
+
+/*
+getLayerScreenLocation allows you to obtain the location of a text layer on
+the terminal display, by adding the locations of all its parents. Unlike
+'getLayerScreenRegion', the location returned is not clipped to the
+visible area.
+*/
+func getLayerScreenLocation(layerEntry *memory.LayerEntryType) (int, int) {
+	xLocation := layerEntry.ScreenXLocation
+	yLocation := layerEntry.ScreenYLocation
+	for layerEntry.ParentAlias != "" && memory.IsLayerExists(layerEntry.ParentAlias) {
+		layerEntry = memory.GetLayer(layerEntry.ParentAlias)
+		xLocation += layerEntry.ScreenXLocation
+		yLocation += layerEntry.ScreenYLocation
+	}
+	return xLocation, yLocation
+}
+
+/*
+isLayerVisible allows you to determine if a text layer can be seen. A text
+layer can only be seen if it exists, is visible, and all of its parents are
+visible as well.
+*/
+func isLayerVisible(layerAlias string) bool {
+	for layerAlias != "" {
+		if !memory.IsLayerExists(layerAlias) || !memory.GetLayer(layerAlias).IsVisible {
+			return false
+		}
+		layerAlias = memory.GetLayer(layerAlias).ParentAlias
+	}
+	return true
+}
+
+/*
+getDirtyRegion allows you to obtain the area of the terminal display which
+needs to be redrawn. This includes any area previously vacated by text
+layers, as well as any area modified on the text layers themselves. Once
+obtained, all dirty regions are reset.
+*/
+func getDirtyRegion() memory.RegionEntryType {
+	dirtyRegion := commonResource.dirtyRegion
+	for _, currentLayerEntry := range memory.ScreenMemory {
+		if !currentLayerEntry.DirtyRegion.IsEmpty() {
+			dirtyRegion = dirtyRegion.GetUnion(getLayerScreenRegion(currentLayerEntry, currentLayerEntry.DirtyRegion))
+			currentLayerEntry.DirtyRegion = memory.NewRegionEntry(0, 0, 0, 0)
+		}
+	}
+	commonResource.dirtyRegion = memory.NewRegionEntry(0, 0, 0, 0)
+	return dirtyRegion
+}
+
+/*
+resetLayerRegion allows you to empty a region of a text layer, so that it
+can be rendered again from scratch.
+*/
+func resetLayerRegion(layerEntry *memory.LayerEntryType, regionEntry memory.RegionEntryType) {
+	regionEntry = regionEntry.GetIntersection(memory.NewRegionEntry(0, 0, layerEntry.Width, layerEntry.Height))
+	for currentRow := regionEntry.YLocation; currentRow < regionEntry.YLocation+regionEntry.Height; currentRow++ {
+		for currentColumn := regionEntry.XLocation; currentColumn < regionEntry.XLocation+regionEntry.Width; currentColumn++ {
+			layerEntry.CharacterMemory[currentRow][currentColumn] = memory.NewCharacterEntry()
+		}
+	}
+}
+
+/*
+GetDisplayAsBase64 allows you to obtain the contents of the last rendered
+display as a base64 encoded ANSI string. This is useful for automated
+testing, since you can compare what was drawn against a known good result.
+For example:
+
+	// Render all text layers and obtain what was drawn.
+	dosktop.UpdateDisplay()
+	renderedDisplay := dosktop.GetDisplayAsBase64()
+
+In addition, the following information should be noted:
+
+- Only content which has been rendered by calling 'UpdateDisplay' will be
+returned. Changes made to text layers afterwards will not be reflected until
+the display is updated again.
+*/
+func GetDisplayAsBase64() string {
+	commonResource.displayMutex.Lock()
+	defer commonResource.displayMutex.Unlock()
+	return commonResource.screenLayer.GetBasicAnsiStringAsBase64()
 }
 
 /*
 renderLayers allows you to render a list of text layers to the specified root
 text layer. In addition, the following information should be noted:
 
-- The root layer entry is considered the parent entry. Only text layers under
-the specified parent will be rendered on it.
+- Only text layers whose parent alias matches the one provided will be
+rendered on the root text layer. Top level text layers have a parent alias
+of "".
 
 - If a text layer being rendered is a parent, then all child text layers will
 be rendered on the parent before the parent is drawn. This is done by making
@@ -804,28 +1321,56 @@ a lower z order priority are rendered first.
 
 - Any text layer which is marked as not visible will be ignored.
 
-- All rendering occurs on a temporary text layer until it is ready to be
-overlaid on the final (terminal ready) text layer. Buttons and other special
-TUI controls are also dynamically rendered at this time so that the original
-text layer data underneath them is preserved.
+- Only the area of the root text layer covered by the specified clip region
+will be modified. Text layers which fall completely outside of it are
+skipped entirely.
+
+- Parent layers, managed windows, and layers with buttons, text fields,
+menus, progress bars, or other special TUI controls are rendered on a temporary copy of
+the text layer. Window decorations and controls are dynamically rendered at
+this time so that the original text layer data underneath them is
+preserved. All other text layers are overlaid directly, since they do not
+need to be modified.
+
+- The scroll bars of a viewport are drawn after its children have been
+rendered, since they must appear on top of the text layer being scrolled.
 */
-func renderLayers(rootLayerEntry *memory.LayerEntryType, sortedLayerAliasSlice memory.LayerAliasZOrderPairList) memory.LayerEntryType {
-	baseLayerEntry := memory.NewLayerEntry(0,0, rootLayerEntry)
+func renderLayers(rootLayerEntry *memory.LayerEntryType, parentAlias string, sortedLayerAliasSlice memory.LayerAliasZOrderPairList, clipRegion memory.RegionEntryType) {
 	for currentListIndex := 0; currentListIndex < len(sortedLayerAliasSlice); currentListIndex++ {
-		currentLayerEntry := memory.NewLayerEntry(0, 0, memory.GetLayer(sortedLayerAliasSlice[currentListIndex].Key))
-		if currentLayerEntry.IsVisible {
-			drawButtonsOnLayer(currentLayerEntry)
-			if currentLayerEntry.IsParent && (currentLayerEntry.LayerAlias != baseLayerEntry.LayerAlias && currentLayerEntry.ParentAlias == baseLayerEntry.LayerAlias){
-				renderedLayer := renderLayers(&currentLayerEntry, sortedLayerAliasSlice)
-				overlayLayers(&renderedLayer, &baseLayerEntry)
-			} else {
-				if currentLayerEntry.ParentAlias == baseLayerEntry.LayerAlias {
-					overlayLayers(&currentLayerEntry, &baseLayerEntry)
-				}
+		currentLayerEntry := memory.GetLayer(sortedLayerAliasSlice[currentListIndex].Key)
+		if !currentLayerEntry.IsVisible || currentLayerEntry.ParentAlias != parentAlias || currentLayerEntry.LayerAlias == parentAlias {
+			continue
+		}
+		layerRegion := memory.NewRegionEntry(currentLayerEntry.ScreenXLocation, currentLayerEntry.ScreenYLocation, currentLayerEntry.Width, currentLayerEntry.Height)
+		layerRegion = layerRegion.GetIntersection(clipRegion)
+		if layerRegion.IsEmpty() {
+			continue
+		}
+		if currentLayerEntry.IsParent || len(memory.ButtonMemory[currentLayerEntry.LayerAlias]) > 0 || len(memory.ControlMemory[currentLayerEntry.LayerAlias]) > 0 || len(memory.MenuBarMemory[currentLayerEntry.LayerAlias]) > 0 || len(memory.ProgressBarMemory[currentLayerEntry.LayerAlias]) > 0 || memory.IsWindowExists(currentLayerEntry.LayerAlias) {
+			renderedLayerEntry := memory.NewLayerEntry(0, 0, currentLayerEntry)
+			drawWindowOnLayer(&renderedLayerEntry)
+			drawButtonsOnLayer(renderedLayerEntry)
+			drawCheckBoxesOnLayer(renderedLayerEntry)
+			drawRadioButtonGroupsOnLayer(renderedLayerEntry)
+			drawTextFieldsOnLayer(renderedLayerEntry)
+			drawMenusOnLayer(renderedLayerEntry)
+			drawTextAreasOnLayer(renderedLayerEntry)
+			drawListBoxesOnLayer(renderedLayerEntry)
+			drawDataGridsOnLayer(renderedLayerEntry)
+			drawTreeViewsOnLayer(renderedLayerEntry)
+			drawTabContainersOnLayer(renderedLayerEntry)
+			drawProgressBarsOnLayer(renderedLayerEntry)
+			drawMenuBarsOnLayer(renderedLayerEntry)
+			if currentLayerEntry.IsParent {
+				childClipRegion := layerRegion.GetOffset(-currentLayerEntry.ScreenXLocation, -currentLayerEntry.ScreenYLocation)
+				renderLayers(&renderedLayerEntry, currentLayerEntry.LayerAlias, sortedLayerAliasSlice, childClipRegion)
 			}
+			drawViewportOnLayer(&renderedLayerEntry)
+			overlayLayersInRegion(&renderedLayerEntry, rootLayerEntry, clipRegion)
+		} else {
+			overlayLayersInRegion(currentLayerEntry, rootLayerEntry, clipRegion)
 		}
 	}
-	return baseLayerEntry
 }
 
 /*
@@ -856,63 +1401,31 @@ then it will be drawn as a shadow with the color and intensity matching
 the rune underneath it.
 */
 func overlayLayers(sourceLayerEntry *memory.LayerEntryType, targetLayerEntry *memory.LayerEntryType) {
+	overlayLayersInRegion(sourceLayerEntry, targetLayerEntry, memory.NewRegionEntry(0, 0, targetLayerEntry.Width, targetLayerEntry.Height))
+	targetLayerEntry.MarkDirtyRegion(sourceLayerEntry.ScreenXLocation, sourceLayerEntry.ScreenYLocation, sourceLayerEntry.Width, sourceLayerEntry.Height)
+}
+
+/*
+overlayLayersInRegion allows you to overlay one text layer on top of another
+text layer, while only modifying the area of the target text layer covered
+by the specified clip region. The same rules as 'overlayLayers' apply.
+*/
+func overlayLayersInRegion(sourceLayerEntry *memory.LayerEntryType, targetLayerEntry *memory.LayerEntryType, clipRegion memory.RegionEntryType) {
 	sourceCharacterMemory := sourceLayerEntry.CharacterMemory
 	targetCharacterMemory := targetLayerEntry.CharacterMemory
-	sourceWidthToCopy := sourceLayerEntry.Width
-	sourceHeightToCopy := sourceLayerEntry.Height
-	// Calculate how much of the source Width to copy.
-	sourceWidthToCopy = sourceLayerEntry.Width - int(math.GetAbsoluteValueAsFloat64(sourceLayerEntry.ScreenXLocation))
-	if sourceLayerEntry.ScreenXLocation < 0 {
-		if sourceWidthToCopy > targetLayerEntry.Width {
-			sourceWidthToCopy = targetLayerEntry.Width
-		}
-	} else {
-		if sourceWidthToCopy < targetLayerEntry.Width {
-			sourceWidthToCopy = sourceLayerEntry.Width
-		}
-	}
-	// Calculate how much of the source Height to copy.
-	sourceHeightToCopy = sourceLayerEntry.Height - int(math.GetAbsoluteValueAsFloat64(sourceLayerEntry.ScreenYLocation))
-	if sourceLayerEntry.ScreenYLocation < 0 {
-		if sourceHeightToCopy > targetLayerEntry.Height {
-			sourceHeightToCopy = targetLayerEntry.Height
-		}
-	} else {
-		if sourceHeightToCopy < targetLayerEntry.Height {
-			sourceHeightToCopy = sourceLayerEntry.Height
-		}
-	}
-	// Adjust where rendering on the layer should start.
-	startingSourceXLocation := 0
-	startingSourceYLocation := 0
-	startingTargetXLocation := 0
-	startingTargetYLocation := 0
-	if sourceLayerEntry.ScreenXLocation < 0 {
-		startingSourceXLocation = int(math.GetAbsoluteValueAsFloat64(sourceLayerEntry.ScreenXLocation))
-	} else {
-		startingTargetXLocation = int(math.GetAbsoluteValueAsFloat64(sourceLayerEntry.ScreenXLocation))
-	}
-	if sourceLayerEntry.ScreenYLocation < 0 {
-		startingSourceYLocation = int(math.GetAbsoluteValueAsFloat64(sourceLayerEntry.ScreenYLocation))
-	} else {
-		startingTargetYLocation = int(math.GetAbsoluteValueAsFloat64(sourceLayerEntry.ScreenYLocation))
-	}
-	if sourceWidthToCopy+startingTargetXLocation > targetLayerEntry.Width {
-		sourceWidthToCopy = targetLayerEntry.Width - startingTargetXLocation
-	}
-	if sourceHeightToCopy+startingTargetYLocation > targetLayerEntry.Height {
-		sourceHeightToCopy = targetLayerEntry.Height - startingTargetYLocation
-	}
+	// Calculate the area of the target layer that the source layer covers.
+	targetRegion := memory.NewRegionEntry(sourceLayerEntry.ScreenXLocation, sourceLayerEntry.ScreenYLocation, sourceLayerEntry.Width, sourceLayerEntry.Height)
+	targetRegion = targetRegion.GetIntersection(memory.NewRegionEntry(0, 0, targetLayerEntry.Width, targetLayerEntry.Height))
+	targetRegion = targetRegion.GetIntersection(clipRegion)
 	// If the layer is totally off screen, don't bother to render it.
-	if startingSourceXLocation+sourceWidthToCopy < 0 || sourceLayerEntry.ScreenXLocation+sourceWidthToCopy > targetLayerEntry.Width ||
-		startingSourceYLocation+sourceHeightToCopy < 0 || sourceLayerEntry.ScreenYLocation+sourceHeightToCopy > targetLayerEntry.Height {
+	if targetRegion.IsEmpty() {
 		return
 	}
-	// Perform the actual copy using the starting offsets previously calculated.
-	for currentRow := 0; currentRow < sourceHeightToCopy; currentRow++ {
-		for currentColumn := 0; currentColumn < sourceWidthToCopy; currentColumn++ {
-			sourceCharacterEntry := &sourceCharacterMemory[currentRow+startingSourceYLocation][currentColumn+startingSourceXLocation]
-			targetCharacterEntry := &targetCharacterMemory[currentRow+startingTargetYLocation][currentColumn+startingTargetXLocation]
+	// Perform the actual copy using the region previously calculated.
+	for currentRow := targetRegion.YLocation; currentRow < targetRegion.YLocation+targetRegion.Height; currentRow++ {
+		for currentColumn := targetRegion.XLocation; currentColumn < targetRegion.XLocation+targetRegion.Width; currentColumn++ {
+			sourceCharacterEntry := &sourceCharacterMemory[currentRow-sourceLayerEntry.ScreenYLocation][currentColumn-sourceLayerEntry.ScreenXLocation]
+			targetCharacterEntry := &targetCharacterMemory[currentRow][currentColumn]
 			sourceAttributeEntry := sourceCharacterEntry.AttributeEntry
 			targetAttributeEntry := targetCharacterEntry.AttributeEntry
 			// Handle transformations
@@ -924,10 +1437,10 @@ func overlayLayers(sourceLayerEntry *memory.LayerEntryType, targetLayerEntry *me
 					targetAttributeEntry.BackgroundColor = GetTransitionedColor(targetAttributeEntry.BackgroundColor, GetRGBColor(0, 0, 0), sourceAttributeEntry.BackgroundTransformValue)
 				}
 				targetCharacterEntry.AttributeEntry = targetAttributeEntry
-				targetCharacterMemory[currentRow+startingTargetYLocation][currentColumn+startingTargetXLocation] = *targetCharacterEntry
 			} else {
 				targetCharacterEntry.AttributeEntry = memory.NewAttributeEntry(&sourceAttributeEntry)
 				targetCharacterEntry.Character = sourceCharacterEntry.Character
+				targetCharacterEntry.CombiningCharacters = sourceCharacterEntry.CombiningCharacters
 				targetCharacterEntry.LayerAlias = sourceCharacterEntry.LayerAlias
 				// If there is no local color transforming being done on cells
 				if sourceAttributeEntry.ForegroundTransformValue != 1 || sourceAttributeEntry.BackgroundTransformValue != 1 {
@@ -950,6 +1463,56 @@ func overlayLayers(sourceLayerEntry *memory.LayerEntryType, targetLayerEntry *me
 	}
 }
 
+/*
+drawRegionToScreen allows you to render a region of a text layer to the
+visible terminal screen. In addition, the following information should be
+noted:
+
+- Only cells which differ from what is currently displayed are sent to the
+terminal. The displayed text layer is updated to match as cells are drawn.
+
+- If a forced refresh is required, every cell in the region is sent to the
+terminal regardless of what is currently displayed.
+
+- If debug is enabled, the displayed text layer is still updated but nothing
+is sent to the terminal since it is virtual.
+*/
+func drawRegionToScreen(sourceLayerEntry *memory.LayerEntryType, displayedLayerEntry *memory.LayerEntryType, regionEntry memory.RegionEntryType, isForcedRefreshRequired bool) {
+	regionEntry = regionEntry.GetIntersection(memory.NewRegionEntry(0, 0, displayedLayerEntry.Width, displayedLayerEntry.Height))
+	isScreenUpdated := false
+	for currentRow := regionEntry.YLocation; currentRow < regionEntry.YLocation+regionEntry.Height; currentRow++ {
+		for currentColumn := regionEntry.XLocation; currentColumn < regionEntry.XLocation+regionEntry.Width; currentColumn++ {
+			characterEntry := sourceLayerEntry.CharacterMemory[currentRow][currentColumn]
+			if !isForcedRefreshRequired && characterEntry == displayedLayerEntry.CharacterMemory[currentRow][currentColumn] {
+				continue
+			}
+			displayedLayerEntry.CharacterMemory[currentRow][currentColumn] = characterEntry
+			if !commonResource.isDebugEnabled && commonResource.screen != nil {
+				commonResource.screen.SetContent(currentColumn, currentRow, characterEntry.Character, []rune(characterEntry.CombiningCharacters), getCellStyle(characterEntry.AttributeEntry))
+				isScreenUpdated = true
+			}
+		}
+	}
+	if isScreenUpdated {
+		commonResource.screen.Show()
+	}
+}
+
+/*
+getCellStyle allows you to obtain the terminal style needed to display a
+cell with the specified attributes.
+*/
+func getCellStyle(attributeEntry memory.AttributeEntryType) tcell.Style {
+	style := tcell.StyleDefault
+	style = style.Foreground(tcell.Color(attributeEntry.ForegroundColor))
+	style = style.Background(tcell.Color(attributeEntry.BackgroundColor))
+	style = style.Blink(attributeEntry.IsBlinking)
+	style = style.Bold(attributeEntry.IsBold)
+	style = style.Reverse(attributeEntry.IsReversed)
+	style = style.Underline(attributeEntry.IsUnderlined)
+	return style
+}
+
 /*
 DrawLayerToScreen allows you to render a text layer to the visible terminal
 screen. If debug is enabled, this method does nothing since the terminal
@@ -961,16 +1524,9 @@ func DrawLayerToScreen(layerEntry *memory.LayerEntryType, isForcedRefreshRequire
 		height := layerEntry.Height
 		for currentRow := 0; currentRow < height; currentRow++ {
 			for currentCharacter := 0; currentCharacter < width; currentCharacter++ {
-				style := tcell.StyleDefault
-				attributeEntry := layerEntry.CharacterMemory[currentRow][currentCharacter].AttributeEntry
-				style = style.Foreground(tcell.Color(attributeEntry.ForegroundColor))
-				style = style.Background(tcell.Color(attributeEntry.BackgroundColor))
-				style = style.Blink(attributeEntry.IsBlinking)
-				style = style.Bold(attributeEntry.IsBold)
-				style = style.Reverse(attributeEntry.IsReversed)
-				style = style.Underline(attributeEntry.IsUnderlined)
+				style := getCellStyle(layerEntry.CharacterMemory[currentRow][currentCharacter].AttributeEntry)
 				var character = layerEntry.CharacterMemory[currentRow][currentCharacter].Character
-				r2 := []rune("")
+				r2 := []rune(layerEntry.CharacterMemory[currentRow][currentCharacter].CombiningCharacters)
 				commonResource.screen.SetContent(currentCharacter, currentRow, character, r2, style)
 			}
 		}
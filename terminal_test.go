@@ -10,6 +10,8 @@ import (
 	_ "math/rand"
 	_ "strconv"
 	"testing"
+	"time"
+	"github.com/gdamore/tcell"
 )
 
 func TestTerminalAddLayer(test *testing.T) {
@@ -293,6 +295,128 @@ func TestTerminalUpdateDisplay(test *testing.T) {
 	assert.Equalf(test, expectedValue, obtainedValue ,"The updated screen does not match the master original!")
 }
 
+func TestTerminalInitializeWithSettings(test *testing.T) {
+	commonResource.isDebugEnabled = true
+	terminalSettings := NewTerminalSettingsEntry()
+	terminalSettings.Width = 30
+	terminalSettings.Height = 10
+	terminalSettings.DebugDirectory = "/var/tmp/"
+	err := InitializeTerminalWithSettings(terminalSettings)
+	assert.NoErrorf(test, err, "The terminal could not be initialized with the settings provided!")
+	obtainedValue := []interface{}{commonResource.terminalWidth, commonResource.terminalHeight, commonResource.debugDirectory}
+	expectedValue := []interface{}{30, 10, "/var/tmp/"}
+	assert.Equalf(test, expectedValue, obtainedValue, "The terminal settings were not applied correctly!")
+	assert.Panicsf(test, func() { InitializeTerminal(-1, 0) }, "Initializing a terminal with invalid dimensions did not panic!")
+}
+
+func TestTerminalHeadlessDisplay(test *testing.T) {
+	layerAlias1 := "Layer1"
+	simulationScreen, err := InitializeHeadlessTerminal(20, 5)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer(layerAlias1, 0, 0, 20, 5, 1, "")
+	Layer(layerAlias1)
+	Locate(2, 1)
+	Print("Hello")
+	UpdateDisplay()
+	cells, width, height := simulationScreen.GetContents()
+	assert.Equalf(test, 20, width, "The headless screen width does not match the terminal width!")
+	assert.Equalf(test, 5, height, "The headless screen height does not match the terminal height!")
+	obtainedValue := ""
+	for currentXLocation := 2; currentXLocation < 7; currentXLocation++ {
+		obtainedValue += string(cells[width + currentXLocation].Runes)
+	}
+	assert.Equalf(test, "Hello", obtainedValue, "The headless screen does not contain what was rendered!")
+	assert.Equalf(test, commonResource.screenLayer.GetBasicAnsiStringAsBase64(), GetDisplayAsBase64(), "The display dump does not match the rendered screen layer!")
+}
+
+func TestTerminalPrintGraphemeClusters(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 2)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Layer1", 0, 0, 20, 2, 1, "")
+	Layer("Layer1")
+	Print("e\u0301a字b")
+	DrawFrameLabel("Layer1", NewTuiStyleEntry(), "u\u0308字", 0, 1)
+	UpdateDisplay()
+	cells, width, _ := simulationScreen.GetContents()
+	getCellText := func(xLocation int, yLocation int) string {
+		return string(cells[yLocation*width+xLocation].Runes)
+	}
+	assert.Equalf(test, []string{"e\u0301", "a", "字", "b"}, []string{getCellText(0, 0), getCellText(1, 0), getCellText(2, 0), getCellText(4, 0)}, "Combining marks did not share the cell of the character they modify, or wide characters did not occupy two cells!")
+	assert.Equalf(test, []string{"u\u0308", "字", " ", "]"}, []string{getCellText(2, 1), getCellText(3, 1), getCellText(5, 1), getCellText(6, 1)}, "A frame label containing combining marks and wide characters was not enclosed correctly!")
+}
+
+func TestTerminalHeadlessInput(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 5)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	simulationScreen.InjectKey(tcell.KeyRune, 'a', tcell.ModNone)
+	simulationScreen.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	obtainedValue := waitForKeystroke() + waitForKeystroke()
+	assert.Equalf(test, "aenter", obtainedValue, "The injected keystrokes were not received!")
+	simulationScreen.InjectMouse(3, 4, tcell.Button1, tcell.ModNone)
+	timeout := time.Now().Add(time.Second)
+	mouseXLocation, mouseYLocation, buttonPressed, _ := memory.MouseMemory.GetMouseStatus()
+	for buttonPressed == 0 && time.Now().Before(timeout) {
+		time.Sleep(time.Millisecond)
+		mouseXLocation, mouseYLocation, buttonPressed, _ = memory.MouseMemory.GetMouseStatus()
+	}
+	assert.Equalf(test, []int{3, 4, 1}, []int{mouseXLocation, mouseYLocation, int(buttonPressed)}, "The injected mouse event was not received!")
+}
+
+/*
+waitForKeystroke allows you to wait for a keystroke to arrive from the
+background event monitor, giving up after one second.
+*/
+func waitForKeystroke() string {
+	timeout := time.Now().Add(time.Second)
+	for time.Now().Before(timeout) {
+		keystroke := Inkey()
+		if keystroke != "" {
+			return keystroke
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return ""
+}
+
+func TestTerminalDirtyRegionRendering(test *testing.T) {
+	commonResource.isDebugEnabled = true
+	layerAlias1 := "Layer1"
+	layerAlias2 := "Layer2"
+	layerAlias3 := "Layer3"
+	InitializeTerminal(30, 10)
+	AddLayer(layerAlias1, 0, 0, 30, 10, 1, "")
+	AddLayer(layerAlias2, 2, 2, 10, 5, 2, "")
+	AddLayer(layerAlias3, 1, 1, 5, 3, 3, layerAlias2)
+	FillLayer(layerAlias1, "a1")
+	FillLayer(layerAlias2, "b2")
+	FillLayer(layerAlias3, "c3")
+	SetAlpha(layerAlias2, 0.5)
+	UpdateDisplay()
+	assert.Truef(test, getDirtyRegion().IsEmpty(), "The dirty region was not reset after the display was updated!")
+	MoveLayerByRelativeValue(layerAlias2, 5, 3)
+	LocateLayer(layerAlias1, 20, 1)
+	PrintLayer(layerAlias1, "Hello")
+	LocateLayer(layerAlias3, 0, 0)
+	PrintLayer(layerAlias3, "XY")
+	UpdateDisplay()
+	obtainedValue := GetDisplayAsBase64()
+	commonResource.dirtyRegion = memory.NewRegionEntry(0, 0, commonResource.terminalWidth, commonResource.terminalHeight)
+	UpdateDisplay()
+	expectedValue := GetDisplayAsBase64()
+	assert.Equalf(test, expectedValue, obtainedValue, "The partially updated screen does not match a full redraw!")
+	Layer(layerAlias1)
+	DeleteLayer(layerAlias2)
+	UpdateDisplay()
+	obtainedValue = GetDisplayAsBase64()
+	commonResource.dirtyRegion = memory.NewRegionEntry(0, 0, commonResource.terminalWidth, commonResource.terminalHeight)
+	UpdateDisplay()
+	expectedValue = GetDisplayAsBase64()
+	assert.Equalf(test, expectedValue, obtainedValue, "The screen does not match a full redraw after deleting a layer!")
+}
+
 func TestTerminalRenderParentLayer(test *testing.T) {
 	commonResource.isDebugEnabled = true
 	// First set of nested text layers.
@@ -417,4 +541,56 @@ func TestNewAssetList(test *testing.T) {
 	obtainedValue = recast.GetArrayOfInterfaces(len(imageFileList.PreloadedImageList))
 	expectedValue = recast.GetArrayOfInterfaces(0)
 	assert.Equalf(test, expectedValue, obtainedValue, "The number of file entries does not what was expected!")
-}
\ No newline at end of file
+}
+
+/*
+setupBenchmarkDisplay allows you to create a large headless display with a
+dozen overlapping text layers for benchmarking with.
+*/
+func setupBenchmarkDisplay(benchmark *testing.B) {
+	_, err := InitializeHeadlessTerminal(200, 60)
+	if err != nil {
+		benchmark.Fatal(err)
+	}
+	for currentLayer := 0; currentLayer < 12; currentLayer++ {
+		layerAlias := fmt.Sprintf("Layer%d", currentLayer)
+		AddLayer(layerAlias, currentLayer*5, currentLayer*2, 120, 30, currentLayer, "")
+		FillLayer(layerAlias, fmt.Sprintf("%d", currentLayer))
+	}
+	SetAlpha("Layer6", 0.5)
+	UpdateDisplay()
+}
+
+func BenchmarkUpdateDisplayFullRedraw(benchmark *testing.B) {
+	setupBenchmarkDisplay(benchmark)
+	defer RestoreTerminalSettings()
+	benchmark.ResetTimer()
+	for currentIteration := 0; currentIteration < benchmark.N; currentIteration++ {
+		commonResource.dirtyRegion = memory.NewRegionEntry(0, 0, commonResource.terminalWidth, commonResource.terminalHeight)
+		commonResource.isScreenRefreshRequired = true
+		LocateLayer("Layer11", 0, 0)
+		PrintLayer("Layer11", fmt.Sprintf("%08d", currentIteration))
+		UpdateDisplay()
+	}
+}
+
+func BenchmarkUpdateDisplayPartialRedraw(benchmark *testing.B) {
+	setupBenchmarkDisplay(benchmark)
+	defer RestoreTerminalSettings()
+	benchmark.ResetTimer()
+	for currentIteration := 0; currentIteration < benchmark.N; currentIteration++ {
+		LocateLayer("Layer11", 0, 0)
+		PrintLayer("Layer11", fmt.Sprintf("%08d", currentIteration))
+		UpdateDisplay()
+	}
+}
+
+func BenchmarkUpdateDisplayMovingLayer(benchmark *testing.B) {
+	setupBenchmarkDisplay(benchmark)
+	defer RestoreTerminalSettings()
+	benchmark.ResetTimer()
+	for currentIteration := 0; currentIteration < benchmark.N; currentIteration++ {
+		MoveLayerByAbsoluteValue("Layer11", currentIteration%80, 20)
+		UpdateDisplay()
+	}
+}
@@ -1,7 +1,9 @@
 package dosktop
 
 import (
+	"github.com/supercom32/dosktop/constants"
 	"github.com/supercom32/dosktop/internal/memory"
+	"sort"
 	"time"
 )
 
@@ -30,6 +32,35 @@ func SleepInMilliseconds(timeInMilliseconds uint) {
 	time.Sleep(timeDuration * time.Millisecond)
 }
 
+/*
+AddTimer allows you to create a new timer to measure time with. If the timer
+is not enabled by default, you must call 'StartTimer' when you wish for it
+to begin. For example:
+
+	// Create a timer with the timer alias "MyTimer", with a duration of
+	// 1000 milliseconds, with it's enabled status as being "true".
+	dosktop.AddTimer("MyTimer", 1000, true)
+
+In addition, the following information should be noted:
+
+- If a timer with the same alias already exists, it will be replaced.
+
+- When a timer expires, a timer event is generated for event loops using
+'PollEvent' or 'WaitForEvent'.
+*/
+func AddTimer(timerAlias string, durationInMilliseconds int64, isEnabled bool) {
+	memory.AddTimer(timerAlias, durationInMilliseconds, isEnabled)
+	updateEventChannelTimer()
+}
+
+/*
+DeleteTimer allows you to remove a timer. If you attempt to delete a timer
+which does not exist, then the request will simply be ignored.
+*/
+func DeleteTimer(timerAlias string) {
+	memory.DeleteTimer(timerAlias)
+}
+
 /*
 IsTimerExpired allows you to check if a created timer has expired or not.
 If the specified timer has expired, then it will automatically be disabled.
@@ -57,6 +88,7 @@ func SetTimer(timerAlias string, durationInMilliseconds int64, isEnabled bool) {
 	timerEntry.StartTime = GetCurrentTimeInMilliseconds()
 	timerEntry.TimerLength = durationInMilliseconds
 	timerEntry.IsTimerEnabled = isEnabled
+	updateEventChannelTimer()
 }
 
 /*
@@ -70,6 +102,7 @@ func StartTimer(timerAlias string) {
 	timerEntry := memory.TimerMemory[timerAlias]
 	timerEntry.StartTime = GetCurrentTimeInMilliseconds()
 	timerEntry.IsTimerEnabled = true
+	updateEventChannelTimer()
 }
 
 /*
@@ -78,4 +111,54 @@ time in milliseconds.
 */
 func GetCurrentTimeInMilliseconds() int64 {
 	return time.Now().UnixNano() / int64(time.Millisecond)
-}
\ No newline at end of file
+}
+
+/*
+getExpiredTimerEvent allows you to obtain a timer event for the first
+enabled timer found to have expired. Once reported, the timer is disabled,
+unless it animates the marquee of a progress bar, in which case the marquee
+is advanced and the timer is restarted. If no timer has expired, then
+'false' is returned instead. Timers are checked in alphabetical order of
+their timer alias.
+*/
+func getExpiredTimerEvent() (memory.EventEntryType, bool) {
+	timerAliasSlice := make([]string, 0, len(memory.TimerMemory))
+	for currentKey := range memory.TimerMemory {
+		timerAliasSlice = append(timerAliasSlice, currentKey)
+	}
+	sort.Strings(timerAliasSlice)
+	for _, currentTimerAlias := range timerAliasSlice {
+		if IsTimerExpired(currentTimerAlias) {
+			updateProgressBarMarquee(currentTimerAlias)
+			eventEntry := newEventEntry(constants.EventTypeTimer)
+			eventEntry.TimerEvent.TimerAlias = currentTimerAlias
+			return eventEntry, true
+		}
+	}
+	return memory.NewEventEntry(), false
+}
+
+/*
+getTimeUntilNextTimerExpires allows you to obtain the number of milliseconds
+remaining until the next enabled timer expires. If no timer is enabled,
+then 'false' is returned instead.
+*/
+func getTimeUntilNextTimerExpires() (int64, bool) {
+	isTimerEnabled := false
+	var shortestTimeRemaining int64
+	currentTime := GetCurrentTimeInMilliseconds()
+	for _, currentTimerEntry := range memory.TimerMemory {
+		if !currentTimerEntry.IsTimerEnabled {
+			continue
+		}
+		timeRemaining := currentTimerEntry.StartTime + currentTimerEntry.TimerLength - currentTime
+		if timeRemaining < 0 {
+			timeRemaining = 0
+		}
+		if !isTimerEnabled || timeRemaining < shortestTimeRemaining {
+			shortestTimeRemaining = timeRemaining
+			isTimerEnabled = true
+		}
+	}
+	return shortestTimeRemaining, isTimerEnabled
+}
@@ -408,9 +408,16 @@ then the width will automatically default to the width of your button label.
 
 - If the height of your button is less than 3 characters high, then the height
 will automatically default to the minimum of 3 characters.
+
+- Buttons can receive focus. A focused button is drawn as selected, and is
+clicked when the user presses 'enter' or 'space'. When a button is clicked,
+a control event is returned by 'PollEvent' and 'WaitForEvent'. For more
+information, see 'SetFocus'.
 */
 func AddButton(layerAlias string, buttonAlias string, buttonLabel string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, height int) {
 	memory.AddButton(layerAlias, buttonAlias, buttonLabel, styleEntry, xLocation, yLocation, width, height)
+	addControl(layerAlias, buttonAlias, constants.ControlTypeButton)
+	markButtonAsDirty(layerAlias, buttonAlias)
 }
 
 /*
@@ -421,9 +428,43 @@ the following information should be noted:
 will simply be ignored.
 */
 func DeleteButton(layerAlias string, buttonAlias string) {
+	markButtonAsDirty(layerAlias, buttonAlias)
+	deleteControl(layerAlias, buttonAlias)
 	memory.DeleteButton(layerAlias, buttonAlias)
 }
 
+/*
+markButtonAsDirty allows you to flag the area of a text layer covered by a
+button as modified, so that the button is redrawn the next time the display
+is updated. If the button or the text layer it belongs to does not exist,
+then the request will simply be ignored.
+*/
+func markButtonAsDirty(layerAlias string, buttonAlias string) {
+	buttonEntry, isButtonExists := memory.ButtonMemory[layerAlias][buttonAlias]
+	if !isButtonExists || !memory.IsLayerExists(layerAlias) {
+		return
+	}
+	buttonRegion := getButtonRegion(buttonEntry)
+	memory.GetLayer(layerAlias).MarkDirtyRegion(buttonRegion.XLocation, buttonRegion.YLocation, buttonRegion.Width, buttonRegion.Height)
+}
+
+/*
+getButtonRegion allows you to obtain the area of a text layer covered by a
+button. Buttons are always drawn at least three characters high, and wide
+enough to fit their label.
+*/
+func getButtonRegion(buttonEntry *memory.ButtonEntryType) memory.RegionEntryType {
+	width := buttonEntry.Width
+	height := buttonEntry.Height
+	if height < 3 {
+		height = 3
+	}
+	if width < len(buttonEntry.ButtonLabel) {
+		width = len(buttonEntry.ButtonLabel) + 2
+	}
+	return memory.NewRegionEntry(buttonEntry.XLocation, buttonEntry.YLocation, width, height)
+}
+
 /*
 drawButtonsOnLayer allows you to draw all buttons on a given text layer
 entry.
@@ -528,13 +569,17 @@ with a border of a frame.
 - If the frame label to be drawn falls outside the range of the
 specified layer, then only the visible portion of the border will be
 drawn.
+
+- The closing bracket is placed after the number of columns the label
+occupies, so labels containing combining marks or wide characters are
+enclosed correctly.
 */
 func drawFrameLabel(layerEntry *memory.LayerEntryType, styleEntry memory.TuiStyleEntryType, label string, xLocation int, yLocation int) {
 	attributeEntry := memory.NewAttributeEntry()
 	attributeEntry.ForegroundColor= styleEntry.TextForegroundColor
 	attributeEntry.BackgroundColor= styleEntry.TextBackgroundColor
 	printLayer(layerEntry, attributeEntry, xLocation, yLocation, []rune("[ "))
-	printLayer(layerEntry, attributeEntry, xLocation + 2 + len(label), yLocation, []rune(" ]"))
+	printLayer(layerEntry, attributeEntry, xLocation + 2 + getGraphemeClustersWidth(stringformat.GetGraphemeClusters([]rune(label))), yLocation, []rune(" ]"))
 	attributeEntry.ForegroundColor = styleEntry.TextLabelColor
 	attributeEntry.BackgroundColor = styleEntry.TextBackgroundColor
 	printLayer(layerEntry, attributeEntry, xLocation + 2, yLocation, []rune(label))
@@ -703,6 +748,27 @@ func drawShadow(layerEntry *memory.LayerEntryType, attributeEntry memory.Attribu
 	fillArea(layerEntry, localAttributeEntry, "", xLocation, yLocation, width, height)
 }
 
+/*
+drawMenuPanel allows you to draw an empty menu panel on a given text layer
+entry. The panel is drawn with a border using the menu colors of the style
+provided, and fills the text layer except for the last two columns and the
+last row, where its shadow is drawn.
+*/
+func drawMenuPanel(layerEntry *memory.LayerEntryType, styleEntry memory.TuiStyleEntryType) {
+	panelStyleEntry := styleEntry
+	panelStyleEntry.TextForegroundColor = styleEntry.MenuForegroundColor
+	panelStyleEntry.TextBackgroundColor = styleEntry.MenuBackgroundColor
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.MenuForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.MenuBackgroundColor
+	panelWidth := layerEntry.Width - 2
+	panelHeight := layerEntry.Height - 1
+	fillArea(layerEntry, attributeEntry, " ", 0, 0, panelWidth, panelHeight)
+	drawBorder(layerEntry, panelStyleEntry, attributeEntry, 0, 0, panelWidth, panelHeight)
+	drawShadow(layerEntry, memory.NewAttributeEntry(), panelWidth, 1, 2, panelHeight, 0.5)
+	drawShadow(layerEntry, memory.NewAttributeEntry(), 2, panelHeight, panelWidth-2, 1, 0.5)
+}
+
 /*
 FillArea allows you to fill an area of a given text layer with characters of
 your choice. If you wish to fill the area with repeating text, simply provide
@@ -777,6 +843,106 @@ func DrawBar(layerAlias string, xLocation int, yLocation int, barLength int, fil
 	fillArea(layerEntry, attributeEntry, fillCharacters, xLocation, yLocation, barLength, 1)
 }
 
+/*
+DrawVerticalScrollBar allows you to draw a vertical scroll bar on a given text
+layer. This is useful for indicating which portion of a list or document is
+currently visible. In addition, the following information should be noted:
+
+- The number of items is the total length of what is being scrolled, while
+the viewport height is how much of it can be seen at once. The viewport
+position is the first item currently visible.
+
+- The scroll bar track and handle are drawn using the scroll bar patterns of
+your style entry. The size of the handle reflects how much of the content is
+visible.
+*/
+func DrawVerticalScrollBar(layerAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, height int, numberOfItems int, viewportHeight int, viewportPosition int) {
+	layerEntry := memory.GetLayer(layerAlias)
+	drawVerticalScrollBar(layerEntry, styleEntry, xLocation, yLocation, height, numberOfItems, viewportHeight, viewportPosition)
+}
+
+/*
+drawVerticalScrollBar allows you to draw a vertical scroll bar on a given
+text layer entry. If all items fit inside the viewport, the handle fills the
+entire scroll bar.
+*/
+func drawVerticalScrollBar(layerEntry *memory.LayerEntryType, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, height int, numberOfItems int, viewportHeight int, viewportPosition int) {
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.MenuForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.MenuBackgroundColor
+	handleHeight := height
+	handlePosition := 0
+	if numberOfItems > viewportHeight {
+		handleHeight = height * viewportHeight / numberOfItems
+		if handleHeight < 1 {
+			handleHeight = 1
+		}
+		handlePosition = (height - handleHeight) * viewportPosition / (numberOfItems - viewportHeight)
+	}
+	for currentRow := 0; currentRow < height; currentRow++ {
+		character := styleEntry.ScrollBarTrackPattern
+		if currentRow >= handlePosition && currentRow < handlePosition+handleHeight {
+			character = styleEntry.ScrollBarHandlePattern
+		}
+		printLayer(layerEntry, attributeEntry, xLocation, yLocation+currentRow, []rune{character})
+	}
+}
+
+/*
+getVerticalScrollBarViewportPosition allows you to obtain the viewport
+position represented by a row of a vertical scroll bar, where 0 is the top
+row of the scroll bar. Rows outside of the scroll bar return the first or
+last viewport position instead.
+*/
+func getVerticalScrollBarViewportPosition(scrollBarRow int, height int, numberOfItems int, viewportHeight int) int {
+	maximumViewportPosition := numberOfItems - viewportHeight
+	if maximumViewportPosition <= 0 || height <= 1 || scrollBarRow <= 0 {
+		return 0
+	}
+	if scrollBarRow >= height-1 {
+		return maximumViewportPosition
+	}
+	return scrollBarRow * maximumViewportPosition / (height - 1)
+}
+
+/*
+DrawHorizontalScrollBar allows you to draw a horizontal scroll bar on a
+given text layer. It works exactly like 'DrawVerticalScrollBar', except
+that the number of items, viewport width, and viewport position refer to
+columns instead of rows.
+*/
+func DrawHorizontalScrollBar(layerAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, numberOfItems int, viewportWidth int, viewportPosition int) {
+	layerEntry := memory.GetLayer(layerAlias)
+	drawHorizontalScrollBar(layerEntry, styleEntry, xLocation, yLocation, width, numberOfItems, viewportWidth, viewportPosition)
+}
+
+/*
+drawHorizontalScrollBar allows you to draw a horizontal scroll bar on a
+given text layer entry. If all items fit inside the viewport, the handle
+fills the entire scroll bar.
+*/
+func drawHorizontalScrollBar(layerEntry *memory.LayerEntryType, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, numberOfItems int, viewportWidth int, viewportPosition int) {
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.MenuForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.MenuBackgroundColor
+	handleWidth := width
+	handlePosition := 0
+	if numberOfItems > viewportWidth {
+		handleWidth = width * viewportWidth / numberOfItems
+		if handleWidth < 1 {
+			handleWidth = 1
+		}
+		handlePosition = (width - handleWidth) * viewportPosition / (numberOfItems - viewportWidth)
+	}
+	for currentColumn := 0; currentColumn < width; currentColumn++ {
+		character := styleEntry.ScrollBarTrackPattern
+		if currentColumn >= handlePosition && currentColumn < handlePosition+handleWidth {
+			character = styleEntry.ScrollBarHandlePattern
+		}
+		printLayer(layerEntry, attributeEntry, xLocation+currentColumn, yLocation, []rune{character})
+	}
+}
+
 /*
 fillLayer allows you to fill an entire layer with characters of your choice.
 If you wish to fill the layer with repeating text, simply provide the string
@@ -0,0 +1,356 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+)
+
+/*
+These constants represent the scroll bars of a viewport which can be
+interacted with using the mouse.
+*/
+const (
+	viewportScrollBarNone       = 0
+	viewportScrollBarVertical   = 1
+	viewportScrollBarHorizontal = 2
+)
+
+/*
+viewportHistoryType is a structure used to remember which viewport scroll
+bar the user is currently dragging.
+*/
+type viewportHistoryType struct {
+	viewportAlias string
+	scrollBar     int
+}
+
+/*
+viewportHistory is a variable used to hold the viewport scroll bar the user
+is currently dragging. It is only accessed by methods which read from the
+event queue.
+*/
+var viewportHistory viewportHistoryType
+
+/*
+AddViewport allows you to display a text layer which is larger than the
+area it should occupy, by showing it through a smaller window which can be
+scrolled. For example:
+
+	// Create a large text layer and show it through a small viewport.
+	dosktop.AddLayer("Document", 0, 0, 200, 500, 1, "")
+	dosktop.AddViewport("DocumentView", "Document", styleEntry, 5, 2, 40, 10, 1, "", true, true)
+	dosktop.ScrollViewportToRow("DocumentView", 100)
+
+In addition, the following information should be noted:
+
+- The viewport owns a new text layer with the viewport alias, which is
+placed at the location and size provided. The text layer specified becomes
+a child of the viewport, so only the portion of it inside the viewport is
+rendered. You can continue to print on it and add controls to it as usual.
+
+- A vertical scroll bar can be shown in the right-most column of the
+viewport, and a horizontal scroll bar in its bottom row. Pressing or
+dragging the mouse on a scroll bar scrolls the viewport. Moving the mouse
+wheel over the viewport also scrolls it, unless a control under the mouse
+uses the wheel itself.
+
+- The text layer specified becomes your default text layer.
+
+- If the text layer specified does not exist, or the viewport is too small
+to show any content beside its scroll bars, a panic will be generated to
+fail as fast as possible.
+*/
+func AddViewport(viewportAlias string, layerAlias string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, height int, zOrderPriority int, parentAlias string, isVerticalScrollBarShown bool, isHorizontalScrollBarShown bool) {
+	layerEntry := memory.GetLayer(layerAlias)
+	minimumWidth, minimumHeight := 1, 1
+	if isVerticalScrollBarShown {
+		minimumWidth++
+	}
+	if isHorizontalScrollBarShown {
+		minimumHeight++
+	}
+	if width < minimumWidth || height < minimumHeight {
+		panic(fmt.Sprintf("The viewport '%s' could not be created since the width and height of (%d, %d) is smaller than the minimum allowed.", viewportAlias, width, height))
+	}
+	AddLayer(viewportAlias, xLocation, yLocation, width, height, zOrderPriority, parentAlias)
+	memory.AddViewport(viewportAlias, layerAlias, styleEntry, isVerticalScrollBarShown, isHorizontalScrollBarShown)
+	markLayerAsDirty(layerEntry)
+	previousParentAlias := layerEntry.ParentAlias
+	layerEntry.ParentAlias = viewportAlias
+	layerEntry.ScreenXLocation = 0
+	layerEntry.ScreenYLocation = 0
+	memory.GetLayer(viewportAlias).IsParent = true
+	if previousParentAlias != "" && memory.IsLayerExists(previousParentAlias) && !memory.IsAParent(previousParentAlias) {
+		memory.GetLayer(previousParentAlias).IsParent = false
+	}
+	commonResource.layerAlias = layerAlias
+}
+
+/*
+DeleteViewport allows you to remove a viewport, along with the text layer
+it owns and the text layer being shown through it. If the viewport does not
+exist, then the operation will be ignored.
+*/
+func DeleteViewport(viewportAlias string) {
+	DeleteLayer(viewportAlias)
+	memory.DeleteViewport(viewportAlias)
+}
+
+/*
+GetViewportOffset allows you to obtain the column and row of the text layer
+being shown at the top left corner of a viewport. If the viewport does not
+exist, then a panic will be generated to fail as fast as possible.
+*/
+func GetViewportOffset(viewportAlias string) (int, int) {
+	layerEntry := memory.GetLayer(memory.GetViewport(viewportAlias).LayerAlias)
+	return -layerEntry.ScreenXLocation, -layerEntry.ScreenYLocation
+}
+
+/*
+ScrollViewportToRow allows you to scroll a viewport so that the row
+specified is shown at its top. If the row is too close to the bottom of the
+text layer being shown, the viewport is scrolled as far as possible
+instead. If the viewport does not exist, then a panic will be generated to
+fail as fast as possible.
+*/
+func ScrollViewportToRow(viewportAlias string, row int) {
+	column, _ := GetViewportOffset(viewportAlias)
+	setViewportOffset(memory.GetViewport(viewportAlias), column, row)
+}
+
+/*
+ScrollViewportToColumn allows you to scroll a viewport so that the column
+specified is shown at its left edge. If the column is too close to the
+right edge of the text layer being shown, the viewport is scrolled as far
+as possible instead. If the viewport does not exist, then a panic will be
+generated to fail as fast as possible.
+*/
+func ScrollViewportToColumn(viewportAlias string, column int) {
+	_, row := GetViewportOffset(viewportAlias)
+	setViewportOffset(memory.GetViewport(viewportAlias), column, row)
+}
+
+/*
+ScrollViewportToCursor allows you to scroll a viewport by the smallest
+amount needed to make the cursor of the text layer being shown visible.
+This is useful after printing to the text layer, so that the text most
+recently printed can always be seen. If the cursor is already visible, then
+no operation takes place. If the viewport does not exist, then a panic will
+be generated to fail as fast as possible.
+*/
+func ScrollViewportToCursor(viewportAlias string) {
+	viewportEntry := memory.GetViewport(viewportAlias)
+	layerEntry := memory.GetLayer(viewportEntry.LayerAlias)
+	viewportWidth, viewportHeight := getViewportContentSize(viewportEntry)
+	column, row := GetViewportOffset(viewportAlias)
+	if layerEntry.CursorXLocation < column {
+		column = layerEntry.CursorXLocation
+	} else if layerEntry.CursorXLocation >= column+viewportWidth {
+		column = layerEntry.CursorXLocation - viewportWidth + 1
+	}
+	if layerEntry.CursorYLocation < row {
+		row = layerEntry.CursorYLocation
+	} else if layerEntry.CursorYLocation >= row+viewportHeight {
+		row = layerEntry.CursorYLocation - viewportHeight + 1
+	}
+	setViewportOffset(viewportEntry, column, row)
+}
+
+/*
+getViewportContentSize allows you to obtain the width and height of the
+area of a viewport used to show its text layer, which excludes any scroll
+bars.
+*/
+func getViewportContentSize(viewportEntry *memory.ViewportEntryType) (int, int) {
+	viewportLayerEntry := memory.GetLayer(viewportEntry.ViewportAlias)
+	width := viewportLayerEntry.Width
+	height := viewportLayerEntry.Height
+	if viewportEntry.IsVerticalScrollBarShown {
+		width--
+	}
+	if viewportEntry.IsHorizontalScrollBarShown {
+		height--
+	}
+	return width, height
+}
+
+/*
+setViewportOffset allows you to change which column and row of its text
+layer a viewport shows at its top left corner. The values provided are
+clamped so that the viewport never scrolls past the edges of its text
+layer.
+*/
+func setViewportOffset(viewportEntry *memory.ViewportEntryType, column int, row int) {
+	layerEntry := memory.GetLayer(viewportEntry.LayerAlias)
+	viewportWidth, viewportHeight := getViewportContentSize(viewportEntry)
+	if column > layerEntry.Width-viewportWidth {
+		column = layerEntry.Width - viewportWidth
+	}
+	if row > layerEntry.Height-viewportHeight {
+		row = layerEntry.Height - viewportHeight
+	}
+	if column < 0 {
+		column = 0
+	}
+	if row < 0 {
+		row = 0
+	}
+	if layerEntry.ScreenXLocation == -column && layerEntry.ScreenYLocation == -row {
+		return
+	}
+	layerEntry.ScreenXLocation = -column
+	layerEntry.ScreenYLocation = -row
+	markLayerAsDirty(memory.GetLayer(viewportEntry.ViewportAlias))
+}
+
+/*
+getViewportAliasForLayer allows you to find the viewport a text layer is
+shown through, either directly or through one of its parents. If the text
+layer is not inside a viewport, then an empty string is returned instead.
+*/
+func getViewportAliasForLayer(layerAlias string) string {
+	for layerAlias != "" && memory.IsLayerExists(layerAlias) {
+		if memory.IsViewportExists(layerAlias) {
+			return layerAlias
+		}
+		layerAlias = memory.GetLayer(layerAlias).ParentAlias
+	}
+	return ""
+}
+
+/*
+getViewportScrollBarAtLocation allows you to find the viewport scroll bar
+at the specified terminal location, searching the viewports which contain
+the text layer provided from the inside out. If no scroll bar is at that
+location, then an empty string and 'viewportScrollBarNone' are returned
+instead.
+*/
+func getViewportScrollBarAtLocation(layerAlias string, xLocation int, yLocation int) (string, int) {
+	for viewportAlias := getViewportAliasForLayer(layerAlias); viewportAlias != ""; viewportAlias = getViewportAliasForLayer(memory.GetLayer(viewportAlias).ParentAlias) {
+		viewportEntry := memory.GetViewport(viewportAlias)
+		viewportWidth, viewportHeight := getViewportContentSize(viewportEntry)
+		layerXLocation, layerYLocation := getLayerScreenLocation(memory.GetLayer(viewportAlias))
+		column := xLocation - layerXLocation
+		row := yLocation - layerYLocation
+		if viewportEntry.IsVerticalScrollBarShown && column == viewportWidth && row >= 0 && row < viewportHeight {
+			return viewportAlias, viewportScrollBarVertical
+		}
+		if viewportEntry.IsHorizontalScrollBarShown && row == viewportHeight && column >= 0 && column < viewportWidth {
+			return viewportAlias, viewportScrollBarHorizontal
+		}
+	}
+	return "", viewportScrollBarNone
+}
+
+/*
+scrollViewportByScrollBar allows you to scroll a viewport to the position
+represented by the specified terminal location on one of its scroll bars.
+*/
+func scrollViewportByScrollBar(viewportAlias string, scrollBar int, xLocation int, yLocation int) {
+	viewportEntry := memory.GetViewport(viewportAlias)
+	layerEntry := memory.GetLayer(viewportEntry.LayerAlias)
+	viewportWidth, viewportHeight := getViewportContentSize(viewportEntry)
+	layerXLocation, layerYLocation := getLayerScreenLocation(memory.GetLayer(viewportAlias))
+	column, row := GetViewportOffset(viewportAlias)
+	if scrollBar == viewportScrollBarVertical {
+		row = getVerticalScrollBarViewportPosition(yLocation-layerYLocation, viewportHeight, layerEntry.Height, viewportHeight)
+	} else {
+		column = getVerticalScrollBarViewportPosition(xLocation-layerXLocation, viewportWidth, layerEntry.Width, viewportWidth)
+	}
+	setViewportOffset(viewportEntry, column, row)
+}
+
+/*
+getViewportMouseEvents allows you to process a mouse event for any
+viewports it affects. The events which should be reported in its place are
+returned, along with a flag indicating if the event was used. In addition,
+the following information should be noted:
+
+- Pressing the left mouse button on a scroll bar, and dragging it
+afterwards, scrolls the viewport. These mouse events are not reported.
+
+- Moving the mouse wheel over a viewport scrolls it, unless a control
+under the mouse uses the wheel itself. The wheel event is still reported.
+*/
+func getViewportMouseEvents(eventEntry memory.EventEntryType) ([]memory.EventEntryType, bool) {
+	mouseEvent := eventEntry.MouseEvent
+	if viewportHistory.viewportAlias != "" && !memory.IsViewportExists(viewportHistory.viewportAlias) {
+		viewportHistory = viewportHistoryType{}
+	}
+	if viewportHistory.viewportAlias != "" {
+		switch mouseEvent.Action {
+		case constants.MouseActionDrag:
+			scrollViewportByScrollBar(viewportHistory.viewportAlias, viewportHistory.scrollBar, mouseEvent.XLocation, mouseEvent.YLocation)
+			return nil, true
+		case constants.MouseActionRelease:
+			viewportHistory = viewportHistoryType{}
+			return nil, true
+		case constants.MouseActionPress:
+			viewportHistory = viewportHistoryType{}
+		}
+	}
+	switch mouseEvent.Action {
+	case constants.MouseActionPress:
+		if mouseEvent.ButtonPressed != 1 {
+			return nil, false
+		}
+		viewportAlias, scrollBar := getViewportScrollBarAtLocation(mouseEvent.LayerAlias, mouseEvent.XLocation, mouseEvent.YLocation)
+		if viewportAlias == "" {
+			return nil, false
+		}
+		viewportHistory = viewportHistoryType{viewportAlias: viewportAlias, scrollBar: scrollBar}
+		scrollViewportByScrollBar(viewportAlias, scrollBar, mouseEvent.XLocation, mouseEvent.YLocation)
+		return nil, true
+	case constants.MouseActionWheel:
+		viewportAlias := getViewportAliasForLayer(mouseEvent.LayerAlias)
+		if viewportAlias == "" || getControlAtLocation(mouseEvent.LayerAlias, mouseEvent.XLocation, mouseEvent.YLocation) != nil {
+			return nil, false
+		}
+		column, row := GetViewportOffset(viewportAlias)
+		switch mouseEvent.WheelState {
+		case "Up":
+			row--
+		case "Down":
+			row++
+		case "Left":
+			column--
+		case "Right":
+			column++
+		}
+		setViewportOffset(memory.GetViewport(viewportAlias), column, row)
+		return []memory.EventEntryType{eventEntry}, true
+	}
+	return nil, false
+}
+
+/*
+drawViewportOnLayer allows you to draw the scroll bars of a viewport on a
+given text layer entry. This is done after the text layer being shown
+through the viewport has been rendered, so that the scroll bars are drawn
+on top of it. If the text layer is not a viewport, then no operation takes
+place.
+*/
+func drawViewportOnLayer(layerEntry *memory.LayerEntryType) {
+	if !memory.IsViewportExists(layerEntry.LayerAlias) {
+		return
+	}
+	viewportEntry := memory.GetViewport(layerEntry.LayerAlias)
+	styleEntry := viewportEntry.StyleEntry
+	contentLayerEntry := memory.GetLayer(viewportEntry.LayerAlias)
+	viewportWidth, viewportHeight := getViewportContentSize(viewportEntry)
+	column := -contentLayerEntry.ScreenXLocation
+	row := -contentLayerEntry.ScreenYLocation
+	if viewportEntry.IsVerticalScrollBarShown {
+		drawVerticalScrollBar(layerEntry, styleEntry, viewportWidth, 0, viewportHeight, contentLayerEntry.Height, viewportHeight, row)
+	}
+	if viewportEntry.IsHorizontalScrollBarShown {
+		drawHorizontalScrollBar(layerEntry, styleEntry, 0, viewportHeight, viewportWidth, contentLayerEntry.Width, viewportWidth, column)
+	}
+	if viewportEntry.IsVerticalScrollBarShown && viewportEntry.IsHorizontalScrollBarShown {
+		attributeEntry := memory.NewAttributeEntry()
+		attributeEntry.ForegroundColor = styleEntry.MenuForegroundColor
+		attributeEntry.BackgroundColor = styleEntry.MenuBackgroundColor
+		printLayer(layerEntry, attributeEntry, viewportWidth, viewportHeight, []rune{' '})
+	}
+}
@@ -0,0 +1,70 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestViewport(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(20, 8)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddLayer("Background", 0, 0, 20, 8, 0, "")
+	AddLayer("Document", 0, 0, 30, 20, 1, "")
+	for currentRow := 0; currentRow < 20; currentRow++ {
+		LocateLayer("Document", 0, currentRow)
+		PrintLayer("Document", fmt.Sprintf("Row%02dabcdefghijklmnopqrst", currentRow))
+	}
+	AddViewport("View", "Document", NewTuiStyleEntry(), 1, 1, 10, 5, 1, "", true, true)
+	injectMouse := func(xLocation int, yLocation int, buttonMask tcell.ButtonMask, modifierMask tcell.ModMask) {
+		simulationScreen.PostEventWait(tcell.NewEventMouse(xLocation, yLocation, buttonMask, modifierMask))
+	}
+	waitForMouseEvents := func() {
+		simulationScreen.PostEventWait(tcell.NewEventKey(tcell.KeyF12, 0, tcell.ModNone))
+		for WaitForEvent().EventType != constants.EventTypeKey {
+		}
+	}
+	getScreenText := func(xLocation int, yLocation int, length int) string {
+		UpdateDisplay()
+		cells, width, _ := simulationScreen.GetContents()
+		obtainedValue := ""
+		for currentXLocation := xLocation; currentXLocation < xLocation+length; currentXLocation++ {
+			obtainedValue += string(cells[yLocation*width+currentXLocation].Runes)
+		}
+		return obtainedValue
+	}
+	getViewportOffset := func() []int {
+		column, row := GetViewportOffset("View")
+		return []int{column, row}
+	}
+	assert.Equalf(test, "Document", commonResource.layerAlias, "The text layer shown was not made the default text layer!")
+	assert.Equalf(test, []string{"Row00abcd█", "Row03abcd░", "██░░░░░░░ "}, []string{getScreenText(1, 1, 10), getScreenText(1, 4, 10), getScreenText(1, 5, 10)}, "The viewport was not drawn correctly!")
+	ScrollViewportToRow("View", 10)
+	assert.Equalf(test, "Row10abcd", getScreenText(1, 1, 9), "The viewport was not scrolled to the row specified!")
+	ScrollViewportToRow("View", 100)
+	ScrollViewportToColumn("View", 3)
+	assert.Equalf(test, []interface{}{[]int{3, 16}, "16abcdefg"}, []interface{}{getViewportOffset(), getScreenText(1, 1, 9)}, "The viewport was not clamped or scrolled to the column specified!")
+	LocateLayer("Document", 28, 2)
+	ScrollViewportToCursor("View")
+	assert.Equalf(test, []int{20, 2}, getViewportOffset(), "The viewport was not scrolled to the cursor!")
+	injectMouse(5, 3, tcell.WheelDown, tcell.ModNone)
+	waitForMouseEvents()
+	assert.Equalf(test, []int{20, 3}, getViewportOffset(), "The mouse wheel did not scroll the viewport!")
+	injectMouse(10, 4, tcell.Button1, tcell.ModNone)
+	waitForMouseEvents()
+	assert.Equalf(test, []int{20, 16}, getViewportOffset(), "Pressing the vertical scroll bar did not scroll the viewport!")
+	injectMouse(10, 1, tcell.Button1, tcell.ModNone)
+	injectMouse(10, 1, tcell.ButtonNone, tcell.ModNone)
+	injectMouse(1, 5, tcell.Button1, tcell.ModNone)
+	injectMouse(1, 5, tcell.ButtonNone, tcell.ModNone)
+	waitForMouseEvents()
+	assert.Equalf(test, []int{0, 0}, getViewportOffset(), "Dragging the vertical scroll bar or pressing the horizontal scroll bar did not scroll the viewport!")
+	assert.Panicsf(test, func() { AddViewport("Small", "View", NewTuiStyleEntry(), 0, 0, 1, 1, 1, "", true, false) }, "Creating a viewport too small for its scroll bars did not panic!")
+	Layer("Background")
+	DeleteViewport("View")
+	assert.Falsef(test, memory.IsViewportExists("View") || memory.IsLayerExists("Document"), "The viewport or the text layer shown through it was not deleted!")
+}
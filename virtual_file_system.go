@@ -17,7 +17,9 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
+	"time"
 )
 var virtualFileSystemArchive string
 var virtualFileSystemPassword string
@@ -400,4 +402,183 @@ func getFileDataFromRarArchive(fileName string) ([]byte, error) {
 		}
 	}
 	return fileData, err
-}
\ No newline at end of file
+}
+
+/*
+fileSystemEntryType is a structure used to describe a file or directory
+found while listing the contents of a directory.
+*/
+type fileSystemEntryType struct {
+	name             string
+	isDirectory      bool
+	size             int64
+	modificationTime time.Time
+}
+
+/*
+isVirtualFileSystemMounted allows you to detect if a virtual file system is
+currently mounted.
+*/
+func isVirtualFileSystemMounted() bool {
+	return virtualFileSystemArchiveType != 0
+}
+
+/*
+getDirectoryEntriesFromFileSystem allows you to list the files and
+directories inside a directory of the default file system. If you have a
+virtual file system mounted, then the directory will be listed from it
+instead of your local file system. In addition, the following information
+should be noted:
+
+- Directories of a virtual file system are specified relative to the root
+of the archive, using '/' as a separator. The root of the archive is
+specified as "".
+
+- Directories are listed before files, and both are sorted by name without
+regard to case.
+
+- If for some reason the directory could not be listed, an error will be
+returned so that your application can handle this case appropriately.
+*/
+func getDirectoryEntriesFromFileSystem(directoryPath string) ([]fileSystemEntryType, error) {
+	var directoryEntries []fileSystemEntryType
+	var err error
+	if virtualFileSystemArchiveType == constants.VirtualFileSystemZip {
+		directoryEntries, err = getDirectoryEntriesFromZipArchive(directoryPath)
+	} else if virtualFileSystemArchiveType == constants.VirtualFileSystemRar {
+		directoryEntries, err = getDirectoryEntriesFromRarArchive(directoryPath)
+	} else {
+		directoryEntries, err = getDirectoryEntriesFromLocalFileSystem(directoryPath)
+	}
+	return directoryEntries, err
+}
+
+/*
+getDirectoryEntriesFromLocalFileSystem allows you to list the files and
+directories inside a directory of the local file system. If the directory
+cannot be listed, then an error is returned instead.
+*/
+func getDirectoryEntriesFromLocalFileSystem(directoryPath string) ([]fileSystemEntryType, error) {
+	var directoryEntries []fileSystemEntryType
+	fileInfos, err := ioutil.ReadDir(directoryPath)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("Could not list the directory '%s': %s", directoryPath, err.Error()))
+		return directoryEntries, err
+	}
+	for _, currentFileInfo := range fileInfos {
+		directoryEntries = append(directoryEntries, fileSystemEntryType{currentFileInfo.Name(), currentFileInfo.IsDir(), currentFileInfo.Size(), currentFileInfo.ModTime()})
+	}
+	sortDirectoryEntries(directoryEntries)
+	return directoryEntries, err
+}
+
+/*
+getDirectoryEntriesFromZipArchive allows you to list the files and
+directories inside a directory of a ZIP archive. If the archive cannot be
+opened, then an error is returned instead.
+*/
+func getDirectoryEntriesFromZipArchive(directoryPath string) ([]fileSystemEntryType, error) {
+	var directoryEntries []fileSystemEntryType
+	archiveReadCloser, err := zip.OpenReader(virtualFileSystemArchive)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("Could not open '%s': %s", virtualFileSystemArchive, err.Error()))
+		return directoryEntries, err
+	}
+	defer archiveReadCloser.Close()
+	for _, currentFile := range archiveReadCloser.File {
+		currentFileInfo := currentFile.FileInfo()
+		directoryEntries = addArchiveDirectoryEntry(directoryEntries, directoryPath, currentFile.Name, currentFileInfo.IsDir(), currentFileInfo.Size(), currentFileInfo.ModTime())
+	}
+	sortDirectoryEntries(directoryEntries)
+	return directoryEntries, err
+}
+
+/*
+getDirectoryEntriesFromRarArchive allows you to list the files and
+directories inside a directory of a RAR archive. If the archive cannot be
+opened or scanned, then an error is returned instead. In addition, the
+following information should be noted:
+
+- If the virtual file system is password protected, then the password
+provided at mount time will be used to read the archive. If a scramble key
+was provided at mount time, it will be used to unscramble the password
+before being used.
+*/
+func getDirectoryEntriesFromRarArchive(directoryPath string) ([]fileSystemEntryType, error) {
+	var directoryEntries []fileSystemEntryType
+	archivePassword := virtualFileSystemPassword
+	if virtualFileSystemEncryptionKey != "" {
+		archivePassword = getUnscrambledPassword(archivePassword, virtualFileSystemEncryptionKey)
+	}
+	archiveReadCloser, err := rardecode.OpenReader(virtualFileSystemArchive, archivePassword)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("Could not open '%s': %s", virtualFileSystemArchive, err.Error()))
+		return directoryEntries, err
+	}
+	defer archiveReadCloser.Close()
+	for {
+		fileHeader, err := archiveReadCloser.Next()
+		if err == io.EOF {
+			sortDirectoryEntries(directoryEntries)
+			return directoryEntries, nil
+		}
+		if err != nil {
+			err = errors.New(fmt.Sprintf("Failed while scanning archive '%s': %s", virtualFileSystemArchive, err.Error()))
+			return directoryEntries, err
+		}
+		directoryEntries = addArchiveDirectoryEntry(directoryEntries, directoryPath, fileHeader.Name, fileHeader.IsDir, fileHeader.UnPackedSize, fileHeader.ModificationTime)
+	}
+}
+
+/*
+addArchiveDirectoryEntry allows you to add a file stored in an archive to a
+list of directory entries, if it is found inside the directory being
+listed. In addition, the following information should be noted:
+
+- Archives do not always store their directories as separate entries. If a
+file is stored in a sub-directory of the directory being listed, then the
+sub-directory is added instead.
+
+- Entries which have already been added are not added again.
+*/
+func addArchiveDirectoryEntry(directoryEntries []fileSystemEntryType, directoryPath string, fileName string, isDirectory bool, size int64, modificationTime time.Time) []fileSystemEntryType {
+	fileName = strings.ReplaceAll(fileName, "\\", "/")
+	directoryPath = strings.Trim(directoryPath, "/")
+	if directoryPath != "" {
+		if !strings.HasPrefix(fileName, directoryPath+"/") {
+			return directoryEntries
+		}
+		fileName = strings.TrimPrefix(fileName, directoryPath+"/")
+	}
+	fileName = strings.TrimSuffix(fileName, "/")
+	if fileName == "" {
+		return directoryEntries
+	}
+	if separatorIndex := strings.Index(fileName, "/"); separatorIndex != -1 {
+		fileName = fileName[:separatorIndex]
+		isDirectory = true
+		size = 0
+	}
+	for _, currentDirectoryEntry := range directoryEntries {
+		if currentDirectoryEntry.name == fileName {
+			return directoryEntries
+		}
+	}
+	return append(directoryEntries, fileSystemEntryType{fileName, isDirectory, size, modificationTime})
+}
+
+/*
+sortDirectoryEntries allows you to sort a list of directory entries so that
+directories are listed before files, and both are sorted by name without
+regard to case.
+*/
+func sortDirectoryEntries(directoryEntries []fileSystemEntryType) {
+	sort.SliceStable(directoryEntries, func(firstIndex int, secondIndex int) bool {
+		firstEntry := directoryEntries[firstIndex]
+		secondEntry := directoryEntries[secondIndex]
+		if firstEntry.isDirectory != secondEntry.isDirectory {
+			return firstEntry.isDirectory
+		}
+		return strings.ToLower(firstEntry.name) < strings.ToLower(secondEntry.name)
+	})
+}
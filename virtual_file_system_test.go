@@ -70,4 +70,24 @@ func TestGetFileDataFromLocalFileSystem(test *testing.T) {
 func TestGetTextFromFileSystem(test *testing.T) {
 	_, err := getTextFromFileSystem(BASE_DIRECTORY + "text_file.txt")
 	assert.NoErrorf(test, err, "Did not expect an error reading a text file that should exist!")
-}
\ No newline at end of file
+}
+
+func TestGetDirectoryEntriesFromFileSystem(test *testing.T) {
+	for _, currentArchive := range []string{"valid.zip", "valid.rar"} {
+		err := mountVirtualFileSystem(BASE_DIRECTORY+currentArchive, "TAFDRw==", "SampleScrambleKey")
+		assert.NoErrorf(test, err, "Failed to open a valid virtual file system!")
+		var obtainedResult []string
+		for _, currentDirectoryPath := range []string{"", "myFolder-1", "myFolder-1/myFolder-2/"} {
+			directoryEntries, err := getDirectoryEntriesFromFileSystem(currentDirectoryPath)
+			assert.NoErrorf(test, err, "Did not expect an error listing a directory that should exist!")
+			for _, currentDirectoryEntry := range directoryEntries {
+				obtainedResult = append(obtainedResult, currentDirectoryEntry.name)
+			}
+		}
+		expectedResult := []string{"myFolder-1", "sample.png", "myFolder-2", "sample2.png", "sample3.png"}
+		assert.Equalf(test, expectedResult, obtainedResult, "The directories of '%s' were not listed correctly!", currentArchive)
+	}
+	UnmountVirtualFileSystem()
+	_, err := getDirectoryEntriesFromFileSystem(BASE_DIRECTORY + "missing")
+	assert.Errorf(test, err, "Expected an error listing a directory that does not exist.")
+}
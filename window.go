@@ -0,0 +1,423 @@
+package dosktop
+
+import (
+	"fmt"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/math"
+	"github.com/supercom32/dosktop/internal/memory"
+)
+
+/*
+These constants represent the various areas of a window which can be
+interacted with using the mouse.
+*/
+const (
+	windowAreaNone          = 0
+	windowAreaContent       = 1
+	windowAreaTitleBar      = 2
+	windowAreaCloseGlyph    = 3
+	windowAreaMaximizeGlyph = 4
+	windowAreaRightBorder   = 5
+	windowAreaBottomBorder  = 6
+	windowAreaCorner        = 7
+)
+
+/*
+windowHistoryType is a structure used to remember which window the user is
+currently interacting with, and the state it was in when the interaction
+started.
+*/
+type windowHistoryType struct {
+	windowAlias    string
+	windowArea     int
+	pressXLocation int
+	pressYLocation int
+	xLocation      int
+	yLocation      int
+	width          int
+	height         int
+}
+
+/*
+windowHistory is a variable used to hold the window the user is currently
+interacting with. It is only accessed by methods which read from the event
+queue.
+*/
+var windowHistory windowHistoryType
+
+/*
+AddWindow allows you to create a managed window. A managed window owns a
+text layer with the same alias, which is decorated with a border, a title
+bar, and glyphs for closing and maximizing the window. For example:
+
+	// Create a window and print some text inside it.
+	dosktop.AddWindow("MyWindow", "My Window", styleEntry, 5, 2, 30, 10, 1, "")
+	dosktop.LocateLayer("MyWindow", 2, 2)
+	dosktop.PrintLayer("MyWindow", "Hello World")
+
+In addition, the following information should be noted:
+
+- The border and title bar are not drawn physically to the text layer.
+Instead they are rendered at the same time the text layer is rendered, so
+anything drawn on the outer edge of the text layer will be hidden. Any
+empty cells inside the window are rendered using the window background
+color.
+
+- Managed windows can be dragged by their title bar, resized from their
+right and bottom borders, and are raised above their siblings when clicked.
+Double clicking the title bar toggles the window between its maximized and
+normal size.
+
+- When the user moves, resizes, maximizes, restores, or clicks the close
+glyph of a window, a window event is returned by 'PollEvent' and
+'WaitForEvent'. Windows are never closed automatically, so that your
+application can decide what to do. Call 'DeleteWindow' to close it.
+
+- Child text layers can be added to a window by using the window alias as
+their parent alias.
+
+- If the window is smaller than 'constants.WindowMinimumWidth' or
+'constants.WindowMinimumHeight', a panic will be generated to fail as fast
+as possible.
+*/
+func AddWindow(windowAlias string, windowTitle string, styleEntry memory.TuiStyleEntryType, xLocation int, yLocation int, width int, height int, zOrderPriority int, parentAlias string) {
+	if width < constants.WindowMinimumWidth || height < constants.WindowMinimumHeight {
+		panic(fmt.Sprintf("The window '%s' could not be created since the width and height of (%d, %d) is smaller than the minimum allowed.", windowAlias, width, height))
+	}
+	AddLayer(windowAlias, xLocation, yLocation, width, height, zOrderPriority, parentAlias)
+	memory.AddWindow(windowAlias, windowTitle, styleEntry)
+}
+
+/*
+DeleteWindow allows you to remove a managed window, along with the text
+layer it owns and all of its children. If the window does not exist, then
+the operation will be ignored.
+*/
+func DeleteWindow(windowAlias string) {
+	DeleteLayer(windowAlias)
+	memory.DeleteWindow(windowAlias)
+}
+
+/*
+SetWindowTitle allows you to change the title displayed in the title bar of
+a managed window. If the title is too long to fit, only the portion which
+fits will be displayed.
+*/
+func SetWindowTitle(windowAlias string, windowTitle string) {
+	windowEntry := memory.GetWindow(windowAlias)
+	windowEntry.WindowTitle = windowTitle
+	memory.GetLayer(windowAlias).MarkDirtyRegion(0, 0, memory.GetLayer(windowAlias).Width, 1)
+}
+
+/*
+RaiseWindow allows you to bring a managed window in front of all other
+text layers which share the same parent. This happens automatically when
+the user clicks on a window.
+*/
+func RaiseWindow(windowAlias string) {
+	layerEntry := memory.GetLayer(windowAlias)
+	highestZOrder := layerEntry.ZOrder
+	isRaiseRequired := false
+	for currentKey, currentLayerEntry := range memory.ScreenMemory {
+		if currentKey == windowAlias || currentLayerEntry.ParentAlias != layerEntry.ParentAlias {
+			continue
+		}
+		if currentLayerEntry.ZOrder >= highestZOrder {
+			highestZOrder = currentLayerEntry.ZOrder
+			isRaiseRequired = true
+		}
+	}
+	if isRaiseRequired {
+		layerEntry.ZOrder = highestZOrder + 1
+		markLayerAsDirty(layerEntry)
+	}
+}
+
+/*
+MaximizeWindow allows you to make a managed window fill its container. The
+container of a window is its parent, or the terminal display if it has no
+parent. In addition, the following information should be noted:
+
+- While maximized, the window follows the size of its container and cannot
+be moved or resized by the user.
+
+- If the window is already maximized, then no operation takes place.
+*/
+func MaximizeWindow(windowAlias string) {
+	windowEntry := memory.GetWindow(windowAlias)
+	if windowEntry.IsMaximized {
+		return
+	}
+	layerEntry := memory.GetLayer(windowAlias)
+	windowEntry.IsMaximized = true
+	windowEntry.RestoreXLocation = layerEntry.ScreenXLocation
+	windowEntry.RestoreYLocation = layerEntry.ScreenYLocation
+	windowEntry.RestoreWidth = layerEntry.Width
+	windowEntry.RestoreHeight = layerEntry.Height
+	windowEntry.RestoreLayoutEntry = layerEntry.LayoutEntry
+	SetLayerLayoutByPercent(windowAlias, 0, 0, 100, 100)
+	layerEntry.MarkDirty()
+}
+
+/*
+RestoreWindow allows you to return a maximized window to the size and
+location it had before it was maximized. If the window is not maximized,
+then no operation takes place.
+*/
+func RestoreWindow(windowAlias string) {
+	windowEntry := memory.GetWindow(windowAlias)
+	if !windowEntry.IsMaximized {
+		return
+	}
+	layerEntry := memory.GetLayer(windowAlias)
+	windowEntry.IsMaximized = false
+	layerEntry.LayoutEntry = windowEntry.RestoreLayoutEntry
+	MoveLayerByAbsoluteValue(windowAlias, windowEntry.RestoreXLocation, windowEntry.RestoreYLocation)
+	ResizeLayer(windowAlias, windowEntry.RestoreWidth, windowEntry.RestoreHeight)
+	layerEntry.MarkDirty()
+}
+
+/*
+drawWindowOnLayer allows you to draw the border, title bar, and background
+of a managed window on a given text layer entry. If the text layer is not a
+managed window, then no operation takes place.
+*/
+func drawWindowOnLayer(layerEntry *memory.LayerEntryType) {
+	if !memory.IsWindowExists(layerEntry.LayerAlias) {
+		return
+	}
+	windowEntry := memory.GetWindow(layerEntry.LayerAlias)
+	styleEntry := windowEntry.StyleEntry
+	attributeEntry := memory.NewAttributeEntry()
+	attributeEntry.ForegroundColor = styleEntry.TextForegroundColor
+	attributeEntry.BackgroundColor = styleEntry.TextBackgroundColor
+	for currentRow := 0; currentRow < layerEntry.Height; currentRow++ {
+		for currentCharacter := 0; currentCharacter < layerEntry.Width; currentCharacter++ {
+			if layerEntry.CharacterMemory[currentRow][currentCharacter].Character == constants.NullRune {
+				layerEntry.CharacterMemory[currentRow][currentCharacter].Character = ' '
+				layerEntry.CharacterMemory[currentRow][currentCharacter].AttributeEntry = memory.NewAttributeEntry(&attributeEntry)
+			}
+		}
+	}
+	drawBorder(layerEntry, styleEntry, attributeEntry, 0, 0, layerEntry.Width, layerEntry.Height)
+	windowTitle := []rune(windowEntry.WindowTitle)
+	maximumTitleLength := layerEntry.Width - 12
+	if len(windowTitle) > maximumTitleLength {
+		windowTitle = windowTitle[:maximumTitleLength]
+	}
+	drawFrameLabel(layerEntry, styleEntry, string(windowTitle), 1, 0)
+	maximizeGlyph := "[+]"
+	if windowEntry.IsMaximized {
+		maximizeGlyph = "[-]"
+	}
+	printLayer(layerEntry, attributeEntry, layerEntry.Width-7, 0, []rune(maximizeGlyph))
+	printLayer(layerEntry, attributeEntry, layerEntry.Width-4, 0, []rune("[x]"))
+}
+
+/*
+getWindowAliasForLayer allows you to find the managed window a text layer
+belongs to. If the text layer is not a window or the child of a window, then
+an empty string is returned instead.
+*/
+func getWindowAliasForLayer(layerAlias string) string {
+	for layerAlias != "" && memory.IsLayerExists(layerAlias) {
+		if memory.IsWindowExists(layerAlias) {
+			return layerAlias
+		}
+		layerAlias = memory.GetLayer(layerAlias).ParentAlias
+	}
+	return ""
+}
+
+/*
+getWindowArea allows you to determine which area of a managed window is at
+the specified terminal location. In addition, the following information
+should be noted:
+
+- Maximized windows have no resizable borders, since they always fill their
+container.
+*/
+func getWindowArea(windowAlias string, xLocation int, yLocation int) int {
+	layerEntry := memory.GetLayer(windowAlias)
+	windowEntry := memory.GetWindow(windowAlias)
+	layerXLocation, layerYLocation := getLayerScreenLocation(layerEntry)
+	xLocation -= layerXLocation
+	yLocation -= layerYLocation
+	if xLocation < 0 || xLocation >= layerEntry.Width || yLocation < 0 || yLocation >= layerEntry.Height {
+		return windowAreaNone
+	}
+	if yLocation == 0 {
+		if xLocation >= layerEntry.Width-4 && xLocation <= layerEntry.Width-2 {
+			return windowAreaCloseGlyph
+		}
+		if xLocation >= layerEntry.Width-7 && xLocation <= layerEntry.Width-5 {
+			return windowAreaMaximizeGlyph
+		}
+		return windowAreaTitleBar
+	}
+	if !windowEntry.IsMaximized {
+		if xLocation == layerEntry.Width-1 && yLocation == layerEntry.Height-1 {
+			return windowAreaCorner
+		}
+		if xLocation == layerEntry.Width-1 {
+			return windowAreaRightBorder
+		}
+		if yLocation == layerEntry.Height-1 {
+			return windowAreaBottomBorder
+		}
+	}
+	return windowAreaContent
+}
+
+/*
+getWindowEvents allows you to process a mouse event for any managed windows
+it affects. The events which should be reported in its place are returned.
+In addition, the following information should be noted:
+
+- Mouse events over the content area of a window, or outside of any window,
+are returned unchanged.
+
+- Mouse events used to drag, resize, maximize, or close a window are
+replaced by window events describing what happened. If nothing happened
+yet, no events are returned at all.
+*/
+func getWindowEvents(eventEntry memory.EventEntryType) []memory.EventEntryType {
+	mouseEvent := eventEntry.MouseEvent
+	if windowHistory.windowAlias != "" && !memory.IsWindowExists(windowHistory.windowAlias) {
+		windowHistory = windowHistoryType{}
+	}
+	if windowHistory.windowAlias != "" {
+		switch mouseEvent.Action {
+		case constants.MouseActionDrag:
+			return dragWindow(mouseEvent.XLocation, mouseEvent.YLocation)
+		case constants.MouseActionRelease:
+			var windowEvents []memory.EventEntryType
+			windowAlias := windowHistory.windowAlias
+			windowArea := getWindowArea(windowAlias, mouseEvent.XLocation, mouseEvent.YLocation)
+			if windowHistory.windowArea == windowAreaCloseGlyph && windowArea == windowAreaCloseGlyph {
+				windowEvents = append(windowEvents, newWindowEventEntry(windowAlias, constants.WindowActionClose))
+			}
+			if windowHistory.windowArea == windowAreaMaximizeGlyph && windowArea == windowAreaMaximizeGlyph {
+				windowEvents = append(windowEvents, toggleWindowMaximized(windowAlias)...)
+			}
+			windowHistory = windowHistoryType{}
+			return windowEvents
+		case constants.MouseActionEnter, constants.MouseActionLeave, constants.MouseActionMove, constants.MouseActionWheel:
+			return []memory.EventEntryType{eventEntry}
+		}
+		windowHistory = windowHistoryType{}
+	}
+	if mouseEvent.Action != constants.MouseActionPress {
+		return []memory.EventEntryType{eventEntry}
+	}
+	windowAlias := getWindowAliasForLayer(mouseEvent.LayerAlias)
+	if windowAlias == "" {
+		return []memory.EventEntryType{eventEntry}
+	}
+	for parentAlias := windowAlias; parentAlias != ""; parentAlias = getWindowAliasForLayer(memory.GetLayer(parentAlias).ParentAlias) {
+		RaiseWindow(parentAlias)
+	}
+	windowArea := windowAreaContent
+	if mouseEvent.LayerAlias == windowAlias {
+		windowArea = getWindowArea(windowAlias, mouseEvent.XLocation, mouseEvent.YLocation)
+	}
+	if windowArea == windowAreaContent || mouseEvent.ButtonPressed != 1 {
+		return []memory.EventEntryType{eventEntry}
+	}
+	if windowArea == windowAreaTitleBar && mouseEvent.ClickCount == 2 {
+		return toggleWindowMaximized(windowAlias)
+	}
+	if windowArea == windowAreaTitleBar && memory.GetWindow(windowAlias).IsMaximized {
+		return nil
+	}
+	layerEntry := memory.GetLayer(windowAlias)
+	windowHistory = windowHistoryType{
+		windowAlias:    windowAlias,
+		windowArea:     windowArea,
+		pressXLocation: mouseEvent.XLocation,
+		pressYLocation: mouseEvent.YLocation,
+		xLocation:      layerEntry.ScreenXLocation,
+		yLocation:      layerEntry.ScreenYLocation,
+		width:          layerEntry.Width,
+		height:         layerEntry.Height,
+	}
+	return nil
+}
+
+/*
+dragWindow allows you to move or resize the window currently being
+interacted with, based on how far the mouse has moved since it was pressed.
+In addition, the following information should be noted:
+
+- Windows cannot be dragged so far that their title bar leaves their
+container, or resized smaller than the minimum window size.
+*/
+func dragWindow(mouseXLocation int, mouseYLocation int) []memory.EventEntryType {
+	var windowEvents []memory.EventEntryType
+	windowAlias := windowHistory.windowAlias
+	layerEntry := memory.GetLayer(windowAlias)
+	xDistance := mouseXLocation - windowHistory.pressXLocation
+	yDistance := mouseYLocation - windowHistory.pressYLocation
+	switch windowHistory.windowArea {
+	case windowAreaTitleBar:
+		containerWidth, containerHeight := getLayerContainerSize(layerEntry)
+		xLocation := math.GetClampedValueAsInt(windowHistory.xLocation+xDistance, 1-layerEntry.Width, containerWidth-1)
+		yLocation := math.GetClampedValueAsInt(windowHistory.yLocation+yDistance, 0, containerHeight-1)
+		if xLocation != layerEntry.ScreenXLocation || yLocation != layerEntry.ScreenYLocation {
+			MoveLayerByAbsoluteValue(windowAlias, xLocation, yLocation)
+			windowEvents = append(windowEvents, newWindowEventEntry(windowAlias, constants.WindowActionMove))
+		}
+	case windowAreaRightBorder, windowAreaBottomBorder, windowAreaCorner:
+		width := windowHistory.width
+		height := windowHistory.height
+		if windowHistory.windowArea != windowAreaBottomBorder {
+			width += xDistance
+		}
+		if windowHistory.windowArea != windowAreaRightBorder {
+			height += yDistance
+		}
+		if width < constants.WindowMinimumWidth {
+			width = constants.WindowMinimumWidth
+		}
+		if height < constants.WindowMinimumHeight {
+			height = constants.WindowMinimumHeight
+		}
+		if width != layerEntry.Width || height != layerEntry.Height {
+			ResizeLayer(windowAlias, width, height)
+			windowEvents = append(windowEvents, newWindowEventEntry(windowAlias, constants.WindowActionResize))
+		}
+	}
+	return windowEvents
+}
+
+/*
+toggleWindowMaximized allows you to maximize a window if it is in its normal
+state, or restore it if it is maximized. The window events describing the
+change are returned.
+*/
+func toggleWindowMaximized(windowAlias string) []memory.EventEntryType {
+	windowAction := constants.WindowActionMaximize
+	if memory.GetWindow(windowAlias).IsMaximized {
+		windowAction = constants.WindowActionRestore
+		RestoreWindow(windowAlias)
+	} else {
+		MaximizeWindow(windowAlias)
+	}
+	return []memory.EventEntryType{newWindowEventEntry(windowAlias, windowAction), newWindowEventEntry(windowAlias, constants.WindowActionResize)}
+}
+
+/*
+newWindowEventEntry allows you to create a window event for the specified
+window, which includes its current location and size.
+*/
+func newWindowEventEntry(windowAlias string, windowAction int) memory.EventEntryType {
+	layerEntry := memory.GetLayer(windowAlias)
+	eventEntry := newEventEntry(constants.EventTypeWindow)
+	eventEntry.WindowEvent.WindowAlias = windowAlias
+	eventEntry.WindowEvent.Action = windowAction
+	eventEntry.WindowEvent.XLocation = layerEntry.ScreenXLocation
+	eventEntry.WindowEvent.YLocation = layerEntry.ScreenYLocation
+	eventEntry.WindowEvent.Width = layerEntry.Width
+	eventEntry.WindowEvent.Height = layerEntry.Height
+	return eventEntry
+}
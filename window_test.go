@@ -0,0 +1,75 @@
+package dosktop
+
+import (
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"github.com/supercom32/dosktop/constants"
+	"github.com/supercom32/dosktop/internal/memory"
+	"testing"
+)
+
+func TestWindowRendering(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(30, 10)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddWindow("MyWindow", "Title", NewTuiStyleEntry(), 2, 1, 20, 6, 1, "")
+	LocateLayer("MyWindow", 2, 2)
+	PrintLayer("MyWindow", "Hello")
+	UpdateDisplay()
+	cells, width, _ := simulationScreen.GetContents()
+	getScreenText := func(xLocation int, yLocation int, length int) string {
+		obtainedValue := ""
+		for currentXLocation := xLocation; currentXLocation < xLocation+length; currentXLocation++ {
+			obtainedValue += string(cells[yLocation*width+currentXLocation].Runes)
+		}
+		return obtainedValue
+	}
+	assert.Equalf(test, "[ Title ]", getScreenText(3, 1, 9), "The window title was not rendered correctly!")
+	assert.Equalf(test, "[+][x]", getScreenText(15, 1, 6), "The window glyphs were not rendered correctly!")
+	assert.Equalf(test, "Hello", getScreenText(4, 3, 5), "The window content was not rendered correctly!")
+	assert.Equalf(test, constants.NullRune, memory.GetLayer("MyWindow").CharacterMemory[0][3].Character, "The window decorations were drawn physically on the text layer!")
+	assert.Panicsf(test, func() { AddWindow("SmallWindow", "", NewTuiStyleEntry(), 0, 0, 5, 5, 1, "") }, "Creating a window smaller than the minimum size did not panic!")
+}
+
+func TestWindowMouseInteraction(test *testing.T) {
+	simulationScreen, err := InitializeHeadlessTerminal(40, 20)
+	assert.NoErrorf(test, err, "The headless terminal could not be initialized!")
+	defer RestoreTerminalSettings()
+	AddWindow("MyWindow", "Title", NewTuiStyleEntry(), 5, 2, 20, 8, 1, "")
+	AddLayer("OtherLayer", 30, 0, 10, 10, 2, "")
+	simulationScreen.InjectMouse(8, 2, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(10, 5, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(10, 5, tcell.ButtonNone, tcell.ModNone)
+	eventEntry := waitForWindowEvent()
+	assert.Equalf(test, []int{constants.WindowActionMove, 7, 5}, []int{eventEntry.WindowEvent.Action, eventEntry.WindowEvent.XLocation, eventEntry.WindowEvent.YLocation}, "Dragging the title bar did not move the window!")
+	assert.Truef(test, memory.GetLayer("MyWindow").ZOrder > memory.GetLayer("OtherLayer").ZOrder, "Clicking the window did not raise it!")
+	simulationScreen.InjectMouse(26, 7, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(30, 7, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(30, 7, tcell.ButtonNone, tcell.ModNone)
+	eventEntry = waitForWindowEvent()
+	assert.Equalf(test, []int{constants.WindowActionResize, 24, 8}, []int{eventEntry.WindowEvent.Action, eventEntry.WindowEvent.Width, eventEntry.WindowEvent.Height}, "Dragging the right border did not resize the window!")
+	simulationScreen.InjectMouse(28, 5, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(28, 5, tcell.ButtonNone, tcell.ModNone)
+	eventEntry = waitForWindowEvent()
+	assert.Equalf(test, []interface{}{constants.WindowActionClose, "MyWindow"}, []interface{}{eventEntry.WindowEvent.Action, eventEntry.WindowEvent.WindowAlias}, "Clicking the close glyph did not report a close event!")
+	simulationScreen.InjectMouse(25, 5, tcell.Button1, tcell.ModNone)
+	simulationScreen.InjectMouse(25, 5, tcell.ButtonNone, tcell.ModNone)
+	eventEntry = waitForWindowEvent()
+	assert.Equalf(test, constants.WindowActionMaximize, eventEntry.WindowEvent.Action, "Clicking the maximize glyph did not maximize the window!")
+	eventEntry = waitForWindowEvent()
+	assert.Equalf(test, []int{constants.WindowActionResize, 0, 0, 40, 20}, []int{eventEntry.WindowEvent.Action, eventEntry.WindowEvent.XLocation, eventEntry.WindowEvent.YLocation, eventEntry.WindowEvent.Width, eventEntry.WindowEvent.Height}, "The maximized window does not fill the terminal!")
+	RestoreWindow("MyWindow")
+	layerEntry := memory.GetLayer("MyWindow")
+	assert.Equalf(test, []int{7, 5, 24, 8}, []int{layerEntry.ScreenXLocation, layerEntry.ScreenYLocation, layerEntry.Width, layerEntry.Height}, "The window was not restored to its previous size and location!")
+	DeleteWindow("MyWindow")
+	assert.Falsef(test, memory.IsWindowExists("MyWindow") || memory.IsLayerExists("MyWindow"), "The window was not deleted!")
+}
+
+func waitForWindowEvent() memory.EventEntryType {
+	for {
+		eventEntry := WaitForEvent()
+		if eventEntry.EventType == constants.EventTypeWindow {
+			return eventEntry
+		}
+	}
+}